@@ -335,6 +335,7 @@ type GetERC1155TokenURIResponse struct {
 	TokenUri        string                 `protobuf:"bytes,1,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`                      // Token URI (metadata)
 	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
 	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
+	ResolvedUri     string                 `protobuf:"bytes,4,opt,name=resolved_uri,json=resolvedUri,proto3" json:"resolved_uri,omitempty"`             // Token URI with the {id} placeholder substituted per EIP-1155
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -390,6 +391,13 @@ func (x *GetERC1155TokenURIResponse) GetTokenId() string {
 	return ""
 }
 
+func (x *GetERC1155TokenURIResponse) GetResolvedUri() string {
+	if x != nil {
+		return x.ResolvedUri
+	}
+	return ""
+}
+
 type IsApprovedForAllERC1155Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
@@ -527,6 +535,7 @@ type SafeTransferERC1155Request struct {
 	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to transfer (as string)
 	Data            []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`                                              // Additional data (can be empty)
 	PrivateKey      string                 `protobuf:"bytes,7,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,8,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -610,6 +619,13 @@ func (x *SafeTransferERC1155Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *SafeTransferERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type SafeTransferERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -618,6 +634,7 @@ type SafeTransferERC1155Response struct {
 	ToAddress       string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // To address
 	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
 	Amount          string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount transferred
+	JobId           string                 `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -694,6 +711,13 @@ func (x *SafeTransferERC1155Response) GetAmount() string {
 	return ""
 }
 
+func (x *SafeTransferERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type SafeBatchTransferERC1155Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
@@ -703,6 +727,7 @@ type SafeBatchTransferERC1155Request struct {
 	Amounts         []string               `protobuf:"bytes,5,rep,name=amounts,proto3" json:"amounts,omitempty"`                                        // List of amounts (as string)
 	Data            []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`                                              // Additional data (can be empty)
 	PrivateKey      string                 `protobuf:"bytes,7,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,8,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -786,6 +811,13 @@ func (x *SafeBatchTransferERC1155Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *SafeBatchTransferERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type SafeBatchTransferERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -794,6 +826,7 @@ type SafeBatchTransferERC1155Response struct {
 	ToAddress       string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // To address
 	TokenIds        []string               `protobuf:"bytes,5,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`                      // Token IDs
 	Amounts         []string               `protobuf:"bytes,6,rep,name=amounts,proto3" json:"amounts,omitempty"`                                        // Amounts transferred
+	JobId           string                 `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -870,12 +903,20 @@ func (x *SafeBatchTransferERC1155Response) GetAmounts() []string {
 	return nil
 }
 
+func (x *SafeBatchTransferERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type SetApprovalForAllERC1155Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
 	OperatorAddress string                 `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"` // Operator address
 	Approved        bool                   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`                                     // Whether to approve or revoke approval
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -938,6 +979,13 @@ func (x *SetApprovalForAllERC1155Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *SetApprovalForAllERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type SetApprovalForAllERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -945,6 +993,7 @@ type SetApprovalForAllERC1155Response struct {
 	OwnerAddress    string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Owner address
 	OperatorAddress string                 `protobuf:"bytes,4,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"` // Operator address
 	Approved        bool                   `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`                                     // Approval status set
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1014,6 +1063,13 @@ func (x *SetApprovalForAllERC1155Response) GetApproved() bool {
 	return false
 }
 
+func (x *SetApprovalForAllERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type MintERC1155Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
@@ -1022,6 +1078,7 @@ type MintERC1155Request struct {
 	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to mint (as string)
 	Data            []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`                                              // Additional data (can be empty)
 	PrivateKey      string                 `protobuf:"bytes,6,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,7,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1098,6 +1155,13 @@ func (x *MintERC1155Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *MintERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type MintERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1105,6 +1169,7 @@ type MintERC1155Response struct {
 	ToAddress       string                 `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Address that received minted tokens
 	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
 	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount minted
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1174,6 +1239,13 @@ func (x *MintERC1155Response) GetAmount() string {
 	return ""
 }
 
+func (x *MintERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type MintBatchERC1155Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
@@ -1182,6 +1254,7 @@ type MintBatchERC1155Request struct {
 	Amounts         []string               `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts,omitempty"`                                        // List of amounts (as string)
 	Data            []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`                                              // Additional data (can be empty)
 	PrivateKey      string                 `protobuf:"bytes,6,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,7,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1258,6 +1331,13 @@ func (x *MintBatchERC1155Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *MintBatchERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type MintBatchERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1265,6 +1345,7 @@ type MintBatchERC1155Response struct {
 	ToAddress       string                 `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Address that received minted tokens
 	TokenIds        []string               `protobuf:"bytes,4,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`                      // Token IDs
 	Amounts         []string               `protobuf:"bytes,5,rep,name=amounts,proto3" json:"amounts,omitempty"`                                        // Amounts minted
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1334,6 +1415,13 @@ func (x *MintBatchERC1155Response) GetAmounts() []string {
 	return nil
 }
 
+func (x *MintBatchERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type BurnERC1155Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
@@ -1341,6 +1429,7 @@ type BurnERC1155Request struct {
 	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID (as string)
 	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to burn (as string)
 	PrivateKey      string                 `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1410,6 +1499,13 @@ func (x *BurnERC1155Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *BurnERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type BurnERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1417,6 +1513,7 @@ type BurnERC1155Response struct {
 	AccountAddress  string                 `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`    // Account address
 	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
 	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount burned
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1486,6 +1583,13 @@ func (x *BurnERC1155Response) GetAmount() string {
 	return ""
 }
 
+func (x *BurnERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type BurnBatchERC1155Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
@@ -1493,6 +1597,7 @@ type BurnBatchERC1155Request struct {
 	TokenIds        []string               `protobuf:"bytes,3,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`                      // List of token IDs (as string)
 	Amounts         []string               `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts,omitempty"`                                        // List of amounts (as string)
 	PrivateKey      string                 `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1562,6 +1667,13 @@ func (x *BurnBatchERC1155Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *BurnBatchERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type BurnBatchERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1569,6 +1681,7 @@ type BurnBatchERC1155Response struct {
 	AccountAddress  string                 `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`    // Account address
 	TokenIds        []string               `protobuf:"bytes,4,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`                      // Token IDs
 	Amounts         []string               `protobuf:"bytes,5,rep,name=amounts,proto3" json:"amounts,omitempty"`                                        // Amounts burned
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1638,11 +1751,19 @@ func (x *BurnBatchERC1155Response) GetAmounts() []string {
 	return nil
 }
 
+func (x *BurnBatchERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type DeployERC1155Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                       // Metadata URI template
 	PrivateKey    string                 `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`       // Private key of the deployer
 	InitialOwner  string                 `protobuf:"bytes,3,opt,name=initial_owner,json=initialOwner,proto3" json:"initial_owner,omitempty"` // Initial owner address (optional, defaults to deployer)
+	Async         bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`                                  // Submit as an asynchronous job and return immediately
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1698,12 +1819,20 @@ func (x *DeployERC1155Request) GetInitialOwner() string {
 	return ""
 }
 
+func (x *DeployERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type DeployERC1155Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
 	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Deployed contract address
 	DeployerAddress string                 `protobuf:"bytes,3,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"` // Deployer address
 	Uri             string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`                                                // Metadata URI template
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1766,27 +1895,36 @@ func (x *DeployERC1155Response) GetUri() string {
 	return ""
 }
 
-type DeployERC1155Request_InitialOwner struct {
+func (x *DeployERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type AirdropRecipient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Initial owner address
+	ToAddress     string                 `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"` // Recipient address
+	TokenIds      []string               `protobuf:"bytes,2,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`    // Token IDs for this recipient (optional, defaults to the request token_ids)
+	Amounts       []string               `protobuf:"bytes,3,rep,name=amounts,proto3" json:"amounts,omitempty"`                      // Amounts for this recipient (optional, defaults to the request amounts)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeployERC1155Request_InitialOwner) Reset() {
-	*x = DeployERC1155Request_InitialOwner{}
+func (x *AirdropRecipient) Reset() {
+	*x = AirdropRecipient{}
 	mi := &file_erc1155_v1_erc1155_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeployERC1155Request_InitialOwner) String() string {
+func (x *AirdropRecipient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployERC1155Request_InitialOwner) ProtoMessage() {}
+func (*AirdropRecipient) ProtoMessage() {}
 
-func (x *DeployERC1155Request_InitialOwner) ProtoReflect() protoreflect.Message {
+func (x *AirdropRecipient) ProtoReflect() protoreflect.Message {
 	mi := &file_erc1155_v1_erc1155_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1798,179 +1936,1723 @@ func (x *DeployERC1155Request_InitialOwner) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployERC1155Request_InitialOwner.ProtoReflect.Descriptor instead.
-func (*DeployERC1155Request_InitialOwner) Descriptor() ([]byte, []int) {
-	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{22, 0}
+// Deprecated: Use AirdropRecipient.ProtoReflect.Descriptor instead.
+func (*AirdropRecipient) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeployERC1155Request_InitialOwner) GetAddress() string {
+func (x *AirdropRecipient) GetToAddress() string {
 	if x != nil {
-		return x.Address
+		return x.ToAddress
 	}
 	return ""
 }
 
-var File_erc1155_v1_erc1155_proto protoreflect.FileDescriptor
+func (x *AirdropRecipient) GetTokenIds() []string {
+	if x != nil {
+		return x.TokenIds
+	}
+	return nil
+}
 
-const file_erc1155_v1_erc1155_proto_rawDesc = "" +
-	"\n" +
-	"\x18erc1155/v1/erc1155.proto\x12\x0eapi.erc1155.v1\x1a\x1cgoogle/api/annotations.proto\"\x89\x01\n" +
-	"\x18GetERC1155BalanceRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\"\xa4\x01\n" +
-	"\x19GetERC1155BalanceResponse\x12\x18\n" +
-	"\abalance\x18\x01 \x01(\tR\abalance\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\"\x84\x01\n" +
-	"\x1eGetERC1155BalancesBatchRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1a\n" +
-	"\baccounts\x18\x02 \x03(\tR\baccounts\x12\x1b\n" +
-	"\ttoken_ids\x18\x03 \x03(\tR\btokenIds\"\xa1\x01\n" +
-	"\x1fGetERC1155BalancesBatchResponse\x12\x1a\n" +
-	"\bbalances\x18\x01 \x03(\tR\bbalances\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1a\n" +
-	"\baccounts\x18\x03 \x03(\tR\baccounts\x12\x1b\n" +
-	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\"a\n" +
-	"\x19GetERC1155TokenURIRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x02 \x01(\tR\atokenId\"\x7f\n" +
-	"\x1aGetERC1155TokenURIResponse\x12\x1b\n" +
-	"\ttoken_uri\x18\x01 \x01(\tR\btokenUri\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\"\x9f\x01\n" +
-	"\x1eIsApprovedForAllERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12)\n" +
-	"\x10operator_address\x18\x03 \x01(\tR\x0foperatorAddress\"\xbc\x01\n" +
-	"\x1fIsApprovedForAllERC1155Response\x12\x1a\n" +
-	"\bapproved\x18\x01 \x01(\bR\bapproved\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12)\n" +
-	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\"\xf1\x01\n" +
-	"\x1aSafeTransferERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x12\n" +
-	"\x04data\x18\x06 \x01(\fR\x04data\x12\x1f\n" +
-	"\vprivate_key\x18\a \x01(\tR\n" +
-	"privateKey\"\xd6\x01\n" +
-	"\x1bSafeTransferERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x16\n" +
-	"\x06amount\x18\x06 \x01(\tR\x06amount\"\xfa\x01\n" +
-	"\x1fSafeBatchTransferERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x1b\n" +
-	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\x12\x18\n" +
-	"\aamounts\x18\x05 \x03(\tR\aamounts\x12\x12\n" +
-	"\x04data\x18\x06 \x01(\fR\x04data\x12\x1f\n" +
-	"\vprivate_key\x18\a \x01(\tR\n" +
-	"privateKey\"\xdf\x01\n" +
-	" SafeBatchTransferERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x1b\n" +
-	"\ttoken_ids\x18\x05 \x03(\tR\btokenIds\x12\x18\n" +
-	"\aamounts\x18\x06 \x03(\tR\aamounts\"\xb4\x01\n" +
-	"\x1fSetApprovalForAllERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12)\n" +
-	"\x10operator_address\x18\x02 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
-	"\bapproved\x18\x03 \x01(\bR\bapproved\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\xd2\x01\n" +
-	" SetApprovalForAllERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
-	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
-	"\bapproved\x18\x05 \x01(\bR\bapproved\"\xc6\x01\n" +
-	"\x12MintERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x12\n" +
-	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1f\n" +
-	"\vprivate_key\x18\x06 \x01(\tR\n" +
-	"privateKey\"\xab\x01\n" +
-	"\x13MintERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\tR\x06amount\"\xcf\x01\n" +
-	"\x17MintBatchERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x1b\n" +
-	"\ttoken_ids\x18\x03 \x03(\tR\btokenIds\x12\x18\n" +
-	"\aamounts\x18\x04 \x03(\tR\aamounts\x12\x12\n" +
-	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1f\n" +
-	"\vprivate_key\x18\x06 \x01(\tR\n" +
-	"privateKey\"\xb4\x01\n" +
-	"\x18MintBatchERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x1b\n" +
-	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\x12\x18\n" +
-	"\aamounts\x18\x05 \x03(\tR\aamounts\"\xbc\x01\n" +
-	"\x12BurnERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1f\n" +
-	"\vprivate_key\x18\x05 \x01(\tR\n" +
-	"privateKey\"\xb5\x01\n" +
-	"\x13BurnERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\tR\x06amount\"\xc5\x01\n" +
-	"\x17BurnBatchERC1155Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12\x1b\n" +
-	"\ttoken_ids\x18\x03 \x03(\tR\btokenIds\x12\x18\n" +
-	"\aamounts\x18\x04 \x03(\tR\aamounts\x12\x1f\n" +
-	"\vprivate_key\x18\x05 \x01(\tR\n" +
-	"privateKey\"\xbe\x01\n" +
-	"\x18BurnBatchERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12\x1b\n" +
-	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\x12\x18\n" +
-	"\aamounts\x18\x05 \x03(\tR\aamounts\"\x98\x01\n" +
-	"\x14DeployERC1155Request\x12\x10\n" +
-	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x1f\n" +
-	"\vprivate_key\x18\x02 \x01(\tR\n" +
-	"privateKey\x12#\n" +
-	"\rinitial_owner\x18\x03 \x01(\tR\finitialOwner\x1a(\n" +
-	"\fInitialOwner\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\"\x98\x01\n" +
-	"\x15DeployERC1155Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12)\n" +
-	"\x10deployer_address\x18\x03 \x01(\tR\x0fdeployerAddress\x12\x10\n" +
-	"\x03uri\x18\x04 \x01(\tR\x03uri2\x81\x0e\n" +
+func (x *AirdropRecipient) GetAmounts() []string {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type AirdropERC1155Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	Recipients      []*AirdropRecipient    `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`                                  // Recipients of the airdrop
+	TokenIds        []string               `protobuf:"bytes,3,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`                      // Default token IDs; empty entries are assigned from the token ID sequence
+	Amounts         []string               `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts,omitempty"`                                        // Default amounts, one per token ID
+	Data            []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`                                              // Additional data (can be empty)
+	PrivateKey      string                 `protobuf:"bytes,6,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key of the contract owner (hex encoded, with or without 0x prefix)
+	BatchId         string                 `protobuf:"bytes,7,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`                         // Resume a previous batch; recipients are ignored
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AirdropERC1155Request) Reset() {
+	*x = AirdropERC1155Request{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AirdropERC1155Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirdropERC1155Request) ProtoMessage() {}
+
+func (x *AirdropERC1155Request) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirdropERC1155Request.ProtoReflect.Descriptor instead.
+func (*AirdropERC1155Request) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AirdropERC1155Request) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *AirdropERC1155Request) GetRecipients() []*AirdropRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *AirdropERC1155Request) GetTokenIds() []string {
+	if x != nil {
+		return x.TokenIds
+	}
+	return nil
+}
+
+func (x *AirdropERC1155Request) GetAmounts() []string {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *AirdropERC1155Request) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AirdropERC1155Request) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *AirdropERC1155Request) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type AirdropResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                         // Item index (one item per recipient and token ID)
+	ToAddress     string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"` // Recipient address
+	TokenId       string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`       // Token ID (including assigned IDs)
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                        // Amount minted
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // pending, submitted, confirmed or failed
+	TxHash        string                 `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`          // Transaction hash
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                          // Error message for failed items
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AirdropResult) Reset() {
+	*x = AirdropResult{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AirdropResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirdropResult) ProtoMessage() {}
+
+func (x *AirdropResult) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirdropResult.ProtoReflect.Descriptor instead.
+func (*AirdropResult) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AirdropResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *AirdropResult) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *AirdropResult) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *AirdropResult) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AirdropResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AirdropResult) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *AirdropResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AirdropERC1155Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BatchId         string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`                         // Batch ID, used to resume the batch
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Minter address
+	Succeeded       int32                  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`                                   // Items submitted or confirmed
+	Failed          int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`                                         // Items that failed and can be resumed
+	Results         []*AirdropResult       `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`                                        // Per-item results in input order
+	Pending         int32                  `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`                                       // Items not sent yet because of batch.max_txs_per_request, resume with batch_id
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AirdropERC1155Response) Reset() {
+	*x = AirdropERC1155Response{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AirdropERC1155Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirdropERC1155Response) ProtoMessage() {}
+
+func (x *AirdropERC1155Response) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirdropERC1155Response.ProtoReflect.Descriptor instead.
+func (*AirdropERC1155Response) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AirdropERC1155Response) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *AirdropERC1155Response) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *AirdropERC1155Response) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *AirdropERC1155Response) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *AirdropERC1155Response) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *AirdropERC1155Response) GetResults() []*AirdropResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *AirdropERC1155Response) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type PauseERC1155Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	PrivateKey      string                 `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,3,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PauseERC1155Request) Reset() {
+	*x = PauseERC1155Request{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseERC1155Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseERC1155Request) ProtoMessage() {}
+
+func (x *PauseERC1155Request) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseERC1155Request.ProtoReflect.Descriptor instead.
+func (*PauseERC1155Request) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PauseERC1155Request) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *PauseERC1155Request) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *PauseERC1155Request) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *PauseERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type PauseERC1155Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Owner address that signed the transaction
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PauseERC1155Response) Reset() {
+	*x = PauseERC1155Response{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseERC1155Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseERC1155Response) ProtoMessage() {}
+
+func (x *PauseERC1155Response) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseERC1155Response.ProtoReflect.Descriptor instead.
+func (*PauseERC1155Response) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PauseERC1155Response) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *PauseERC1155Response) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *PauseERC1155Response) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *PauseERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type UnpauseERC1155Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	PrivateKey      string                 `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,3,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnpauseERC1155Request) Reset() {
+	*x = UnpauseERC1155Request{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpauseERC1155Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpauseERC1155Request) ProtoMessage() {}
+
+func (x *UnpauseERC1155Request) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpauseERC1155Request.ProtoReflect.Descriptor instead.
+func (*UnpauseERC1155Request) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnpauseERC1155Request) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *UnpauseERC1155Request) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *UnpauseERC1155Request) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *UnpauseERC1155Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type UnpauseERC1155Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Owner address that signed the transaction
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnpauseERC1155Response) Reset() {
+	*x = UnpauseERC1155Response{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpauseERC1155Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpauseERC1155Response) ProtoMessage() {}
+
+func (x *UnpauseERC1155Response) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpauseERC1155Response.ProtoReflect.Descriptor instead.
+func (*UnpauseERC1155Response) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UnpauseERC1155Response) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *UnpauseERC1155Response) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *UnpauseERC1155Response) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *UnpauseERC1155Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetERC1155PausedRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC1155PausedRequest) Reset() {
+	*x = GetERC1155PausedRequest{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC1155PausedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC1155PausedRequest) ProtoMessage() {}
+
+func (x *GetERC1155PausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC1155PausedRequest.ProtoReflect.Descriptor instead.
+func (*GetERC1155PausedRequest) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetERC1155PausedRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type GetERC1155PausedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	Paused          bool                   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`                                         // Whether token transfers are paused
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC1155PausedResponse) Reset() {
+	*x = GetERC1155PausedResponse{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC1155PausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC1155PausedResponse) ProtoMessage() {}
+
+func (x *GetERC1155PausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC1155PausedResponse.ProtoReflect.Descriptor instead.
+func (*GetERC1155PausedResponse) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetERC1155PausedResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC1155PausedResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type GetERC1155OwnerRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC1155OwnerRequest) Reset() {
+	*x = GetERC1155OwnerRequest{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC1155OwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC1155OwnerRequest) ProtoMessage() {}
+
+func (x *GetERC1155OwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC1155OwnerRequest.ProtoReflect.Descriptor instead.
+func (*GetERC1155OwnerRequest) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetERC1155OwnerRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type GetERC1155OwnerResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	OwnerAddress    string                 `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Current owner (zero address after renouncing)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC1155OwnerResponse) Reset() {
+	*x = GetERC1155OwnerResponse{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC1155OwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC1155OwnerResponse) ProtoMessage() {}
+
+func (x *GetERC1155OwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC1155OwnerResponse.ProtoReflect.Descriptor instead.
+func (*GetERC1155OwnerResponse) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetERC1155OwnerResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC1155OwnerResponse) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+type TransferERC1155OwnershipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	NewOwner        string                 `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`                      // Address of the new owner
+	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,4,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferERC1155OwnershipRequest) Reset() {
+	*x = TransferERC1155OwnershipRequest{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferERC1155OwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferERC1155OwnershipRequest) ProtoMessage() {}
+
+func (x *TransferERC1155OwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferERC1155OwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferERC1155OwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TransferERC1155OwnershipRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TransferERC1155OwnershipRequest) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *TransferERC1155OwnershipRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *TransferERC1155OwnershipRequest) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *TransferERC1155OwnershipRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type TransferERC1155OwnershipResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	PreviousOwner   string                 `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`       // Owner that signed the transaction
+	NewOwner        string                 `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`                      // New owner
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferERC1155OwnershipResponse) Reset() {
+	*x = TransferERC1155OwnershipResponse{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferERC1155OwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferERC1155OwnershipResponse) ProtoMessage() {}
+
+func (x *TransferERC1155OwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferERC1155OwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferERC1155OwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TransferERC1155OwnershipResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TransferERC1155OwnershipResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TransferERC1155OwnershipResponse) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *TransferERC1155OwnershipResponse) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *TransferERC1155OwnershipResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type RenounceERC1155OwnershipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	Confirmation    string                 `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`                              // Must be "RENOUNCE <contract_address>" to confirm the irreversible renounce
+	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,4,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenounceERC1155OwnershipRequest) Reset() {
+	*x = RenounceERC1155OwnershipRequest{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceERC1155OwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceERC1155OwnershipRequest) ProtoMessage() {}
+
+func (x *RenounceERC1155OwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceERC1155OwnershipRequest.ProtoReflect.Descriptor instead.
+func (*RenounceERC1155OwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RenounceERC1155OwnershipRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *RenounceERC1155OwnershipRequest) GetConfirmation() string {
+	if x != nil {
+		return x.Confirmation
+	}
+	return ""
+}
+
+func (x *RenounceERC1155OwnershipRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *RenounceERC1155OwnershipRequest) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *RenounceERC1155OwnershipRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type RenounceERC1155OwnershipResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	PreviousOwner   string                 `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`       // Owner that signed the transaction
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenounceERC1155OwnershipResponse) Reset() {
+	*x = RenounceERC1155OwnershipResponse{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceERC1155OwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceERC1155OwnershipResponse) ProtoMessage() {}
+
+func (x *RenounceERC1155OwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceERC1155OwnershipResponse.ProtoReflect.Descriptor instead.
+func (*RenounceERC1155OwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RenounceERC1155OwnershipResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *RenounceERC1155OwnershipResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *RenounceERC1155OwnershipResponse) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *RenounceERC1155OwnershipResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetERC1155MetadataRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC1155 contract address
+	TokenId         string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID (as string to handle large numbers)
+	Refresh         bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`                                       // Fetch the metadata again instead of using the cache
+	IncludeRaw      bool                   `protobuf:"varint,4,opt,name=include_raw,json=includeRaw,proto3" json:"include_raw,omitempty"`               // Return the original metadata JSON document in raw
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC1155MetadataRequest) Reset() {
+	*x = GetERC1155MetadataRequest{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC1155MetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC1155MetadataRequest) ProtoMessage() {}
+
+func (x *GetERC1155MetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC1155MetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetERC1155MetadataRequest) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetERC1155MetadataRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC1155MetadataRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *GetERC1155MetadataRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+func (x *GetERC1155MetadataRequest) GetIncludeRaw() bool {
+	if x != nil {
+		return x.IncludeRaw
+	}
+	return false
+}
+
+type NFTAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TraitType     string                 `protobuf:"bytes,1,opt,name=trait_type,json=traitType,proto3" json:"trait_type,omitempty"`       // Trait name
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                                // Trait value (numbers and booleans are converted to strings)
+	DisplayType   string                 `protobuf:"bytes,3,opt,name=display_type,json=displayType,proto3" json:"display_type,omitempty"` // Display hint (e.g. number, boost_percentage, date)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFTAttribute) Reset() {
+	*x = NFTAttribute{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFTAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTAttribute) ProtoMessage() {}
+
+func (x *NFTAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTAttribute.ProtoReflect.Descriptor instead.
+func (*NFTAttribute) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *NFTAttribute) GetTraitType() string {
+	if x != nil {
+		return x.TraitType
+	}
+	return ""
+}
+
+func (x *NFTAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *NFTAttribute) GetDisplayType() string {
+	if x != nil {
+		return x.DisplayType
+	}
+	return ""
+}
+
+type NFTMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Token name
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                       // Token description
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                                   // Image URL (ipfs:// and ar:// rewritten to the configured gateways)
+	Attributes    []*NFTAttribute        `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`                         // Token attributes
+	AnimationUrl  string                 `protobuf:"bytes,5,opt,name=animation_url,json=animationUrl,proto3" json:"animation_url,omitempty"` // Animation URL (ipfs:// and ar:// rewritten to the configured gateways)
+	ExternalUrl   string                 `protobuf:"bytes,6,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`    // External URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFTMetadata) Reset() {
+	*x = NFTMetadata{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFTMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTMetadata) ProtoMessage() {}
+
+func (x *NFTMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTMetadata.ProtoReflect.Descriptor instead.
+func (*NFTMetadata) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NFTMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetAttributes() []*NFTAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *NFTMetadata) GetAnimationUrl() string {
+	if x != nil {
+		return x.AnimationUrl
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+type GetERC1155MetadataResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	TokenId         string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
+	TokenUri        string                 `protobuf:"bytes,3,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`                      // Token URI as returned by the contract
+	ResolvedUri     string                 `protobuf:"bytes,4,opt,name=resolved_uri,json=resolvedUri,proto3" json:"resolved_uri,omitempty"`             // Token URI after {id} substitution
+	Metadata        *NFTMetadata           `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`                                      // Normalized metadata
+	Raw             string                 `protobuf:"bytes,6,opt,name=raw,proto3" json:"raw,omitempty"`                                                // Original metadata JSON document (only with include_raw)
+	Cached          bool                   `protobuf:"varint,7,opt,name=cached,proto3" json:"cached,omitempty"`                                         // Whether the metadata was served from the cache
+	FetchedAt       int64                  `protobuf:"varint,8,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`                  // Unix timestamp when the metadata was fetched
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC1155MetadataResponse) Reset() {
+	*x = GetERC1155MetadataResponse{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC1155MetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC1155MetadataResponse) ProtoMessage() {}
+
+func (x *GetERC1155MetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC1155MetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetERC1155MetadataResponse) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetERC1155MetadataResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC1155MetadataResponse) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *GetERC1155MetadataResponse) GetTokenUri() string {
+	if x != nil {
+		return x.TokenUri
+	}
+	return ""
+}
+
+func (x *GetERC1155MetadataResponse) GetResolvedUri() string {
+	if x != nil {
+		return x.ResolvedUri
+	}
+	return ""
+}
+
+func (x *GetERC1155MetadataResponse) GetMetadata() *NFTMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetERC1155MetadataResponse) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+func (x *GetERC1155MetadataResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *GetERC1155MetadataResponse) GetFetchedAt() int64 {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return 0
+}
+
+type DeployERC1155Request_InitialOwner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Initial owner address
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployERC1155Request_InitialOwner) Reset() {
+	*x = DeployERC1155Request_InitialOwner{}
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployERC1155Request_InitialOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployERC1155Request_InitialOwner) ProtoMessage() {}
+
+func (x *DeployERC1155Request_InitialOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_erc1155_v1_erc1155_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployERC1155Request_InitialOwner.ProtoReflect.Descriptor instead.
+func (*DeployERC1155Request_InitialOwner) Descriptor() ([]byte, []int) {
+	return file_erc1155_v1_erc1155_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *DeployERC1155Request_InitialOwner) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+var File_erc1155_v1_erc1155_proto protoreflect.FileDescriptor
+
+const file_erc1155_v1_erc1155_proto_rawDesc = "" +
+	"\n" +
+	"\x18erc1155/v1/erc1155.proto\x12\x0eapi.erc1155.v1\x1a\x1cgoogle/api/annotations.proto\"\x89\x01\n" +
+	"\x18GetERC1155BalanceRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\"\xa4\x01\n" +
+	"\x19GetERC1155BalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\tR\abalance\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\"\x84\x01\n" +
+	"\x1eGetERC1155BalancesBatchRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1a\n" +
+	"\baccounts\x18\x02 \x03(\tR\baccounts\x12\x1b\n" +
+	"\ttoken_ids\x18\x03 \x03(\tR\btokenIds\"\xa1\x01\n" +
+	"\x1fGetERC1155BalancesBatchResponse\x12\x1a\n" +
+	"\bbalances\x18\x01 \x03(\tR\bbalances\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1a\n" +
+	"\baccounts\x18\x03 \x03(\tR\baccounts\x12\x1b\n" +
+	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\"a\n" +
+	"\x19GetERC1155TokenURIRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"\xa2\x01\n" +
+	"\x1aGetERC1155TokenURIResponse\x12\x1b\n" +
+	"\ttoken_uri\x18\x01 \x01(\tR\btokenUri\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12!\n" +
+	"\fresolved_uri\x18\x04 \x01(\tR\vresolvedUri\"\x9f\x01\n" +
+	"\x1eIsApprovedForAllERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12)\n" +
+	"\x10operator_address\x18\x03 \x01(\tR\x0foperatorAddress\"\xbc\x01\n" +
+	"\x1fIsApprovedForAllERC1155Response\x12\x1a\n" +
+	"\bapproved\x18\x01 \x01(\bR\bapproved\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12)\n" +
+	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\"\x87\x02\n" +
+	"\x1aSafeTransferERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12\x1f\n" +
+	"\vprivate_key\x18\a \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\b \x01(\bR\x05async\"\xed\x01\n" +
+	"\x1bSafeTransferERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\a \x01(\tR\x05jobId\"\x90\x02\n" +
+	"\x1fSafeBatchTransferERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x1b\n" +
+	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x05 \x03(\tR\aamounts\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12\x1f\n" +
+	"\vprivate_key\x18\a \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\b \x01(\bR\x05async\"\xf6\x01\n" +
+	" SafeBatchTransferERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x1b\n" +
+	"\ttoken_ids\x18\x05 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x06 \x03(\tR\aamounts\x12\x15\n" +
+	"\x06job_id\x18\a \x01(\tR\x05jobId\"\xca\x01\n" +
+	"\x1fSetApprovalForAllERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12)\n" +
+	"\x10operator_address\x18\x02 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
+	"\bapproved\x18\x03 \x01(\bR\bapproved\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xe9\x01\n" +
+	" SetApprovalForAllERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
+	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
+	"\bapproved\x18\x05 \x01(\bR\bapproved\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xdc\x01\n" +
+	"\x12MintERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1f\n" +
+	"\vprivate_key\x18\x06 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\a \x01(\bR\x05async\"\xc2\x01\n" +
+	"\x13MintERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xe5\x01\n" +
+	"\x17MintBatchERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x1b\n" +
+	"\ttoken_ids\x18\x03 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x04 \x03(\tR\aamounts\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1f\n" +
+	"\vprivate_key\x18\x06 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\a \x01(\bR\x05async\"\xcb\x01\n" +
+	"\x18MintBatchERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x1b\n" +
+	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x05 \x03(\tR\aamounts\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xd2\x01\n" +
+	"\x12BurnERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vprivate_key\x18\x05 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x06 \x01(\bR\x05async\"\xcc\x01\n" +
+	"\x13BurnERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xdb\x01\n" +
+	"\x17BurnBatchERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x02 \x01(\tR\x0eaccountAddress\x12\x1b\n" +
+	"\ttoken_ids\x18\x03 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x04 \x03(\tR\aamounts\x12\x1f\n" +
+	"\vprivate_key\x18\x05 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x06 \x01(\bR\x05async\"\xd5\x01\n" +
+	"\x18BurnBatchERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0faccount_address\x18\x03 \x01(\tR\x0eaccountAddress\x12\x1b\n" +
+	"\ttoken_ids\x18\x04 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x05 \x03(\tR\aamounts\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xae\x01\n" +
+	"\x14DeployERC1155Request\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12#\n" +
+	"\rinitial_owner\x18\x03 \x01(\tR\finitialOwner\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\x1a(\n" +
+	"\fInitialOwner\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\xaf\x01\n" +
+	"\x15DeployERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12)\n" +
+	"\x10deployer_address\x18\x03 \x01(\tR\x0fdeployerAddress\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\"h\n" +
+	"\x10AirdropRecipient\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x01 \x01(\tR\ttoAddress\x12\x1b\n" +
+	"\ttoken_ids\x18\x02 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x03 \x03(\tR\aamounts\"\x8b\x02\n" +
+	"\x15AirdropERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12@\n" +
+	"\n" +
+	"recipients\x18\x02 \x03(\v2 .api.erc1155.v1.AirdropRecipientR\n" +
+	"recipients\x12\x1b\n" +
+	"\ttoken_ids\x18\x03 \x03(\tR\btokenIds\x12\x18\n" +
+	"\aamounts\x18\x04 \x03(\tR\aamounts\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1f\n" +
+	"\vprivate_key\x18\x06 \x01(\tR\n" +
+	"privateKey\x12\x19\n" +
+	"\bbatch_id\x18\a \x01(\tR\abatchId\"\xbe\x01\n" +
+	"\rAirdropResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x17\n" +
+	"\atx_hash\x18\x06 \x01(\tR\x06txHash\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\x8a\x02\n" +
+	"\x16AirdropERC1155Response\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x127\n" +
+	"\aresults\x18\x06 \x03(\v2\x1d.api.erc1155.v1.AirdropResultR\aresults\x12\x18\n" +
+	"\apending\x18\a \x01(\x05R\apending\"\x94\x01\n" +
+	"\x13PauseERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x03 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\"\x94\x01\n" +
+	"\x14PauseERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"\x96\x01\n" +
+	"\x15UnpauseERC1155Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x03 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\"\x96\x01\n" +
+	"\x16UnpauseERC1155Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"D\n" +
+	"\x17GetERC1155PausedRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\"]\n" +
+	"\x18GetERC1155PausedResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x16\n" +
+	"\x06paused\x18\x02 \x01(\bR\x06paused\"C\n" +
+	"\x16GetERC1155OwnerRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\"i\n" +
+	"\x17GetERC1155OwnerResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\"\xbd\x01\n" +
+	"\x1fTransferERC1155OwnershipRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1b\n" +
+	"\tnew_owner\x18\x02 \x01(\tR\bnewOwner\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x04 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xc1\x01\n" +
+	" TransferERC1155OwnershipResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12%\n" +
+	"\x0eprevious_owner\x18\x03 \x01(\tR\rpreviousOwner\x12\x1b\n" +
+	"\tnew_owner\x18\x04 \x01(\tR\bnewOwner\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\"\xc4\x01\n" +
+	"\x1fRenounceERC1155OwnershipRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\"\n" +
+	"\fconfirmation\x18\x02 \x01(\tR\fconfirmation\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x04 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xa4\x01\n" +
+	" RenounceERC1155OwnershipResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12%\n" +
+	"\x0eprevious_owner\x18\x03 \x01(\tR\rpreviousOwner\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"\x9c\x01\n" +
+	"\x19GetERC1155MetadataRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\x12\x1f\n" +
+	"\vinclude_raw\x18\x04 \x01(\bR\n" +
+	"includeRaw\"f\n" +
+	"\fNFTAttribute\x12\x1d\n" +
+	"\n" +
+	"trait_type\x18\x01 \x01(\tR\ttraitType\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12!\n" +
+	"\fdisplay_type\x18\x03 \x01(\tR\vdisplayType\"\xdf\x01\n" +
+	"\vNFTMetadata\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12<\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v2\x1c.api.erc1155.v1.NFTAttributeR\n" +
+	"attributes\x12#\n" +
+	"\ranimation_url\x18\x05 \x01(\tR\fanimationUrl\x12!\n" +
+	"\fexternal_url\x18\x06 \x01(\tR\vexternalUrl\"\xa4\x02\n" +
+	"\x1aGetERC1155MetadataResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x1b\n" +
+	"\ttoken_uri\x18\x03 \x01(\tR\btokenUri\x12!\n" +
+	"\fresolved_uri\x18\x04 \x01(\tR\vresolvedUri\x127\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x1b.api.erc1155.v1.NFTMetadataR\bmetadata\x12\x10\n" +
+	"\x03raw\x18\x06 \x01(\tR\x03raw\x12\x16\n" +
+	"\x06cached\x18\a \x01(\bR\x06cached\x12\x1d\n" +
+	"\n" +
+	"fetched_at\x18\b \x01(\x03R\tfetchedAt2\x84\x17\n" +
 	"\aERC1155\x12\x89\x01\n" +
 	"\x11GetERC1155Balance\x12(.api.erc1155.v1.GetERC1155BalanceRequest\x1a).api.erc1155.v1.GetERC1155BalanceResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/erc1155/balance\x12\xa1\x01\n" +
 	"\x17GetERC1155BalancesBatch\x12..api.erc1155.v1.GetERC1155BalancesBatchRequest\x1a/.api.erc1155.v1.GetERC1155BalancesBatchResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/erc1155/balance-batch\x12\x8e\x01\n" +
-	"\x12GetERC1155TokenURI\x12).api.erc1155.v1.GetERC1155TokenURIRequest\x1a*.api.erc1155.v1.GetERC1155TokenURIResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/erc1155/token-uri\x12\xa7\x01\n" +
+	"\x12GetERC1155TokenURI\x12).api.erc1155.v1.GetERC1155TokenURIRequest\x1a*.api.erc1155.v1.GetERC1155TokenURIResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/erc1155/token-uri\x12\x8d\x01\n" +
+	"\x12GetERC1155Metadata\x12).api.erc1155.v1.GetERC1155MetadataRequest\x1a*.api.erc1155.v1.GetERC1155MetadataResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/erc1155/metadata\x12\xa7\x01\n" +
 	"\x17IsApprovedForAllERC1155\x12..api.erc1155.v1.IsApprovedForAllERC1155Request\x1a/.api.erc1155.v1.IsApprovedForAllERC1155Response\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/erc1155/is-approved-for-all\x12\x98\x01\n" +
 	"\x13SafeTransferERC1155\x12*.api.erc1155.v1.SafeTransferERC1155Request\x1a+.api.erc1155.v1.SafeTransferERC1155Response\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/erc1155/safe-transfer\x12\xad\x01\n" +
 	"\x18SafeBatchTransferERC1155\x12/.api.erc1155.v1.SafeBatchTransferERC1155Request\x1a0.api.erc1155.v1.SafeBatchTransferERC1155Response\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/erc1155/safe-batch-transfer\x12\xae\x01\n" +
@@ -1979,7 +3661,14 @@ const file_erc1155_v1_erc1155_proto_rawDesc = "" +
 	"\x10MintBatchERC1155\x12'.api.erc1155.v1.MintBatchERC1155Request\x1a(.api.erc1155.v1.MintBatchERC1155Response\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/erc1155/mint-batch\x12w\n" +
 	"\vBurnERC1155\x12\".api.erc1155.v1.BurnERC1155Request\x1a#.api.erc1155.v1.BurnERC1155Response\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/erc1155/burn\x12\x8c\x01\n" +
 	"\x10BurnBatchERC1155\x12'.api.erc1155.v1.BurnBatchERC1155Request\x1a(.api.erc1155.v1.BurnBatchERC1155Response\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/erc1155/burn-batch\x12\x7f\n" +
-	"\rDeployERC1155\x12$.api.erc1155.v1.DeployERC1155Request\x1a%.api.erc1155.v1.DeployERC1155Response\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/erc1155/deployB:\n" +
+	"\rDeployERC1155\x12$.api.erc1155.v1.DeployERC1155Request\x1a%.api.erc1155.v1.DeployERC1155Response\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/erc1155/deploy\x12\x83\x01\n" +
+	"\x0eAirdropERC1155\x12%.api.erc1155.v1.AirdropERC1155Request\x1a&.api.erc1155.v1.AirdropERC1155Response\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/erc1155/airdrop\x12{\n" +
+	"\fPauseERC1155\x12#.api.erc1155.v1.PauseERC1155Request\x1a$.api.erc1155.v1.PauseERC1155Response\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/erc1155/pause\x12\x83\x01\n" +
+	"\x0eUnpauseERC1155\x12%.api.erc1155.v1.UnpauseERC1155Request\x1a&.api.erc1155.v1.UnpauseERC1155Response\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/erc1155/unpause\x12\x85\x01\n" +
+	"\x10GetERC1155Paused\x12'.api.erc1155.v1.GetERC1155PausedRequest\x1a(.api.erc1155.v1.GetERC1155PausedResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/erc1155/paused\x12\x81\x01\n" +
+	"\x0fGetERC1155Owner\x12&.api.erc1155.v1.GetERC1155OwnerRequest\x1a'.api.erc1155.v1.GetERC1155OwnerResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/erc1155/owner\x12\xac\x01\n" +
+	"\x18TransferERC1155Ownership\x12/.api.erc1155.v1.TransferERC1155OwnershipRequest\x1a0.api.erc1155.v1.TransferERC1155OwnershipResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/erc1155/transfer-ownership\x12\xac\x01\n" +
+	"\x18RenounceERC1155Ownership\x12/.api.erc1155.v1.RenounceERC1155OwnershipRequest\x1a0.api.erc1155.v1.RenounceERC1155OwnershipResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/erc1155/renounce-ownershipB:\n" +
 	"\x0eapi.erc1155.v1P\x01Z&eth-contract-service/api/erc1155/v1;v1b\x06proto3"
 
 var (
@@ -1994,7 +3683,7 @@ func file_erc1155_v1_erc1155_proto_rawDescGZIP() []byte {
 	return file_erc1155_v1_erc1155_proto_rawDescData
 }
 
-var file_erc1155_v1_erc1155_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_erc1155_v1_erc1155_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_erc1155_v1_erc1155_proto_goTypes = []any{
 	(*GetERC1155BalanceRequest)(nil),          // 0: api.erc1155.v1.GetERC1155BalanceRequest
 	(*GetERC1155BalanceResponse)(nil),         // 1: api.erc1155.v1.GetERC1155BalanceResponse
@@ -2020,38 +3709,78 @@ var file_erc1155_v1_erc1155_proto_goTypes = []any{
 	(*BurnBatchERC1155Response)(nil),          // 21: api.erc1155.v1.BurnBatchERC1155Response
 	(*DeployERC1155Request)(nil),              // 22: api.erc1155.v1.DeployERC1155Request
 	(*DeployERC1155Response)(nil),             // 23: api.erc1155.v1.DeployERC1155Response
-	(*DeployERC1155Request_InitialOwner)(nil), // 24: api.erc1155.v1.DeployERC1155Request.InitialOwner
+	(*AirdropRecipient)(nil),                  // 24: api.erc1155.v1.AirdropRecipient
+	(*AirdropERC1155Request)(nil),             // 25: api.erc1155.v1.AirdropERC1155Request
+	(*AirdropResult)(nil),                     // 26: api.erc1155.v1.AirdropResult
+	(*AirdropERC1155Response)(nil),            // 27: api.erc1155.v1.AirdropERC1155Response
+	(*PauseERC1155Request)(nil),               // 28: api.erc1155.v1.PauseERC1155Request
+	(*PauseERC1155Response)(nil),              // 29: api.erc1155.v1.PauseERC1155Response
+	(*UnpauseERC1155Request)(nil),             // 30: api.erc1155.v1.UnpauseERC1155Request
+	(*UnpauseERC1155Response)(nil),            // 31: api.erc1155.v1.UnpauseERC1155Response
+	(*GetERC1155PausedRequest)(nil),           // 32: api.erc1155.v1.GetERC1155PausedRequest
+	(*GetERC1155PausedResponse)(nil),          // 33: api.erc1155.v1.GetERC1155PausedResponse
+	(*GetERC1155OwnerRequest)(nil),            // 34: api.erc1155.v1.GetERC1155OwnerRequest
+	(*GetERC1155OwnerResponse)(nil),           // 35: api.erc1155.v1.GetERC1155OwnerResponse
+	(*TransferERC1155OwnershipRequest)(nil),   // 36: api.erc1155.v1.TransferERC1155OwnershipRequest
+	(*TransferERC1155OwnershipResponse)(nil),  // 37: api.erc1155.v1.TransferERC1155OwnershipResponse
+	(*RenounceERC1155OwnershipRequest)(nil),   // 38: api.erc1155.v1.RenounceERC1155OwnershipRequest
+	(*RenounceERC1155OwnershipResponse)(nil),  // 39: api.erc1155.v1.RenounceERC1155OwnershipResponse
+	(*GetERC1155MetadataRequest)(nil),         // 40: api.erc1155.v1.GetERC1155MetadataRequest
+	(*NFTAttribute)(nil),                      // 41: api.erc1155.v1.NFTAttribute
+	(*NFTMetadata)(nil),                       // 42: api.erc1155.v1.NFTMetadata
+	(*GetERC1155MetadataResponse)(nil),        // 43: api.erc1155.v1.GetERC1155MetadataResponse
+	(*DeployERC1155Request_InitialOwner)(nil), // 44: api.erc1155.v1.DeployERC1155Request.InitialOwner
 }
 var file_erc1155_v1_erc1155_proto_depIdxs = []int32{
-	0,  // 0: api.erc1155.v1.ERC1155.GetERC1155Balance:input_type -> api.erc1155.v1.GetERC1155BalanceRequest
-	2,  // 1: api.erc1155.v1.ERC1155.GetERC1155BalancesBatch:input_type -> api.erc1155.v1.GetERC1155BalancesBatchRequest
-	4,  // 2: api.erc1155.v1.ERC1155.GetERC1155TokenURI:input_type -> api.erc1155.v1.GetERC1155TokenURIRequest
-	6,  // 3: api.erc1155.v1.ERC1155.IsApprovedForAllERC1155:input_type -> api.erc1155.v1.IsApprovedForAllERC1155Request
-	8,  // 4: api.erc1155.v1.ERC1155.SafeTransferERC1155:input_type -> api.erc1155.v1.SafeTransferERC1155Request
-	10, // 5: api.erc1155.v1.ERC1155.SafeBatchTransferERC1155:input_type -> api.erc1155.v1.SafeBatchTransferERC1155Request
-	12, // 6: api.erc1155.v1.ERC1155.SetApprovalForAllERC1155:input_type -> api.erc1155.v1.SetApprovalForAllERC1155Request
-	14, // 7: api.erc1155.v1.ERC1155.MintERC1155:input_type -> api.erc1155.v1.MintERC1155Request
-	16, // 8: api.erc1155.v1.ERC1155.MintBatchERC1155:input_type -> api.erc1155.v1.MintBatchERC1155Request
-	18, // 9: api.erc1155.v1.ERC1155.BurnERC1155:input_type -> api.erc1155.v1.BurnERC1155Request
-	20, // 10: api.erc1155.v1.ERC1155.BurnBatchERC1155:input_type -> api.erc1155.v1.BurnBatchERC1155Request
-	22, // 11: api.erc1155.v1.ERC1155.DeployERC1155:input_type -> api.erc1155.v1.DeployERC1155Request
-	1,  // 12: api.erc1155.v1.ERC1155.GetERC1155Balance:output_type -> api.erc1155.v1.GetERC1155BalanceResponse
-	3,  // 13: api.erc1155.v1.ERC1155.GetERC1155BalancesBatch:output_type -> api.erc1155.v1.GetERC1155BalancesBatchResponse
-	5,  // 14: api.erc1155.v1.ERC1155.GetERC1155TokenURI:output_type -> api.erc1155.v1.GetERC1155TokenURIResponse
-	7,  // 15: api.erc1155.v1.ERC1155.IsApprovedForAllERC1155:output_type -> api.erc1155.v1.IsApprovedForAllERC1155Response
-	9,  // 16: api.erc1155.v1.ERC1155.SafeTransferERC1155:output_type -> api.erc1155.v1.SafeTransferERC1155Response
-	11, // 17: api.erc1155.v1.ERC1155.SafeBatchTransferERC1155:output_type -> api.erc1155.v1.SafeBatchTransferERC1155Response
-	13, // 18: api.erc1155.v1.ERC1155.SetApprovalForAllERC1155:output_type -> api.erc1155.v1.SetApprovalForAllERC1155Response
-	15, // 19: api.erc1155.v1.ERC1155.MintERC1155:output_type -> api.erc1155.v1.MintERC1155Response
-	17, // 20: api.erc1155.v1.ERC1155.MintBatchERC1155:output_type -> api.erc1155.v1.MintBatchERC1155Response
-	19, // 21: api.erc1155.v1.ERC1155.BurnERC1155:output_type -> api.erc1155.v1.BurnERC1155Response
-	21, // 22: api.erc1155.v1.ERC1155.BurnBatchERC1155:output_type -> api.erc1155.v1.BurnBatchERC1155Response
-	23, // 23: api.erc1155.v1.ERC1155.DeployERC1155:output_type -> api.erc1155.v1.DeployERC1155Response
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	24, // 0: api.erc1155.v1.AirdropERC1155Request.recipients:type_name -> api.erc1155.v1.AirdropRecipient
+	26, // 1: api.erc1155.v1.AirdropERC1155Response.results:type_name -> api.erc1155.v1.AirdropResult
+	41, // 2: api.erc1155.v1.NFTMetadata.attributes:type_name -> api.erc1155.v1.NFTAttribute
+	42, // 3: api.erc1155.v1.GetERC1155MetadataResponse.metadata:type_name -> api.erc1155.v1.NFTMetadata
+	0,  // 4: api.erc1155.v1.ERC1155.GetERC1155Balance:input_type -> api.erc1155.v1.GetERC1155BalanceRequest
+	2,  // 5: api.erc1155.v1.ERC1155.GetERC1155BalancesBatch:input_type -> api.erc1155.v1.GetERC1155BalancesBatchRequest
+	4,  // 6: api.erc1155.v1.ERC1155.GetERC1155TokenURI:input_type -> api.erc1155.v1.GetERC1155TokenURIRequest
+	40, // 7: api.erc1155.v1.ERC1155.GetERC1155Metadata:input_type -> api.erc1155.v1.GetERC1155MetadataRequest
+	6,  // 8: api.erc1155.v1.ERC1155.IsApprovedForAllERC1155:input_type -> api.erc1155.v1.IsApprovedForAllERC1155Request
+	8,  // 9: api.erc1155.v1.ERC1155.SafeTransferERC1155:input_type -> api.erc1155.v1.SafeTransferERC1155Request
+	10, // 10: api.erc1155.v1.ERC1155.SafeBatchTransferERC1155:input_type -> api.erc1155.v1.SafeBatchTransferERC1155Request
+	12, // 11: api.erc1155.v1.ERC1155.SetApprovalForAllERC1155:input_type -> api.erc1155.v1.SetApprovalForAllERC1155Request
+	14, // 12: api.erc1155.v1.ERC1155.MintERC1155:input_type -> api.erc1155.v1.MintERC1155Request
+	16, // 13: api.erc1155.v1.ERC1155.MintBatchERC1155:input_type -> api.erc1155.v1.MintBatchERC1155Request
+	18, // 14: api.erc1155.v1.ERC1155.BurnERC1155:input_type -> api.erc1155.v1.BurnERC1155Request
+	20, // 15: api.erc1155.v1.ERC1155.BurnBatchERC1155:input_type -> api.erc1155.v1.BurnBatchERC1155Request
+	22, // 16: api.erc1155.v1.ERC1155.DeployERC1155:input_type -> api.erc1155.v1.DeployERC1155Request
+	25, // 17: api.erc1155.v1.ERC1155.AirdropERC1155:input_type -> api.erc1155.v1.AirdropERC1155Request
+	28, // 18: api.erc1155.v1.ERC1155.PauseERC1155:input_type -> api.erc1155.v1.PauseERC1155Request
+	30, // 19: api.erc1155.v1.ERC1155.UnpauseERC1155:input_type -> api.erc1155.v1.UnpauseERC1155Request
+	32, // 20: api.erc1155.v1.ERC1155.GetERC1155Paused:input_type -> api.erc1155.v1.GetERC1155PausedRequest
+	34, // 21: api.erc1155.v1.ERC1155.GetERC1155Owner:input_type -> api.erc1155.v1.GetERC1155OwnerRequest
+	36, // 22: api.erc1155.v1.ERC1155.TransferERC1155Ownership:input_type -> api.erc1155.v1.TransferERC1155OwnershipRequest
+	38, // 23: api.erc1155.v1.ERC1155.RenounceERC1155Ownership:input_type -> api.erc1155.v1.RenounceERC1155OwnershipRequest
+	1,  // 24: api.erc1155.v1.ERC1155.GetERC1155Balance:output_type -> api.erc1155.v1.GetERC1155BalanceResponse
+	3,  // 25: api.erc1155.v1.ERC1155.GetERC1155BalancesBatch:output_type -> api.erc1155.v1.GetERC1155BalancesBatchResponse
+	5,  // 26: api.erc1155.v1.ERC1155.GetERC1155TokenURI:output_type -> api.erc1155.v1.GetERC1155TokenURIResponse
+	43, // 27: api.erc1155.v1.ERC1155.GetERC1155Metadata:output_type -> api.erc1155.v1.GetERC1155MetadataResponse
+	7,  // 28: api.erc1155.v1.ERC1155.IsApprovedForAllERC1155:output_type -> api.erc1155.v1.IsApprovedForAllERC1155Response
+	9,  // 29: api.erc1155.v1.ERC1155.SafeTransferERC1155:output_type -> api.erc1155.v1.SafeTransferERC1155Response
+	11, // 30: api.erc1155.v1.ERC1155.SafeBatchTransferERC1155:output_type -> api.erc1155.v1.SafeBatchTransferERC1155Response
+	13, // 31: api.erc1155.v1.ERC1155.SetApprovalForAllERC1155:output_type -> api.erc1155.v1.SetApprovalForAllERC1155Response
+	15, // 32: api.erc1155.v1.ERC1155.MintERC1155:output_type -> api.erc1155.v1.MintERC1155Response
+	17, // 33: api.erc1155.v1.ERC1155.MintBatchERC1155:output_type -> api.erc1155.v1.MintBatchERC1155Response
+	19, // 34: api.erc1155.v1.ERC1155.BurnERC1155:output_type -> api.erc1155.v1.BurnERC1155Response
+	21, // 35: api.erc1155.v1.ERC1155.BurnBatchERC1155:output_type -> api.erc1155.v1.BurnBatchERC1155Response
+	23, // 36: api.erc1155.v1.ERC1155.DeployERC1155:output_type -> api.erc1155.v1.DeployERC1155Response
+	27, // 37: api.erc1155.v1.ERC1155.AirdropERC1155:output_type -> api.erc1155.v1.AirdropERC1155Response
+	29, // 38: api.erc1155.v1.ERC1155.PauseERC1155:output_type -> api.erc1155.v1.PauseERC1155Response
+	31, // 39: api.erc1155.v1.ERC1155.UnpauseERC1155:output_type -> api.erc1155.v1.UnpauseERC1155Response
+	33, // 40: api.erc1155.v1.ERC1155.GetERC1155Paused:output_type -> api.erc1155.v1.GetERC1155PausedResponse
+	35, // 41: api.erc1155.v1.ERC1155.GetERC1155Owner:output_type -> api.erc1155.v1.GetERC1155OwnerResponse
+	37, // 42: api.erc1155.v1.ERC1155.TransferERC1155Ownership:output_type -> api.erc1155.v1.TransferERC1155OwnershipResponse
+	39, // 43: api.erc1155.v1.ERC1155.RenounceERC1155Ownership:output_type -> api.erc1155.v1.RenounceERC1155OwnershipResponse
+	24, // [24:44] is the sub-list for method output_type
+	4,  // [4:24] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_erc1155_v1_erc1155_proto_init() }
@@ -2065,7 +3794,7 @@ func file_erc1155_v1_erc1155_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_erc1155_v1_erc1155_proto_rawDesc), len(file_erc1155_v1_erc1155_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	ERC1155_GetERC1155Balance_FullMethodName        = "/api.erc1155.v1.ERC1155/GetERC1155Balance"
 	ERC1155_GetERC1155BalancesBatch_FullMethodName  = "/api.erc1155.v1.ERC1155/GetERC1155BalancesBatch"
 	ERC1155_GetERC1155TokenURI_FullMethodName       = "/api.erc1155.v1.ERC1155/GetERC1155TokenURI"
+	ERC1155_GetERC1155Metadata_FullMethodName       = "/api.erc1155.v1.ERC1155/GetERC1155Metadata"
 	ERC1155_IsApprovedForAllERC1155_FullMethodName  = "/api.erc1155.v1.ERC1155/IsApprovedForAllERC1155"
 	ERC1155_SafeTransferERC1155_FullMethodName      = "/api.erc1155.v1.ERC1155/SafeTransferERC1155"
 	ERC1155_SafeBatchTransferERC1155_FullMethodName = "/api.erc1155.v1.ERC1155/SafeBatchTransferERC1155"
@@ -31,6 +32,13 @@ const (
 	ERC1155_BurnERC1155_FullMethodName              = "/api.erc1155.v1.ERC1155/BurnERC1155"
 	ERC1155_BurnBatchERC1155_FullMethodName         = "/api.erc1155.v1.ERC1155/BurnBatchERC1155"
 	ERC1155_DeployERC1155_FullMethodName            = "/api.erc1155.v1.ERC1155/DeployERC1155"
+	ERC1155_AirdropERC1155_FullMethodName           = "/api.erc1155.v1.ERC1155/AirdropERC1155"
+	ERC1155_PauseERC1155_FullMethodName             = "/api.erc1155.v1.ERC1155/PauseERC1155"
+	ERC1155_UnpauseERC1155_FullMethodName           = "/api.erc1155.v1.ERC1155/UnpauseERC1155"
+	ERC1155_GetERC1155Paused_FullMethodName         = "/api.erc1155.v1.ERC1155/GetERC1155Paused"
+	ERC1155_GetERC1155Owner_FullMethodName          = "/api.erc1155.v1.ERC1155/GetERC1155Owner"
+	ERC1155_TransferERC1155Ownership_FullMethodName = "/api.erc1155.v1.ERC1155/TransferERC1155Ownership"
+	ERC1155_RenounceERC1155Ownership_FullMethodName = "/api.erc1155.v1.ERC1155/RenounceERC1155Ownership"
 )
 
 // ERC1155Client is the client API for ERC1155 service.
@@ -45,6 +53,8 @@ type ERC1155Client interface {
 	GetERC1155BalancesBatch(ctx context.Context, in *GetERC1155BalancesBatchRequest, opts ...grpc.CallOption) (*GetERC1155BalancesBatchResponse, error)
 	// GetERC1155TokenURI returns the URI for a specific token ID
 	GetERC1155TokenURI(ctx context.Context, in *GetERC1155TokenURIRequest, opts ...grpc.CallOption) (*GetERC1155TokenURIResponse, error)
+	// GetERC1155Metadata resolves the token URI and returns the normalized metadata document
+	GetERC1155Metadata(ctx context.Context, in *GetERC1155MetadataRequest, opts ...grpc.CallOption) (*GetERC1155MetadataResponse, error)
 	// IsApprovedForAllERC1155 checks if an operator is approved for all tokens of an owner
 	IsApprovedForAllERC1155(ctx context.Context, in *IsApprovedForAllERC1155Request, opts ...grpc.CallOption) (*IsApprovedForAllERC1155Response, error)
 	// SafeTransferERC1155 transfers an ERC1155 token from one address to another
@@ -63,6 +73,20 @@ type ERC1155Client interface {
 	BurnBatchERC1155(ctx context.Context, in *BurnBatchERC1155Request, opts ...grpc.CallOption) (*BurnBatchERC1155Response, error)
 	// DeployERC1155 deploys a new ERC1155 token contract
 	DeployERC1155(ctx context.Context, in *DeployERC1155Request, opts ...grpc.CallOption) (*DeployERC1155Response, error)
+	// AirdropERC1155 mints tokens to many recipients and reports the result per item
+	AirdropERC1155(ctx context.Context, in *AirdropERC1155Request, opts ...grpc.CallOption) (*AirdropERC1155Response, error)
+	// PauseERC1155 pauses all token transfers (owner only)
+	PauseERC1155(ctx context.Context, in *PauseERC1155Request, opts ...grpc.CallOption) (*PauseERC1155Response, error)
+	// UnpauseERC1155 resumes token transfers (owner only)
+	UnpauseERC1155(ctx context.Context, in *UnpauseERC1155Request, opts ...grpc.CallOption) (*UnpauseERC1155Response, error)
+	// GetERC1155Paused returns whether the contract is paused
+	GetERC1155Paused(ctx context.Context, in *GetERC1155PausedRequest, opts ...grpc.CallOption) (*GetERC1155PausedResponse, error)
+	// GetERC1155Owner returns the current owner of the contract
+	GetERC1155Owner(ctx context.Context, in *GetERC1155OwnerRequest, opts ...grpc.CallOption) (*GetERC1155OwnerResponse, error)
+	// TransferERC1155Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC1155Ownership(ctx context.Context, in *TransferERC1155OwnershipRequest, opts ...grpc.CallOption) (*TransferERC1155OwnershipResponse, error)
+	// RenounceERC1155Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC1155Ownership(ctx context.Context, in *RenounceERC1155OwnershipRequest, opts ...grpc.CallOption) (*RenounceERC1155OwnershipResponse, error)
 }
 
 type eRC1155Client struct {
@@ -103,6 +127,16 @@ func (c *eRC1155Client) GetERC1155TokenURI(ctx context.Context, in *GetERC1155To
 	return out, nil
 }
 
+func (c *eRC1155Client) GetERC1155Metadata(ctx context.Context, in *GetERC1155MetadataRequest, opts ...grpc.CallOption) (*GetERC1155MetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetERC1155MetadataResponse)
+	err := c.cc.Invoke(ctx, ERC1155_GetERC1155Metadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eRC1155Client) IsApprovedForAllERC1155(ctx context.Context, in *IsApprovedForAllERC1155Request, opts ...grpc.CallOption) (*IsApprovedForAllERC1155Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IsApprovedForAllERC1155Response)
@@ -193,6 +227,76 @@ func (c *eRC1155Client) DeployERC1155(ctx context.Context, in *DeployERC1155Requ
 	return out, nil
 }
 
+func (c *eRC1155Client) AirdropERC1155(ctx context.Context, in *AirdropERC1155Request, opts ...grpc.CallOption) (*AirdropERC1155Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AirdropERC1155Response)
+	err := c.cc.Invoke(ctx, ERC1155_AirdropERC1155_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC1155Client) PauseERC1155(ctx context.Context, in *PauseERC1155Request, opts ...grpc.CallOption) (*PauseERC1155Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseERC1155Response)
+	err := c.cc.Invoke(ctx, ERC1155_PauseERC1155_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC1155Client) UnpauseERC1155(ctx context.Context, in *UnpauseERC1155Request, opts ...grpc.CallOption) (*UnpauseERC1155Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpauseERC1155Response)
+	err := c.cc.Invoke(ctx, ERC1155_UnpauseERC1155_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC1155Client) GetERC1155Paused(ctx context.Context, in *GetERC1155PausedRequest, opts ...grpc.CallOption) (*GetERC1155PausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetERC1155PausedResponse)
+	err := c.cc.Invoke(ctx, ERC1155_GetERC1155Paused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC1155Client) GetERC1155Owner(ctx context.Context, in *GetERC1155OwnerRequest, opts ...grpc.CallOption) (*GetERC1155OwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetERC1155OwnerResponse)
+	err := c.cc.Invoke(ctx, ERC1155_GetERC1155Owner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC1155Client) TransferERC1155Ownership(ctx context.Context, in *TransferERC1155OwnershipRequest, opts ...grpc.CallOption) (*TransferERC1155OwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferERC1155OwnershipResponse)
+	err := c.cc.Invoke(ctx, ERC1155_TransferERC1155Ownership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC1155Client) RenounceERC1155Ownership(ctx context.Context, in *RenounceERC1155OwnershipRequest, opts ...grpc.CallOption) (*RenounceERC1155OwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenounceERC1155OwnershipResponse)
+	err := c.cc.Invoke(ctx, ERC1155_RenounceERC1155Ownership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ERC1155Server is the server API for ERC1155 service.
 // All implementations must embed UnimplementedERC1155Server
 // for forward compatibility.
@@ -205,6 +309,8 @@ type ERC1155Server interface {
 	GetERC1155BalancesBatch(context.Context, *GetERC1155BalancesBatchRequest) (*GetERC1155BalancesBatchResponse, error)
 	// GetERC1155TokenURI returns the URI for a specific token ID
 	GetERC1155TokenURI(context.Context, *GetERC1155TokenURIRequest) (*GetERC1155TokenURIResponse, error)
+	// GetERC1155Metadata resolves the token URI and returns the normalized metadata document
+	GetERC1155Metadata(context.Context, *GetERC1155MetadataRequest) (*GetERC1155MetadataResponse, error)
 	// IsApprovedForAllERC1155 checks if an operator is approved for all tokens of an owner
 	IsApprovedForAllERC1155(context.Context, *IsApprovedForAllERC1155Request) (*IsApprovedForAllERC1155Response, error)
 	// SafeTransferERC1155 transfers an ERC1155 token from one address to another
@@ -223,6 +329,20 @@ type ERC1155Server interface {
 	BurnBatchERC1155(context.Context, *BurnBatchERC1155Request) (*BurnBatchERC1155Response, error)
 	// DeployERC1155 deploys a new ERC1155 token contract
 	DeployERC1155(context.Context, *DeployERC1155Request) (*DeployERC1155Response, error)
+	// AirdropERC1155 mints tokens to many recipients and reports the result per item
+	AirdropERC1155(context.Context, *AirdropERC1155Request) (*AirdropERC1155Response, error)
+	// PauseERC1155 pauses all token transfers (owner only)
+	PauseERC1155(context.Context, *PauseERC1155Request) (*PauseERC1155Response, error)
+	// UnpauseERC1155 resumes token transfers (owner only)
+	UnpauseERC1155(context.Context, *UnpauseERC1155Request) (*UnpauseERC1155Response, error)
+	// GetERC1155Paused returns whether the contract is paused
+	GetERC1155Paused(context.Context, *GetERC1155PausedRequest) (*GetERC1155PausedResponse, error)
+	// GetERC1155Owner returns the current owner of the contract
+	GetERC1155Owner(context.Context, *GetERC1155OwnerRequest) (*GetERC1155OwnerResponse, error)
+	// TransferERC1155Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC1155Ownership(context.Context, *TransferERC1155OwnershipRequest) (*TransferERC1155OwnershipResponse, error)
+	// RenounceERC1155Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC1155Ownership(context.Context, *RenounceERC1155OwnershipRequest) (*RenounceERC1155OwnershipResponse, error)
 	mustEmbedUnimplementedERC1155Server()
 }
 
@@ -242,6 +362,9 @@ func (UnimplementedERC1155Server) GetERC1155BalancesBatch(context.Context, *GetE
 func (UnimplementedERC1155Server) GetERC1155TokenURI(context.Context, *GetERC1155TokenURIRequest) (*GetERC1155TokenURIResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetERC1155TokenURI not implemented")
 }
+func (UnimplementedERC1155Server) GetERC1155Metadata(context.Context, *GetERC1155MetadataRequest) (*GetERC1155MetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetERC1155Metadata not implemented")
+}
 func (UnimplementedERC1155Server) IsApprovedForAllERC1155(context.Context, *IsApprovedForAllERC1155Request) (*IsApprovedForAllERC1155Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method IsApprovedForAllERC1155 not implemented")
 }
@@ -269,6 +392,27 @@ func (UnimplementedERC1155Server) BurnBatchERC1155(context.Context, *BurnBatchER
 func (UnimplementedERC1155Server) DeployERC1155(context.Context, *DeployERC1155Request) (*DeployERC1155Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeployERC1155 not implemented")
 }
+func (UnimplementedERC1155Server) AirdropERC1155(context.Context, *AirdropERC1155Request) (*AirdropERC1155Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method AirdropERC1155 not implemented")
+}
+func (UnimplementedERC1155Server) PauseERC1155(context.Context, *PauseERC1155Request) (*PauseERC1155Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseERC1155 not implemented")
+}
+func (UnimplementedERC1155Server) UnpauseERC1155(context.Context, *UnpauseERC1155Request) (*UnpauseERC1155Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpauseERC1155 not implemented")
+}
+func (UnimplementedERC1155Server) GetERC1155Paused(context.Context, *GetERC1155PausedRequest) (*GetERC1155PausedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetERC1155Paused not implemented")
+}
+func (UnimplementedERC1155Server) GetERC1155Owner(context.Context, *GetERC1155OwnerRequest) (*GetERC1155OwnerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetERC1155Owner not implemented")
+}
+func (UnimplementedERC1155Server) TransferERC1155Ownership(context.Context, *TransferERC1155OwnershipRequest) (*TransferERC1155OwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferERC1155Ownership not implemented")
+}
+func (UnimplementedERC1155Server) RenounceERC1155Ownership(context.Context, *RenounceERC1155OwnershipRequest) (*RenounceERC1155OwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenounceERC1155Ownership not implemented")
+}
 func (UnimplementedERC1155Server) mustEmbedUnimplementedERC1155Server() {}
 func (UnimplementedERC1155Server) testEmbeddedByValue()                 {}
 
@@ -344,6 +488,24 @@ func _ERC1155_GetERC1155TokenURI_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ERC1155_GetERC1155Metadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetERC1155MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).GetERC1155Metadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_GetERC1155Metadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).GetERC1155Metadata(ctx, req.(*GetERC1155MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ERC1155_IsApprovedForAllERC1155_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsApprovedForAllERC1155Request)
 	if err := dec(in); err != nil {
@@ -506,6 +668,132 @@ func _ERC1155_DeployERC1155_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ERC1155_AirdropERC1155_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AirdropERC1155Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).AirdropERC1155(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_AirdropERC1155_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).AirdropERC1155(ctx, req.(*AirdropERC1155Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC1155_PauseERC1155_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseERC1155Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).PauseERC1155(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_PauseERC1155_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).PauseERC1155(ctx, req.(*PauseERC1155Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC1155_UnpauseERC1155_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseERC1155Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).UnpauseERC1155(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_UnpauseERC1155_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).UnpauseERC1155(ctx, req.(*UnpauseERC1155Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC1155_GetERC1155Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetERC1155PausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).GetERC1155Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_GetERC1155Paused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).GetERC1155Paused(ctx, req.(*GetERC1155PausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC1155_GetERC1155Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetERC1155OwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).GetERC1155Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_GetERC1155Owner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).GetERC1155Owner(ctx, req.(*GetERC1155OwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC1155_TransferERC1155Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferERC1155OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).TransferERC1155Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_TransferERC1155Ownership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).TransferERC1155Ownership(ctx, req.(*TransferERC1155OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC1155_RenounceERC1155Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenounceERC1155OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC1155Server).RenounceERC1155Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC1155_RenounceERC1155Ownership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC1155Server).RenounceERC1155Ownership(ctx, req.(*RenounceERC1155OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ERC1155_ServiceDesc is the grpc.ServiceDesc for ERC1155 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -525,6 +813,10 @@ var ERC1155_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetERC1155TokenURI",
 			Handler:    _ERC1155_GetERC1155TokenURI_Handler,
 		},
+		{
+			MethodName: "GetERC1155Metadata",
+			Handler:    _ERC1155_GetERC1155Metadata_Handler,
+		},
 		{
 			MethodName: "IsApprovedForAllERC1155",
 			Handler:    _ERC1155_IsApprovedForAllERC1155_Handler,
@@ -561,6 +853,34 @@ var ERC1155_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeployERC1155",
 			Handler:    _ERC1155_DeployERC1155_Handler,
 		},
+		{
+			MethodName: "AirdropERC1155",
+			Handler:    _ERC1155_AirdropERC1155_Handler,
+		},
+		{
+			MethodName: "PauseERC1155",
+			Handler:    _ERC1155_PauseERC1155_Handler,
+		},
+		{
+			MethodName: "UnpauseERC1155",
+			Handler:    _ERC1155_UnpauseERC1155_Handler,
+		},
+		{
+			MethodName: "GetERC1155Paused",
+			Handler:    _ERC1155_GetERC1155Paused_Handler,
+		},
+		{
+			MethodName: "GetERC1155Owner",
+			Handler:    _ERC1155_GetERC1155Owner_Handler,
+		},
+		{
+			MethodName: "TransferERC1155Ownership",
+			Handler:    _ERC1155_TransferERC1155Ownership_Handler,
+		},
+		{
+			MethodName: "RenounceERC1155Ownership",
+			Handler:    _ERC1155_RenounceERC1155Ownership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "erc1155/v1/erc1155.proto",
@@ -19,20 +19,30 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationERC1155AirdropERC1155 = "/api.erc1155.v1.ERC1155/AirdropERC1155"
 const OperationERC1155BurnBatchERC1155 = "/api.erc1155.v1.ERC1155/BurnBatchERC1155"
 const OperationERC1155BurnERC1155 = "/api.erc1155.v1.ERC1155/BurnERC1155"
 const OperationERC1155DeployERC1155 = "/api.erc1155.v1.ERC1155/DeployERC1155"
 const OperationERC1155GetERC1155Balance = "/api.erc1155.v1.ERC1155/GetERC1155Balance"
 const OperationERC1155GetERC1155BalancesBatch = "/api.erc1155.v1.ERC1155/GetERC1155BalancesBatch"
+const OperationERC1155GetERC1155Metadata = "/api.erc1155.v1.ERC1155/GetERC1155Metadata"
+const OperationERC1155GetERC1155Owner = "/api.erc1155.v1.ERC1155/GetERC1155Owner"
+const OperationERC1155GetERC1155Paused = "/api.erc1155.v1.ERC1155/GetERC1155Paused"
 const OperationERC1155GetERC1155TokenURI = "/api.erc1155.v1.ERC1155/GetERC1155TokenURI"
 const OperationERC1155IsApprovedForAllERC1155 = "/api.erc1155.v1.ERC1155/IsApprovedForAllERC1155"
 const OperationERC1155MintBatchERC1155 = "/api.erc1155.v1.ERC1155/MintBatchERC1155"
 const OperationERC1155MintERC1155 = "/api.erc1155.v1.ERC1155/MintERC1155"
+const OperationERC1155PauseERC1155 = "/api.erc1155.v1.ERC1155/PauseERC1155"
+const OperationERC1155RenounceERC1155Ownership = "/api.erc1155.v1.ERC1155/RenounceERC1155Ownership"
 const OperationERC1155SafeBatchTransferERC1155 = "/api.erc1155.v1.ERC1155/SafeBatchTransferERC1155"
 const OperationERC1155SafeTransferERC1155 = "/api.erc1155.v1.ERC1155/SafeTransferERC1155"
 const OperationERC1155SetApprovalForAllERC1155 = "/api.erc1155.v1.ERC1155/SetApprovalForAllERC1155"
+const OperationERC1155TransferERC1155Ownership = "/api.erc1155.v1.ERC1155/TransferERC1155Ownership"
+const OperationERC1155UnpauseERC1155 = "/api.erc1155.v1.ERC1155/UnpauseERC1155"
 
 type ERC1155HTTPServer interface {
+	// AirdropERC1155 AirdropERC1155 mints tokens to many recipients and reports the result per item
+	AirdropERC1155(context.Context, *AirdropERC1155Request) (*AirdropERC1155Response, error)
 	// BurnBatchERC1155 BurnBatchERC1155 burns multiple ERC1155 tokens
 	BurnBatchERC1155(context.Context, *BurnBatchERC1155Request) (*BurnBatchERC1155Response, error)
 	// BurnERC1155 BurnERC1155 burns ERC1155 tokens
@@ -43,6 +53,12 @@ type ERC1155HTTPServer interface {
 	GetERC1155Balance(context.Context, *GetERC1155BalanceRequest) (*GetERC1155BalanceResponse, error)
 	// GetERC1155BalancesBatch GetERC1155BalancesBatch returns the balance of multiple addresses for multiple token IDs
 	GetERC1155BalancesBatch(context.Context, *GetERC1155BalancesBatchRequest) (*GetERC1155BalancesBatchResponse, error)
+	// GetERC1155Metadata GetERC1155Metadata resolves the token URI and returns the normalized metadata document
+	GetERC1155Metadata(context.Context, *GetERC1155MetadataRequest) (*GetERC1155MetadataResponse, error)
+	// GetERC1155Owner GetERC1155Owner returns the current owner of the contract
+	GetERC1155Owner(context.Context, *GetERC1155OwnerRequest) (*GetERC1155OwnerResponse, error)
+	// GetERC1155Paused GetERC1155Paused returns whether the contract is paused
+	GetERC1155Paused(context.Context, *GetERC1155PausedRequest) (*GetERC1155PausedResponse, error)
 	// GetERC1155TokenURI GetERC1155TokenURI returns the URI for a specific token ID
 	GetERC1155TokenURI(context.Context, *GetERC1155TokenURIRequest) (*GetERC1155TokenURIResponse, error)
 	// IsApprovedForAllERC1155 IsApprovedForAllERC1155 checks if an operator is approved for all tokens of an owner
@@ -51,12 +67,20 @@ type ERC1155HTTPServer interface {
 	MintBatchERC1155(context.Context, *MintBatchERC1155Request) (*MintBatchERC1155Response, error)
 	// MintERC1155 MintERC1155 mints new ERC1155 tokens
 	MintERC1155(context.Context, *MintERC1155Request) (*MintERC1155Response, error)
+	// PauseERC1155 PauseERC1155 pauses all token transfers (owner only)
+	PauseERC1155(context.Context, *PauseERC1155Request) (*PauseERC1155Response, error)
+	// RenounceERC1155Ownership RenounceERC1155Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC1155Ownership(context.Context, *RenounceERC1155OwnershipRequest) (*RenounceERC1155OwnershipResponse, error)
 	// SafeBatchTransferERC1155 SafeBatchTransferERC1155 safely transfers multiple ERC1155 tokens
 	SafeBatchTransferERC1155(context.Context, *SafeBatchTransferERC1155Request) (*SafeBatchTransferERC1155Response, error)
 	// SafeTransferERC1155 SafeTransferERC1155 transfers an ERC1155 token from one address to another
 	SafeTransferERC1155(context.Context, *SafeTransferERC1155Request) (*SafeTransferERC1155Response, error)
 	// SetApprovalForAllERC1155 SetApprovalForAllERC1155 enables or disables approval for a third party ("operator") to manage all tokens
 	SetApprovalForAllERC1155(context.Context, *SetApprovalForAllERC1155Request) (*SetApprovalForAllERC1155Response, error)
+	// TransferERC1155Ownership TransferERC1155Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC1155Ownership(context.Context, *TransferERC1155OwnershipRequest) (*TransferERC1155OwnershipResponse, error)
+	// UnpauseERC1155 UnpauseERC1155 resumes token transfers (owner only)
+	UnpauseERC1155(context.Context, *UnpauseERC1155Request) (*UnpauseERC1155Response, error)
 }
 
 func RegisterERC1155HTTPServer(s *http.Server, srv ERC1155HTTPServer) {
@@ -64,6 +88,7 @@ func RegisterERC1155HTTPServer(s *http.Server, srv ERC1155HTTPServer) {
 	r.GET("/api/v1/erc1155/balance", _ERC1155_GetERC1155Balance0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc1155/balance-batch", _ERC1155_GetERC1155BalancesBatch0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc1155/token-uri", _ERC1155_GetERC1155TokenURI0_HTTP_Handler(srv))
+	r.GET("/api/v1/erc1155/metadata", _ERC1155_GetERC1155Metadata0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc1155/is-approved-for-all", _ERC1155_IsApprovedForAllERC11550_HTTP_Handler(srv))
 	r.POST("/api/v1/erc1155/safe-transfer", _ERC1155_SafeTransferERC11550_HTTP_Handler(srv))
 	r.POST("/api/v1/erc1155/safe-batch-transfer", _ERC1155_SafeBatchTransferERC11550_HTTP_Handler(srv))
@@ -73,6 +98,13 @@ func RegisterERC1155HTTPServer(s *http.Server, srv ERC1155HTTPServer) {
 	r.POST("/api/v1/erc1155/burn", _ERC1155_BurnERC11550_HTTP_Handler(srv))
 	r.POST("/api/v1/erc1155/burn-batch", _ERC1155_BurnBatchERC11550_HTTP_Handler(srv))
 	r.POST("/api/v1/erc1155/deploy", _ERC1155_DeployERC11550_HTTP_Handler(srv))
+	r.POST("/api/v1/erc1155/airdrop", _ERC1155_AirdropERC11550_HTTP_Handler(srv))
+	r.POST("/api/v1/erc1155/pause", _ERC1155_PauseERC11550_HTTP_Handler(srv))
+	r.POST("/api/v1/erc1155/unpause", _ERC1155_UnpauseERC11550_HTTP_Handler(srv))
+	r.GET("/api/v1/erc1155/paused", _ERC1155_GetERC1155Paused0_HTTP_Handler(srv))
+	r.GET("/api/v1/erc1155/owner", _ERC1155_GetERC1155Owner0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc1155/transfer-ownership", _ERC1155_TransferERC1155Ownership0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc1155/renounce-ownership", _ERC1155_RenounceERC1155Ownership0_HTTP_Handler(srv))
 }
 
 func _ERC1155_GetERC1155Balance0_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
@@ -132,6 +164,25 @@ func _ERC1155_GetERC1155TokenURI0_HTTP_Handler(srv ERC1155HTTPServer) func(ctx h
 	}
 }
 
+func _ERC1155_GetERC1155Metadata0_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetERC1155MetadataRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155GetERC1155Metadata)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetERC1155Metadata(ctx, req.(*GetERC1155MetadataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetERC1155MetadataResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ERC1155_IsApprovedForAllERC11550_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in IsApprovedForAllERC1155Request
@@ -327,7 +378,157 @@ func _ERC1155_DeployERC11550_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.C
 	}
 }
 
+func _ERC1155_AirdropERC11550_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AirdropERC1155Request
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155AirdropERC1155)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AirdropERC1155(ctx, req.(*AirdropERC1155Request))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AirdropERC1155Response)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC1155_PauseERC11550_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PauseERC1155Request
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155PauseERC1155)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseERC1155(ctx, req.(*PauseERC1155Request))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PauseERC1155Response)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC1155_UnpauseERC11550_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnpauseERC1155Request
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155UnpauseERC1155)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnpauseERC1155(ctx, req.(*UnpauseERC1155Request))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnpauseERC1155Response)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC1155_GetERC1155Paused0_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetERC1155PausedRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155GetERC1155Paused)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetERC1155Paused(ctx, req.(*GetERC1155PausedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetERC1155PausedResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC1155_GetERC1155Owner0_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetERC1155OwnerRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155GetERC1155Owner)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetERC1155Owner(ctx, req.(*GetERC1155OwnerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetERC1155OwnerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC1155_TransferERC1155Ownership0_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferERC1155OwnershipRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155TransferERC1155Ownership)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TransferERC1155Ownership(ctx, req.(*TransferERC1155OwnershipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferERC1155OwnershipResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC1155_RenounceERC1155Ownership0_HTTP_Handler(srv ERC1155HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenounceERC1155OwnershipRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC1155RenounceERC1155Ownership)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenounceERC1155Ownership(ctx, req.(*RenounceERC1155OwnershipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenounceERC1155OwnershipResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ERC1155HTTPClient interface {
+	// AirdropERC1155 AirdropERC1155 mints tokens to many recipients and reports the result per item
+	AirdropERC1155(ctx context.Context, req *AirdropERC1155Request, opts ...http.CallOption) (rsp *AirdropERC1155Response, err error)
 	// BurnBatchERC1155 BurnBatchERC1155 burns multiple ERC1155 tokens
 	BurnBatchERC1155(ctx context.Context, req *BurnBatchERC1155Request, opts ...http.CallOption) (rsp *BurnBatchERC1155Response, err error)
 	// BurnERC1155 BurnERC1155 burns ERC1155 tokens
@@ -338,6 +539,12 @@ type ERC1155HTTPClient interface {
 	GetERC1155Balance(ctx context.Context, req *GetERC1155BalanceRequest, opts ...http.CallOption) (rsp *GetERC1155BalanceResponse, err error)
 	// GetERC1155BalancesBatch GetERC1155BalancesBatch returns the balance of multiple addresses for multiple token IDs
 	GetERC1155BalancesBatch(ctx context.Context, req *GetERC1155BalancesBatchRequest, opts ...http.CallOption) (rsp *GetERC1155BalancesBatchResponse, err error)
+	// GetERC1155Metadata GetERC1155Metadata resolves the token URI and returns the normalized metadata document
+	GetERC1155Metadata(ctx context.Context, req *GetERC1155MetadataRequest, opts ...http.CallOption) (rsp *GetERC1155MetadataResponse, err error)
+	// GetERC1155Owner GetERC1155Owner returns the current owner of the contract
+	GetERC1155Owner(ctx context.Context, req *GetERC1155OwnerRequest, opts ...http.CallOption) (rsp *GetERC1155OwnerResponse, err error)
+	// GetERC1155Paused GetERC1155Paused returns whether the contract is paused
+	GetERC1155Paused(ctx context.Context, req *GetERC1155PausedRequest, opts ...http.CallOption) (rsp *GetERC1155PausedResponse, err error)
 	// GetERC1155TokenURI GetERC1155TokenURI returns the URI for a specific token ID
 	GetERC1155TokenURI(ctx context.Context, req *GetERC1155TokenURIRequest, opts ...http.CallOption) (rsp *GetERC1155TokenURIResponse, err error)
 	// IsApprovedForAllERC1155 IsApprovedForAllERC1155 checks if an operator is approved for all tokens of an owner
@@ -346,12 +553,20 @@ type ERC1155HTTPClient interface {
 	MintBatchERC1155(ctx context.Context, req *MintBatchERC1155Request, opts ...http.CallOption) (rsp *MintBatchERC1155Response, err error)
 	// MintERC1155 MintERC1155 mints new ERC1155 tokens
 	MintERC1155(ctx context.Context, req *MintERC1155Request, opts ...http.CallOption) (rsp *MintERC1155Response, err error)
+	// PauseERC1155 PauseERC1155 pauses all token transfers (owner only)
+	PauseERC1155(ctx context.Context, req *PauseERC1155Request, opts ...http.CallOption) (rsp *PauseERC1155Response, err error)
+	// RenounceERC1155Ownership RenounceERC1155Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC1155Ownership(ctx context.Context, req *RenounceERC1155OwnershipRequest, opts ...http.CallOption) (rsp *RenounceERC1155OwnershipResponse, err error)
 	// SafeBatchTransferERC1155 SafeBatchTransferERC1155 safely transfers multiple ERC1155 tokens
 	SafeBatchTransferERC1155(ctx context.Context, req *SafeBatchTransferERC1155Request, opts ...http.CallOption) (rsp *SafeBatchTransferERC1155Response, err error)
 	// SafeTransferERC1155 SafeTransferERC1155 transfers an ERC1155 token from one address to another
 	SafeTransferERC1155(ctx context.Context, req *SafeTransferERC1155Request, opts ...http.CallOption) (rsp *SafeTransferERC1155Response, err error)
 	// SetApprovalForAllERC1155 SetApprovalForAllERC1155 enables or disables approval for a third party ("operator") to manage all tokens
 	SetApprovalForAllERC1155(ctx context.Context, req *SetApprovalForAllERC1155Request, opts ...http.CallOption) (rsp *SetApprovalForAllERC1155Response, err error)
+	// TransferERC1155Ownership TransferERC1155Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC1155Ownership(ctx context.Context, req *TransferERC1155OwnershipRequest, opts ...http.CallOption) (rsp *TransferERC1155OwnershipResponse, err error)
+	// UnpauseERC1155 UnpauseERC1155 resumes token transfers (owner only)
+	UnpauseERC1155(ctx context.Context, req *UnpauseERC1155Request, opts ...http.CallOption) (rsp *UnpauseERC1155Response, err error)
 }
 
 type ERC1155HTTPClientImpl struct {
@@ -362,6 +577,20 @@ func NewERC1155HTTPClient(client *http.Client) ERC1155HTTPClient {
 	return &ERC1155HTTPClientImpl{client}
 }
 
+// AirdropERC1155 AirdropERC1155 mints tokens to many recipients and reports the result per item
+func (c *ERC1155HTTPClientImpl) AirdropERC1155(ctx context.Context, in *AirdropERC1155Request, opts ...http.CallOption) (*AirdropERC1155Response, error) {
+	var out AirdropERC1155Response
+	pattern := "/api/v1/erc1155/airdrop"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC1155AirdropERC1155))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // BurnBatchERC1155 BurnBatchERC1155 burns multiple ERC1155 tokens
 func (c *ERC1155HTTPClientImpl) BurnBatchERC1155(ctx context.Context, in *BurnBatchERC1155Request, opts ...http.CallOption) (*BurnBatchERC1155Response, error) {
 	var out BurnBatchERC1155Response
@@ -432,6 +661,48 @@ func (c *ERC1155HTTPClientImpl) GetERC1155BalancesBatch(ctx context.Context, in
 	return &out, nil
 }
 
+// GetERC1155Metadata GetERC1155Metadata resolves the token URI and returns the normalized metadata document
+func (c *ERC1155HTTPClientImpl) GetERC1155Metadata(ctx context.Context, in *GetERC1155MetadataRequest, opts ...http.CallOption) (*GetERC1155MetadataResponse, error) {
+	var out GetERC1155MetadataResponse
+	pattern := "/api/v1/erc1155/metadata"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationERC1155GetERC1155Metadata))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetERC1155Owner GetERC1155Owner returns the current owner of the contract
+func (c *ERC1155HTTPClientImpl) GetERC1155Owner(ctx context.Context, in *GetERC1155OwnerRequest, opts ...http.CallOption) (*GetERC1155OwnerResponse, error) {
+	var out GetERC1155OwnerResponse
+	pattern := "/api/v1/erc1155/owner"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationERC1155GetERC1155Owner))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetERC1155Paused GetERC1155Paused returns whether the contract is paused
+func (c *ERC1155HTTPClientImpl) GetERC1155Paused(ctx context.Context, in *GetERC1155PausedRequest, opts ...http.CallOption) (*GetERC1155PausedResponse, error) {
+	var out GetERC1155PausedResponse
+	pattern := "/api/v1/erc1155/paused"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationERC1155GetERC1155Paused))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetERC1155TokenURI GetERC1155TokenURI returns the URI for a specific token ID
 func (c *ERC1155HTTPClientImpl) GetERC1155TokenURI(ctx context.Context, in *GetERC1155TokenURIRequest, opts ...http.CallOption) (*GetERC1155TokenURIResponse, error) {
 	var out GetERC1155TokenURIResponse
@@ -488,6 +759,34 @@ func (c *ERC1155HTTPClientImpl) MintERC1155(ctx context.Context, in *MintERC1155
 	return &out, nil
 }
 
+// PauseERC1155 PauseERC1155 pauses all token transfers (owner only)
+func (c *ERC1155HTTPClientImpl) PauseERC1155(ctx context.Context, in *PauseERC1155Request, opts ...http.CallOption) (*PauseERC1155Response, error) {
+	var out PauseERC1155Response
+	pattern := "/api/v1/erc1155/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC1155PauseERC1155))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RenounceERC1155Ownership RenounceERC1155Ownership leaves the contract without an owner (owner only, irreversible)
+func (c *ERC1155HTTPClientImpl) RenounceERC1155Ownership(ctx context.Context, in *RenounceERC1155OwnershipRequest, opts ...http.CallOption) (*RenounceERC1155OwnershipResponse, error) {
+	var out RenounceERC1155OwnershipResponse
+	pattern := "/api/v1/erc1155/renounce-ownership"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC1155RenounceERC1155Ownership))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SafeBatchTransferERC1155 SafeBatchTransferERC1155 safely transfers multiple ERC1155 tokens
 func (c *ERC1155HTTPClientImpl) SafeBatchTransferERC1155(ctx context.Context, in *SafeBatchTransferERC1155Request, opts ...http.CallOption) (*SafeBatchTransferERC1155Response, error) {
 	var out SafeBatchTransferERC1155Response
@@ -529,3 +828,31 @@ func (c *ERC1155HTTPClientImpl) SetApprovalForAllERC1155(ctx context.Context, in
 	}
 	return &out, nil
 }
+
+// TransferERC1155Ownership TransferERC1155Ownership transfers ownership of the contract to a new owner (owner only)
+func (c *ERC1155HTTPClientImpl) TransferERC1155Ownership(ctx context.Context, in *TransferERC1155OwnershipRequest, opts ...http.CallOption) (*TransferERC1155OwnershipResponse, error) {
+	var out TransferERC1155OwnershipResponse
+	pattern := "/api/v1/erc1155/transfer-ownership"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC1155TransferERC1155Ownership))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnpauseERC1155 UnpauseERC1155 resumes token transfers (owner only)
+func (c *ERC1155HTTPClientImpl) UnpauseERC1155(ctx context.Context, in *UnpauseERC1155Request, opts ...http.CallOption) (*UnpauseERC1155Response, error) {
+	var out UnpauseERC1155Response
+	pattern := "/api/v1/erc1155/unpause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC1155UnpauseERC1155))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
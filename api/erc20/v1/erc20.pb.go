@@ -26,7 +26,7 @@ type GetERC20BalanceRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
 	OwnerAddress    string                 `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Address to query balance for
-	ContractType    string                 `protobuf:"bytes,3,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`          // Contract type: "standard" or "ownable" (default: "standard")
+	ContractType    string                 `protobuf:"bytes,3,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`          // Contract type: "standard" or "ownable" (default: detected from the contract)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -83,13 +83,14 @@ func (x *GetERC20BalanceRequest) GetContractType() string {
 }
 
 type GetERC20BalanceResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Balance         string                 `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`                                        // Token balance (as string to handle large numbers)
-	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
-	OwnerAddress    string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Owner address
-	Decimals        uint32                 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`                                     // Token decimals
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Balance          string                 `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`                                           // Token balance (as string to handle large numbers)
+	ContractAddress  string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`    // Contract address
+	OwnerAddress     string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`             // Owner address
+	Decimals         uint32                 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`                                        // Token decimals
+	BalanceFormatted string                 `protobuf:"bytes,5,opt,name=balance_formatted,json=balanceFormatted,proto3" json:"balance_formatted,omitempty"` // Balance in whole tokens (e.g. "12.5")
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetERC20BalanceResponse) Reset() {
@@ -150,10 +151,17 @@ func (x *GetERC20BalanceResponse) GetDecimals() uint32 {
 	return 0
 }
 
+func (x *GetERC20BalanceResponse) GetBalanceFormatted() string {
+	if x != nil {
+		return x.BalanceFormatted
+	}
+	return ""
+}
+
 type GetERC20InfoRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
-	ContractType    string                 `protobuf:"bytes,2,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`          // Contract type: "standard" or "ownable" (default: "standard")
+	ContractType    string                 `protobuf:"bytes,2,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`          // Contract type: "standard" or "ownable" (default: detected from the contract)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -203,14 +211,15 @@ func (x *GetERC20InfoRequest) GetContractType() string {
 }
 
 type GetERC20InfoResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // Token name
-	Symbol          string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`                                          // Token symbol
-	Decimals        uint32                 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`                                     // Token decimals
-	TotalSupply     string                 `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`             // Total supply (as string to handle large numbers)
-	ContractAddress string                 `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                               // Token name
+	Symbol               string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`                                                           // Token symbol
+	Decimals             uint32                 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`                                                      // Token decimals
+	TotalSupply          string                 `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`                              // Total supply (as string to handle large numbers)
+	ContractAddress      string                 `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`                  // Contract address
+	TotalSupplyFormatted string                 `protobuf:"bytes,6,opt,name=total_supply_formatted,json=totalSupplyFormatted,proto3" json:"total_supply_formatted,omitempty"` // Total supply in whole tokens
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GetERC20InfoResponse) Reset() {
@@ -278,12 +287,22 @@ func (x *GetERC20InfoResponse) GetContractAddress() string {
 	return ""
 }
 
+func (x *GetERC20InfoResponse) GetTotalSupplyFormatted() string {
+	if x != nil {
+		return x.TotalSupplyFormatted
+	}
+	return ""
+}
+
 type TransferERC20Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
 	ToAddress       string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Recipient address
 	Amount          string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to transfer (as string to handle large numbers)
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, 64 characters, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	Unit            string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of amount: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
+	Signer          string                 `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`                                          // HD wallet account hd:<index> that signs instead of private_key (requires an admin API token)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -346,6 +365,27 @@ func (x *TransferERC20Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *TransferERC20Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *TransferERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *TransferERC20Request) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
 type TransferERC20Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -353,6 +393,8 @@ type TransferERC20Response struct {
 	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Sender address
 	ToAddress       string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Recipient address
 	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount transferred
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	AmountFormatted string                 `protobuf:"bytes,7,opt,name=amount_formatted,json=amountFormatted,proto3" json:"amount_formatted,omitempty"` // Amount in whole tokens
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -422,12 +464,28 @@ func (x *TransferERC20Response) GetAmount() string {
 	return ""
 }
 
+func (x *TransferERC20Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *TransferERC20Response) GetAmountFormatted() string {
+	if x != nil {
+		return x.AmountFormatted
+	}
+	return ""
+}
+
 type ApproveERC20Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
 	SpenderAddress  string                 `protobuf:"bytes,2,opt,name=spender_address,json=spenderAddress,proto3" json:"spender_address,omitempty"`    // Spender address
 	Amount          string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to approve (as string to handle large numbers)
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, 64 characters, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	Unit            string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of amount: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -490,6 +548,20 @@ func (x *ApproveERC20Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *ApproveERC20Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *ApproveERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type ApproveERC20Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -497,6 +569,8 @@ type ApproveERC20Response struct {
 	OwnerAddress    string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Owner address
 	SpenderAddress  string                 `protobuf:"bytes,4,opt,name=spender_address,json=spenderAddress,proto3" json:"spender_address,omitempty"`    // Spender address
 	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Approved amount
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	AmountFormatted string                 `protobuf:"bytes,7,opt,name=amount_formatted,json=amountFormatted,proto3" json:"amount_formatted,omitempty"` // Amount in whole tokens
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -566,6 +640,20 @@ func (x *ApproveERC20Response) GetAmount() string {
 	return ""
 }
 
+func (x *ApproveERC20Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ApproveERC20Response) GetAmountFormatted() string {
+	if x != nil {
+		return x.AmountFormatted
+	}
+	return ""
+}
+
 type GetERC20AllowanceRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
@@ -627,13 +715,14 @@ func (x *GetERC20AllowanceRequest) GetSpenderAddress() string {
 }
 
 type GetERC20AllowanceResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Allowance       string                 `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`                                    // Allowed amount (as string to handle large numbers)
-	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
-	OwnerAddress    string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Owner address
-	SpenderAddress  string                 `protobuf:"bytes,4,opt,name=spender_address,json=spenderAddress,proto3" json:"spender_address,omitempty"`    // Spender address
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Allowance          string                 `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`                                             // Allowed amount (as string to handle large numbers)
+	ContractAddress    string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`          // Contract address
+	OwnerAddress       string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`                   // Owner address
+	SpenderAddress     string                 `protobuf:"bytes,4,opt,name=spender_address,json=spenderAddress,proto3" json:"spender_address,omitempty"`             // Spender address
+	AllowanceFormatted string                 `protobuf:"bytes,5,opt,name=allowance_formatted,json=allowanceFormatted,proto3" json:"allowance_formatted,omitempty"` // Allowance in whole tokens
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetERC20AllowanceResponse) Reset() {
@@ -694,6 +783,13 @@ func (x *GetERC20AllowanceResponse) GetSpenderAddress() string {
 	return ""
 }
 
+func (x *GetERC20AllowanceResponse) GetAllowanceFormatted() string {
+	if x != nil {
+		return x.AllowanceFormatted
+	}
+	return ""
+}
+
 type TransferFromERC20Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
@@ -701,6 +797,8 @@ type TransferFromERC20Request struct {
 	ToAddress       string                 `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Recipient address
 	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to transfer (as string to handle large numbers)
 	PrivateKey      string                 `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key of the spender (hex encoded, 64 characters, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	Unit            string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of amount: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -770,6 +868,20 @@ func (x *TransferFromERC20Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *TransferFromERC20Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *TransferFromERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type TransferFromERC20Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -777,6 +889,8 @@ type TransferFromERC20Response struct {
 	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Address transferred from
 	ToAddress       string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Recipient address
 	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount transferred
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	AmountFormatted string                 `protobuf:"bytes,7,opt,name=amount_formatted,json=amountFormatted,proto3" json:"amount_formatted,omitempty"` // Amount in whole tokens
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -846,12 +960,28 @@ func (x *TransferFromERC20Response) GetAmount() string {
 	return ""
 }
 
+func (x *TransferFromERC20Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *TransferFromERC20Response) GetAmountFormatted() string {
+	if x != nil {
+		return x.AmountFormatted
+	}
+	return ""
+}
+
 type MintERC20Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
 	ToAddress       string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Address to mint tokens to
 	Amount          string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to mint (as string to handle large numbers)
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, 64 characters, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	Unit            string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of amount: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -914,12 +1044,28 @@ func (x *MintERC20Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *MintERC20Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *MintERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type MintERC20Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
 	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
 	ToAddress       string                 `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Address that received minted tokens
 	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount minted
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	AmountFormatted string                 `protobuf:"bytes,6,opt,name=amount_formatted,json=amountFormatted,proto3" json:"amount_formatted,omitempty"` // Amount in whole tokens
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -982,11 +1128,27 @@ func (x *MintERC20Response) GetAmount() string {
 	return ""
 }
 
+func (x *MintERC20Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *MintERC20Response) GetAmountFormatted() string {
+	if x != nil {
+		return x.AmountFormatted
+	}
+	return ""
+}
+
 type BurnERC20Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
 	Amount          string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to burn (as string to handle large numbers)
 	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, 64 characters, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	Unit            string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of amount: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1042,12 +1204,28 @@ func (x *BurnERC20Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *BurnERC20Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *BurnERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type BurnERC20Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
 	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
 	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Address that burned tokens
 	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount burned
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	AmountFormatted string                 `protobuf:"bytes,6,opt,name=amount_formatted,json=amountFormatted,proto3" json:"amount_formatted,omitempty"` // Amount in whole tokens
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1110,12 +1288,28 @@ func (x *BurnERC20Response) GetAmount() string {
 	return ""
 }
 
+func (x *BurnERC20Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BurnERC20Response) GetAmountFormatted() string {
+	if x != nil {
+		return x.AmountFormatted
+	}
+	return ""
+}
+
 type BurnFromERC20Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
 	FromAddress     string                 `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Address to burn tokens from
 	Amount          string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount to burn (as string to handle large numbers)
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key of the spender (hex encoded, 64 characters, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	Unit            string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of amount: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1178,12 +1372,28 @@ func (x *BurnFromERC20Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *BurnFromERC20Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *BurnFromERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type BurnFromERC20Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
 	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
 	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Address that tokens were burned from
 	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount burned
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	AmountFormatted string                 `protobuf:"bytes,6,opt,name=amount_formatted,json=amountFormatted,proto3" json:"amount_formatted,omitempty"` // Amount in whole tokens
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1246,6 +1456,20 @@ func (x *BurnFromERC20Response) GetAmount() string {
 	return ""
 }
 
+func (x *BurnFromERC20Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BurnFromERC20Response) GetAmountFormatted() string {
+	if x != nil {
+		return x.AmountFormatted
+	}
+	return ""
+}
+
 type DeployERC20Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                        // Token name (e.g., "My Token")
@@ -1255,6 +1479,8 @@ type DeployERC20Request struct {
 	PrivateKey    string                 `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`          // Private key for deployment (hex encoded, 64 characters, with or without 0x prefix)
 	ContractType  string                 `protobuf:"bytes,6,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`    // Contract type: "standard" or "ownable" (default: "standard")
 	UseAdmin      bool                   `protobuf:"varint,7,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`               // Use admin address as owner for ownable contract (default: false)
+	Async         bool                   `protobuf:"varint,8,opt,name=async,proto3" json:"async,omitempty"`                                     // Submit as an asynchronous job and return immediately
+	Unit          string                 `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit,omitempty"`                                        // Unit of initial_supply: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1338,17 +1564,33 @@ func (x *DeployERC20Request) GetUseAdmin() bool {
 	return false
 }
 
+func (x *DeployERC20Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *DeployERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type DeployERC20Response struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Deployment transaction hash
-	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Deployed contract address
-	DeployerAddress string                 `protobuf:"bytes,3,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"` // Address that deployed the contract
-	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                              // Token name
-	Symbol          string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`                                          // Token symbol
-	Decimals        uint32                 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`                                     // Token decimals
-	InitialSupply   string                 `protobuf:"bytes,7,opt,name=initial_supply,json=initialSupply,proto3" json:"initial_supply,omitempty"`       // Initial supply
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TxHash                 string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                                                   // Deployment transaction hash
+	ContractAddress        string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`                        // Deployed contract address
+	DeployerAddress        string                 `protobuf:"bytes,3,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`                        // Address that deployed the contract
+	Name                   string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                                                     // Token name
+	Symbol                 string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`                                                                 // Token symbol
+	Decimals               uint32                 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`                                                            // Token decimals
+	InitialSupply          string                 `protobuf:"bytes,7,opt,name=initial_supply,json=initialSupply,proto3" json:"initial_supply,omitempty"`                              // Initial supply
+	JobId                  string                 `protobuf:"bytes,8,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                                                      // Job ID (set when submitted asynchronously)
+	InitialSupplyFormatted string                 `protobuf:"bytes,9,opt,name=initial_supply_formatted,json=initialSupplyFormatted,proto3" json:"initial_supply_formatted,omitempty"` // Initial supply in whole tokens
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *DeployERC20Response) Reset() {
@@ -1430,113 +1672,1379 @@ func (x *DeployERC20Response) GetInitialSupply() string {
 	return ""
 }
 
-var File_erc20_v1_erc20_proto protoreflect.FileDescriptor
+func (x *DeployERC20Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
 
-const file_erc20_v1_erc20_proto_rawDesc = "" +
-	"\n" +
-	"\x14erc20/v1/erc20.proto\x12\fapi.erc20.v1\x1a\x1cgoogle/api/annotations.proto\"\x8d\x01\n" +
-	"\x16GetERC20BalanceRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\x12#\n" +
-	"\rcontract_type\x18\x03 \x01(\tR\fcontractType\"\x9f\x01\n" +
-	"\x17GetERC20BalanceResponse\x12\x18\n" +
-	"\abalance\x18\x01 \x01(\tR\abalance\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12\x1a\n" +
-	"\bdecimals\x18\x04 \x01(\rR\bdecimals\"e\n" +
-	"\x13GetERC20InfoRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rcontract_type\x18\x02 \x01(\tR\fcontractType\"\xac\x01\n" +
-	"\x14GetERC20InfoResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1a\n" +
-	"\bdecimals\x18\x03 \x01(\rR\bdecimals\x12!\n" +
-	"\ftotal_supply\x18\x04 \x01(\tR\vtotalSupply\x12)\n" +
-	"\x10contract_address\x18\x05 \x01(\tR\x0fcontractAddress\"\x99\x01\n" +
-	"\x14TransferERC20Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\xb5\x01\n" +
-	"\x15TransferERC20Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\tR\x06amount\"\xa2\x01\n" +
-	"\x13ApproveERC20Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
-	"\x0fspender_address\x18\x02 \x01(\tR\x0espenderAddress\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\xc0\x01\n" +
-	"\x14ApproveERC20Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12'\n" +
-	"\x0fspender_address\x18\x04 \x01(\tR\x0espenderAddress\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\tR\x06amount\"\x93\x01\n" +
-	"\x18GetERC20AllowanceRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\x12'\n" +
-	"\x0fspender_address\x18\x03 \x01(\tR\x0espenderAddress\"\xb2\x01\n" +
-	"\x19GetERC20AllowanceResponse\x12\x1c\n" +
-	"\tallowance\x18\x01 \x01(\tR\tallowance\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12'\n" +
-	"\x0fspender_address\x18\x04 \x01(\tR\x0espenderAddress\"\xc0\x01\n" +
-	"\x18TransferFromERC20Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1f\n" +
-	"\vprivate_key\x18\x05 \x01(\tR\n" +
-	"privateKey\"\xb9\x01\n" +
-	"\x19TransferFromERC20Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\tR\x06amount\"\x95\x01\n" +
-	"\x10MintERC20Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\x8e\x01\n" +
-	"\x11MintERC20Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\tR\x06amount\"v\n" +
-	"\x10BurnERC20Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1f\n" +
-	"\vprivate_key\x18\x03 \x01(\tR\n" +
-	"privateKey\"\x92\x01\n" +
-	"\x11BurnERC20Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\tR\x06amount\"\x9d\x01\n" +
-	"\x14BurnFromERC20Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\x96\x01\n" +
-	"\x15BurnFromERC20Response\x12\x17\n" +
+func (x *DeployERC20Response) GetInitialSupplyFormatted() string {
+	if x != nil {
+		return x.InitialSupplyFormatted
+	}
+	return ""
+}
+
+type BatchTransferRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToAddress     string                 `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"` // Recipient address
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                        // Amount to transfer (as string to handle large numbers)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTransferRow) Reset() {
+	*x = BatchTransferRow{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransferRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferRow) ProtoMessage() {}
+
+func (x *BatchTransferRow) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferRow.ProtoReflect.Descriptor instead.
+func (*BatchTransferRow) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchTransferRow) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *BatchTransferRow) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type BatchTransferERC20Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract address
+	Rows            []*BatchTransferRow    `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`                                              // Rows to transfer (inline input)
+	Csv             string                 `protobuf:"bytes,3,opt,name=csv,proto3" json:"csv,omitempty"`                                                // CSV content with to_address,amount columns (alternative to rows)
+	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, 64 characters, with or without 0x prefix)
+	BatchId         string                 `protobuf:"bytes,5,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`                         // Resume a previous batch; rows and csv are ignored
+	Unit            string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of the row amounts: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchTransferERC20Request) Reset() {
+	*x = BatchTransferERC20Request{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransferERC20Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferERC20Request) ProtoMessage() {}
+
+func (x *BatchTransferERC20Request) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferERC20Request.ProtoReflect.Descriptor instead.
+func (*BatchTransferERC20Request) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchTransferERC20Request) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Request) GetRows() []*BatchTransferRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *BatchTransferERC20Request) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Request) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Request) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Request) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type BatchTransferResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Index           int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                           // Row index in the original input
+	ToAddress       string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Recipient address
+	Amount          string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Amount transferred
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                          // pending, submitted, confirmed or failed
+	TxHash          string                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash carrying the row
+	Error           string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                                            // Error message for failed rows
+	AmountFormatted string                 `protobuf:"bytes,7,opt,name=amount_formatted,json=amountFormatted,proto3" json:"amount_formatted,omitempty"` // Amount in whole tokens
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchTransferResult) Reset() {
+	*x = BatchTransferResult{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransferResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferResult) ProtoMessage() {}
+
+func (x *BatchTransferResult) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferResult.ProtoReflect.Descriptor instead.
+func (*BatchTransferResult) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchTransferResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchTransferResult) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *BatchTransferResult) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BatchTransferResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchTransferResult) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *BatchTransferResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchTransferResult) GetAmountFormatted() string {
+	if x != nil {
+		return x.AmountFormatted
+	}
+	return ""
+}
+
+type BatchTransferERC20Response struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BatchId              string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`                                          // Batch ID, used to resume the batch
+	ContractAddress      string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`                  // Contract address
+	FromAddress          string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`                              // Sender address
+	TotalAmount          string                 `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                              // Sum of all row amounts
+	Method               string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`                                                           // sequential or disperse
+	Succeeded            int32                  `protobuf:"varint,6,opt,name=succeeded,proto3" json:"succeeded,omitempty"`                                                    // Rows submitted or confirmed
+	Failed               int32                  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`                                                          // Rows that failed and can be resumed
+	Results              []*BatchTransferResult `protobuf:"bytes,8,rep,name=results,proto3" json:"results,omitempty"`                                                         // Per-row results in input order
+	TotalAmountFormatted string                 `protobuf:"bytes,9,opt,name=total_amount_formatted,json=totalAmountFormatted,proto3" json:"total_amount_formatted,omitempty"` // Sum of all row amounts in whole tokens
+	Pending              int32                  `protobuf:"varint,10,opt,name=pending,proto3" json:"pending,omitempty"`                                                       // Rows not sent yet because of batch.max_txs_per_request, resume with batch_id
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BatchTransferERC20Response) Reset() {
+	*x = BatchTransferERC20Response{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransferERC20Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferERC20Response) ProtoMessage() {}
+
+func (x *BatchTransferERC20Response) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferERC20Response.ProtoReflect.Descriptor instead.
+func (*BatchTransferERC20Response) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchTransferERC20Response) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Response) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Response) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Response) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Response) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Response) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchTransferERC20Response) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchTransferERC20Response) GetResults() []*BatchTransferResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchTransferERC20Response) GetTotalAmountFormatted() string {
+	if x != nil {
+		return x.TotalAmountFormatted
+	}
+	return ""
+}
+
+func (x *BatchTransferERC20Response) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type GetERC20OwnerRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 (ownable) contract address
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC20OwnerRequest) Reset() {
+	*x = GetERC20OwnerRequest{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC20OwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC20OwnerRequest) ProtoMessage() {}
+
+func (x *GetERC20OwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC20OwnerRequest.ProtoReflect.Descriptor instead.
+func (*GetERC20OwnerRequest) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetERC20OwnerRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type GetERC20OwnerResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	OwnerAddress    string                 `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Current owner (zero address after renouncing)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC20OwnerResponse) Reset() {
+	*x = GetERC20OwnerResponse{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC20OwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC20OwnerResponse) ProtoMessage() {}
+
+func (x *GetERC20OwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC20OwnerResponse.ProtoReflect.Descriptor instead.
+func (*GetERC20OwnerResponse) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetERC20OwnerResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC20OwnerResponse) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+type TransferERC20OwnershipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 (ownable) contract address
+	NewOwner        string                 `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`                      // Address of the new owner
+	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,4,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferERC20OwnershipRequest) Reset() {
+	*x = TransferERC20OwnershipRequest{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferERC20OwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferERC20OwnershipRequest) ProtoMessage() {}
+
+func (x *TransferERC20OwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferERC20OwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferERC20OwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TransferERC20OwnershipRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TransferERC20OwnershipRequest) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *TransferERC20OwnershipRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *TransferERC20OwnershipRequest) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *TransferERC20OwnershipRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type TransferERC20OwnershipResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	PreviousOwner   string                 `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`       // Owner that signed the transaction
+	NewOwner        string                 `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`                      // New owner
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferERC20OwnershipResponse) Reset() {
+	*x = TransferERC20OwnershipResponse{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferERC20OwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferERC20OwnershipResponse) ProtoMessage() {}
+
+func (x *TransferERC20OwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferERC20OwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferERC20OwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TransferERC20OwnershipResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TransferERC20OwnershipResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TransferERC20OwnershipResponse) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *TransferERC20OwnershipResponse) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *TransferERC20OwnershipResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type RenounceERC20OwnershipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 (ownable) contract address
+	Confirmation    string                 `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`                              // Must be "RENOUNCE <contract_address>" to confirm the irreversible renounce
+	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,4,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenounceERC20OwnershipRequest) Reset() {
+	*x = RenounceERC20OwnershipRequest{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceERC20OwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceERC20OwnershipRequest) ProtoMessage() {}
+
+func (x *RenounceERC20OwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceERC20OwnershipRequest.ProtoReflect.Descriptor instead.
+func (*RenounceERC20OwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RenounceERC20OwnershipRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *RenounceERC20OwnershipRequest) GetConfirmation() string {
+	if x != nil {
+		return x.Confirmation
+	}
+	return ""
+}
+
+func (x *RenounceERC20OwnershipRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *RenounceERC20OwnershipRequest) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *RenounceERC20OwnershipRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type RenounceERC20OwnershipResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	PreviousOwner   string                 `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`       // Owner that signed the transaction
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenounceERC20OwnershipResponse) Reset() {
+	*x = RenounceERC20OwnershipResponse{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceERC20OwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceERC20OwnershipResponse) ProtoMessage() {}
+
+func (x *RenounceERC20OwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceERC20OwnershipResponse.ProtoReflect.Descriptor instead.
+func (*RenounceERC20OwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RenounceERC20OwnershipResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *RenounceERC20OwnershipResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *RenounceERC20OwnershipResponse) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *RenounceERC20OwnershipResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type SignERC20PermitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract implementing EIP-2612
+	Spender         string                 `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`                                        // Address allowed to spend the tokens
+	Value           string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                                            // Allowance to grant (as string to handle large numbers)
+	Deadline        uint64                 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`                                     // Unix timestamp after which the permit is invalid (default: one hour from now)
+	PrivateKey      string                 `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key of the token owner (hex encoded, with or without 0x prefix; server-held signers are not accepted)
+	Version         string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`                                        // EIP-712 domain version (optional, read from eip712Domain() or "1")
+	Unit            string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"`                                              // Unit of value: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SignERC20PermitRequest) Reset() {
+	*x = SignERC20PermitRequest{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignERC20PermitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignERC20PermitRequest) ProtoMessage() {}
+
+func (x *SignERC20PermitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignERC20PermitRequest.ProtoReflect.Descriptor instead.
+func (*SignERC20PermitRequest) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SignERC20PermitRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SignERC20PermitRequest) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *SignERC20PermitRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SignERC20PermitRequest) GetDeadline() uint64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SignERC20PermitRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SignERC20PermitRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SignERC20PermitRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type SignERC20PermitResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	Owner           string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`                                            // Token owner that signed the permit
+	Spender         string                 `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`                                        // Spender
+	Value           string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`                                            // Allowance granted
+	Nonce           string                 `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`                                            // Owner nonce used in the permit
+	Deadline        uint64                 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`                                     // Permit deadline
+	Signature       string                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`                                    // 65-byte signature (hex encoded, r || s || v)
+	V               uint32                 `protobuf:"varint,8,opt,name=v,proto3" json:"v,omitempty"`                                                   // Signature v (27 or 28)
+	R               string                 `protobuf:"bytes,9,opt,name=r,proto3" json:"r,omitempty"`                                                    // Signature r (hex encoded)
+	S               string                 `protobuf:"bytes,10,opt,name=s,proto3" json:"s,omitempty"`                                                   // Signature s (hex encoded)
+	Digest          string                 `protobuf:"bytes,11,opt,name=digest,proto3" json:"digest,omitempty"`                                         // EIP-712 digest that was signed
+	ValueFormatted  string                 `protobuf:"bytes,12,opt,name=value_formatted,json=valueFormatted,proto3" json:"value_formatted,omitempty"`   // Allowance in whole tokens
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SignERC20PermitResponse) Reset() {
+	*x = SignERC20PermitResponse{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignERC20PermitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignERC20PermitResponse) ProtoMessage() {}
+
+func (x *SignERC20PermitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignERC20PermitResponse.ProtoReflect.Descriptor instead.
+func (*SignERC20PermitResponse) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SignERC20PermitResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetDeadline() uint64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SignERC20PermitResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetV() uint32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *SignERC20PermitResponse) GetR() string {
+	if x != nil {
+		return x.R
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *SignERC20PermitResponse) GetValueFormatted() string {
+	if x != nil {
+		return x.ValueFormatted
+	}
+	return ""
+}
+
+type SubmitERC20PermitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC20 contract implementing EIP-2612
+	Owner           string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`                                            // Token owner that signed the permit
+	Spender         string                 `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`                                        // Spender in the permit
+	Value           string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`                                            // Allowance in the permit
+	Deadline        uint64                 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`                                     // Permit deadline
+	Signature       string                 `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`                                    // 65-byte signature (hex encoded); alternatively set v, r and s
+	V               uint32                 `protobuf:"varint,7,opt,name=v,proto3" json:"v,omitempty"`                                                   // Signature v
+	R               string                 `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`                                                    // Signature r (hex encoded)
+	S               string                 `protobuf:"bytes,9,opt,name=s,proto3" json:"s,omitempty"`                                                    // Signature s (hex encoded)
+	PrivateKey      string                 `protobuf:"bytes,10,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`               // Relayer private key paying the gas (hex encoded); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,11,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                    // Relay with the admin keystore instead of private_key
+	TransferTo      string                 `protobuf:"bytes,12,opt,name=transfer_to,json=transferTo,proto3" json:"transfer_to,omitempty"`               // Transfer the tokens to this address after the permit (optional, relayer must be the spender)
+	TransferAmount  string                 `protobuf:"bytes,13,opt,name=transfer_amount,json=transferAmount,proto3" json:"transfer_amount,omitempty"`   // Amount to transfer (default: value)
+	Version         string                 `protobuf:"bytes,14,opt,name=version,proto3" json:"version,omitempty"`                                       // EIP-712 domain version (optional, read from eip712Domain() or "1")
+	Unit            string                 `protobuf:"bytes,15,opt,name=unit,proto3" json:"unit,omitempty"`                                             // Unit of value and transfer_amount: "base" or "whole" (default: whole tokens if it has a decimal point, base units otherwise)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubmitERC20PermitRequest) Reset() {
+	*x = SubmitERC20PermitRequest{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitERC20PermitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitERC20PermitRequest) ProtoMessage() {}
+
+func (x *SubmitERC20PermitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitERC20PermitRequest.ProtoReflect.Descriptor instead.
+func (*SubmitERC20PermitRequest) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubmitERC20PermitRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetDeadline() uint64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SubmitERC20PermitRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetV() uint32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *SubmitERC20PermitRequest) GetR() string {
+	if x != nil {
+		return x.R
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *SubmitERC20PermitRequest) GetTransferTo() string {
+	if x != nil {
+		return x.TransferTo
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetTransferAmount() string {
+	if x != nil {
+		return x.TransferAmount
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type SubmitERC20PermitResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PermitTxHash    string                 `protobuf:"bytes,1,opt,name=permit_tx_hash,json=permitTxHash,proto3" json:"permit_tx_hash,omitempty"`        // Permit transaction hash
+	TransferTxHash  string                 `protobuf:"bytes,2,opt,name=transfer_tx_hash,json=transferTxHash,proto3" json:"transfer_tx_hash,omitempty"`  // transferFrom transaction hash (set when transfer_to is given)
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	RelayerAddress  string                 `protobuf:"bytes,4,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`    // Address that paid the gas
+	Owner           string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`                                            // Token owner
+	Spender         string                 `protobuf:"bytes,6,opt,name=spender,proto3" json:"spender,omitempty"`                                        // Spender
+	Value           string                 `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`                                            // Allowance granted
+	ValueFormatted  string                 `protobuf:"bytes,8,opt,name=value_formatted,json=valueFormatted,proto3" json:"value_formatted,omitempty"`    // Allowance in whole tokens
+	TransferError   string                 `protobuf:"bytes,9,opt,name=transfer_error,json=transferError,proto3" json:"transfer_error,omitempty"`       // Why transferFrom could not be sent after the submitted permit
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubmitERC20PermitResponse) Reset() {
+	*x = SubmitERC20PermitResponse{}
+	mi := &file_erc20_v1_erc20_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitERC20PermitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitERC20PermitResponse) ProtoMessage() {}
+
+func (x *SubmitERC20PermitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc20_v1_erc20_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitERC20PermitResponse.ProtoReflect.Descriptor instead.
+func (*SubmitERC20PermitResponse) Descriptor() ([]byte, []int) {
+	return file_erc20_v1_erc20_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubmitERC20PermitResponse) GetPermitTxHash() string {
+	if x != nil {
+		return x.PermitTxHash
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetTransferTxHash() string {
+	if x != nil {
+		return x.TransferTxHash
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetRelayerAddress() string {
+	if x != nil {
+		return x.RelayerAddress
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetValueFormatted() string {
+	if x != nil {
+		return x.ValueFormatted
+	}
+	return ""
+}
+
+func (x *SubmitERC20PermitResponse) GetTransferError() string {
+	if x != nil {
+		return x.TransferError
+	}
+	return ""
+}
+
+var File_erc20_v1_erc20_proto protoreflect.FileDescriptor
+
+const file_erc20_v1_erc20_proto_rawDesc = "" +
+	"\n" +
+	"\x14erc20/v1/erc20.proto\x12\fapi.erc20.v1\x1a\x1cgoogle/api/annotations.proto\"\x8d\x01\n" +
+	"\x16GetERC20BalanceRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\x12#\n" +
+	"\rcontract_type\x18\x03 \x01(\tR\fcontractType\"\xcc\x01\n" +
+	"\x17GetERC20BalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\tR\abalance\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12\x1a\n" +
+	"\bdecimals\x18\x04 \x01(\rR\bdecimals\x12+\n" +
+	"\x11balance_formatted\x18\x05 \x01(\tR\x10balanceFormatted\"e\n" +
+	"\x13GetERC20InfoRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rcontract_type\x18\x02 \x01(\tR\fcontractType\"\xe2\x01\n" +
+	"\x14GetERC20InfoResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bdecimals\x18\x03 \x01(\rR\bdecimals\x12!\n" +
+	"\ftotal_supply\x18\x04 \x01(\tR\vtotalSupply\x12)\n" +
+	"\x10contract_address\x18\x05 \x01(\tR\x0fcontractAddress\x124\n" +
+	"\x16total_supply_formatted\x18\x06 \x01(\tR\x14totalSupplyFormatted\"\xdb\x01\n" +
+	"\x14TransferERC20Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x12\x16\n" +
+	"\x06signer\x18\a \x01(\tR\x06signer\"\xf7\x01\n" +
+	"\x15TransferERC20Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\x12)\n" +
+	"\x10amount_formatted\x18\a \x01(\tR\x0famountFormatted\"\xcc\x01\n" +
+	"\x13ApproveERC20Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0fspender_address\x18\x02 \x01(\tR\x0espenderAddress\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\"\x82\x02\n" +
+	"\x14ApproveERC20Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12'\n" +
+	"\x0fspender_address\x18\x04 \x01(\tR\x0espenderAddress\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\x12)\n" +
+	"\x10amount_formatted\x18\a \x01(\tR\x0famountFormatted\"\x93\x01\n" +
+	"\x18GetERC20AllowanceRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\x12'\n" +
+	"\x0fspender_address\x18\x03 \x01(\tR\x0espenderAddress\"\xe3\x01\n" +
+	"\x19GetERC20AllowanceResponse\x12\x1c\n" +
+	"\tallowance\x18\x01 \x01(\tR\tallowance\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12'\n" +
+	"\x0fspender_address\x18\x04 \x01(\tR\x0espenderAddress\x12/\n" +
+	"\x13allowance_formatted\x18\x05 \x01(\tR\x12allowanceFormatted\"\xea\x01\n" +
+	"\x18TransferFromERC20Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vprivate_key\x18\x05 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x06 \x01(\bR\x05async\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\"\xfb\x01\n" +
+	"\x19TransferFromERC20Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\x12)\n" +
+	"\x10amount_formatted\x18\a \x01(\tR\x0famountFormatted\"\xbf\x01\n" +
+	"\x10MintERC20Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\"\xd0\x01\n" +
+	"\x11MintERC20Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\x12)\n" +
+	"\x10amount_formatted\x18\x06 \x01(\tR\x0famountFormatted\"\xa0\x01\n" +
+	"\x10BurnERC20Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\"\xd4\x01\n" +
+	"\x11BurnERC20Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\x12)\n" +
+	"\x10amount_formatted\x18\x06 \x01(\tR\x0famountFormatted\"\xc7\x01\n" +
+	"\x14BurnFromERC20Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\"\xd8\x01\n" +
+	"\x15BurnFromERC20Response\x12\x17\n" +
 	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
 	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
 	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\tR\x06amount\"\xe6\x01\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\x12)\n" +
+	"\x10amount_formatted\x18\x06 \x01(\tR\x0famountFormatted\"\x90\x02\n" +
 	"\x12DeployERC20Request\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1a\n" +
@@ -1545,7 +3053,9 @@ const file_erc20_v1_erc20_proto_rawDesc = "" +
 	"\vprivate_key\x18\x05 \x01(\tR\n" +
 	"privateKey\x12#\n" +
 	"\rcontract_type\x18\x06 \x01(\tR\fcontractType\x12\x1b\n" +
-	"\tuse_admin\x18\a \x01(\bR\buseAdmin\"\xf3\x01\n" +
+	"\tuse_admin\x18\a \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\b \x01(\bR\x05async\x12\x12\n" +
+	"\x04unit\x18\t \x01(\tR\x04unit\"\xc4\x02\n" +
 	"\x13DeployERC20Response\x12\x17\n" +
 	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
 	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12)\n" +
@@ -1553,7 +3063,124 @@ const file_erc20_v1_erc20_proto_rawDesc = "" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
 	"\x06symbol\x18\x05 \x01(\tR\x06symbol\x12\x1a\n" +
 	"\bdecimals\x18\x06 \x01(\rR\bdecimals\x12%\n" +
-	"\x0einitial_supply\x18\a \x01(\tR\rinitialSupply2\xd3\t\n" +
+	"\x0einitial_supply\x18\a \x01(\tR\rinitialSupply\x12\x15\n" +
+	"\x06job_id\x18\b \x01(\tR\x05jobId\x128\n" +
+	"\x18initial_supply_formatted\x18\t \x01(\tR\x16initialSupplyFormatted\"I\n" +
+	"\x10BatchTransferRow\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x01 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"\xdc\x01\n" +
+	"\x19BatchTransferERC20Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x122\n" +
+	"\x04rows\x18\x02 \x03(\v2\x1e.api.erc20.v1.BatchTransferRowR\x04rows\x12\x10\n" +
+	"\x03csv\x18\x03 \x01(\tR\x03csv\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x19\n" +
+	"\bbatch_id\x18\x05 \x01(\tR\abatchId\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\"\xd4\x01\n" +
+	"\x13BatchTransferResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\atx_hash\x18\x05 \x01(\tR\x06txHash\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12)\n" +
+	"\x10amount_formatted\x18\a \x01(\tR\x0famountFormatted\"\x83\x03\n" +
+	"\x1aBatchTransferERC20Response\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12!\n" +
+	"\ftotal_amount\x18\x04 \x01(\tR\vtotalAmount\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x1c\n" +
+	"\tsucceeded\x18\x06 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x05R\x06failed\x12;\n" +
+	"\aresults\x18\b \x03(\v2!.api.erc20.v1.BatchTransferResultR\aresults\x124\n" +
+	"\x16total_amount_formatted\x18\t \x01(\tR\x14totalAmountFormatted\x12\x18\n" +
+	"\apending\x18\n" +
+	" \x01(\x05R\apending\"A\n" +
+	"\x14GetERC20OwnerRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\"g\n" +
+	"\x15GetERC20OwnerResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\"\xbb\x01\n" +
+	"\x1dTransferERC20OwnershipRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1b\n" +
+	"\tnew_owner\x18\x02 \x01(\tR\bnewOwner\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x04 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xbf\x01\n" +
+	"\x1eTransferERC20OwnershipResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12%\n" +
+	"\x0eprevious_owner\x18\x03 \x01(\tR\rpreviousOwner\x12\x1b\n" +
+	"\tnew_owner\x18\x04 \x01(\tR\bnewOwner\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\"\xc2\x01\n" +
+	"\x1dRenounceERC20OwnershipRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\"\n" +
+	"\fconfirmation\x18\x02 \x01(\tR\fconfirmation\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x04 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xa2\x01\n" +
+	"\x1eRenounceERC20OwnershipResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12%\n" +
+	"\x0eprevious_owner\x18\x03 \x01(\tR\rpreviousOwner\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"\xde\x01\n" +
+	"\x16SignERC20PermitRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x18\n" +
+	"\aspender\x18\x02 \x01(\tR\aspender\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1a\n" +
+	"\bdeadline\x18\x04 \x01(\x04R\bdeadline\x12\x1f\n" +
+	"\vprivate_key\x18\x05 \x01(\tR\n" +
+	"privateKey\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\"\xc5\x02\n" +
+	"\x17SignERC20PermitResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x18\n" +
+	"\aspender\x18\x03 \x01(\tR\aspender\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\tR\x05nonce\x12\x1a\n" +
+	"\bdeadline\x18\x06 \x01(\x04R\bdeadline\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\tR\tsignature\x12\f\n" +
+	"\x01v\x18\b \x01(\rR\x01v\x12\f\n" +
+	"\x01r\x18\t \x01(\tR\x01r\x12\f\n" +
+	"\x01s\x18\n" +
+	" \x01(\tR\x01s\x12\x16\n" +
+	"\x06digest\x18\v \x01(\tR\x06digest\x12'\n" +
+	"\x0fvalue_formatted\x18\f \x01(\tR\x0evalueFormatted\"\xa5\x03\n" +
+	"\x18SubmitERC20PermitRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x18\n" +
+	"\aspender\x18\x03 \x01(\tR\aspender\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\x04R\bdeadline\x12\x1c\n" +
+	"\tsignature\x18\x06 \x01(\tR\tsignature\x12\f\n" +
+	"\x01v\x18\a \x01(\rR\x01v\x12\f\n" +
+	"\x01r\x18\b \x01(\tR\x01r\x12\f\n" +
+	"\x01s\x18\t \x01(\tR\x01s\x12\x1f\n" +
+	"\vprivate_key\x18\n" +
+	" \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\v \x01(\bR\buseAdmin\x12\x1f\n" +
+	"\vtransfer_to\x18\f \x01(\tR\n" +
+	"transferTo\x12'\n" +
+	"\x0ftransfer_amount\x18\r \x01(\tR\x0etransferAmount\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\tR\aversion\x12\x12\n" +
+	"\x04unit\x18\x0f \x01(\tR\x04unit\"\xd5\x02\n" +
+	"\x19SubmitERC20PermitResponse\x12$\n" +
+	"\x0epermit_tx_hash\x18\x01 \x01(\tR\fpermitTxHash\x12(\n" +
+	"\x10transfer_tx_hash\x18\x02 \x01(\tR\x0etransferTxHash\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0frelayer_address\x18\x04 \x01(\tR\x0erelayerAddress\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x12\x18\n" +
+	"\aspender\x18\x06 \x01(\tR\aspender\x12\x14\n" +
+	"\x05value\x18\a \x01(\tR\x05value\x12'\n" +
+	"\x0fvalue_formatted\x18\b \x01(\tR\x0evalueFormatted\x12%\n" +
+	"\x0etransfer_error\x18\t \x01(\tR\rtransferError2\xb9\x10\n" +
 	"\x05ERC20\x12}\n" +
 	"\x0fGetERC20Balance\x12$.api.erc20.v1.GetERC20BalanceRequest\x1a%.api.erc20.v1.GetERC20BalanceResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/erc20/balance\x12q\n" +
 	"\fGetERC20Info\x12!.api.erc20.v1.GetERC20InfoRequest\x1a\".api.erc20.v1.GetERC20InfoResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/erc20/info\x12{\n" +
@@ -1564,7 +3191,13 @@ const file_erc20_v1_erc20_proto_rawDesc = "" +
 	"\tMintERC20\x12\x1e.api.erc20.v1.MintERC20Request\x1a\x1f.api.erc20.v1.MintERC20Response\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/erc20/mint\x12k\n" +
 	"\tBurnERC20\x12\x1e.api.erc20.v1.BurnERC20Request\x1a\x1f.api.erc20.v1.BurnERC20Response\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/erc20/burn\x12|\n" +
 	"\rBurnFromERC20\x12\".api.erc20.v1.BurnFromERC20Request\x1a#.api.erc20.v1.BurnFromERC20Response\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/erc20/burn-from\x12s\n" +
-	"\vDeployERC20\x12 .api.erc20.v1.DeployERC20Request\x1a!.api.erc20.v1.DeployERC20Response\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/erc20/deployB6\n" +
+	"\vDeployERC20\x12 .api.erc20.v1.DeployERC20Request\x1a!.api.erc20.v1.DeployERC20Response\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/erc20/deploy\x12\x90\x01\n" +
+	"\x12BatchTransferERC20\x12'.api.erc20.v1.BatchTransferERC20Request\x1a(.api.erc20.v1.BatchTransferERC20Response\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/erc20/batch-transfer\x12u\n" +
+	"\rGetERC20Owner\x12\".api.erc20.v1.GetERC20OwnerRequest\x1a#.api.erc20.v1.GetERC20OwnerResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/erc20/owner\x12\xa0\x01\n" +
+	"\x16TransferERC20Ownership\x12+.api.erc20.v1.TransferERC20OwnershipRequest\x1a,.api.erc20.v1.TransferERC20OwnershipResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/erc20/transfer-ownership\x12\xa0\x01\n" +
+	"\x16RenounceERC20Ownership\x12+.api.erc20.v1.RenounceERC20OwnershipRequest\x1a,.api.erc20.v1.RenounceERC20OwnershipResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/erc20/renounce-ownership\x12\x84\x01\n" +
+	"\x0fSignERC20Permit\x12$.api.erc20.v1.SignERC20PermitRequest\x1a%.api.erc20.v1.SignERC20PermitResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/erc20/permit/sign\x12\x8c\x01\n" +
+	"\x11SubmitERC20Permit\x12&.api.erc20.v1.SubmitERC20PermitRequest\x1a'.api.erc20.v1.SubmitERC20PermitResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/erc20/permit/submitB6\n" +
 	"\fapi.erc20.v1P\x01Z$eth-contract-service/api/erc20/v1;v1b\x06proto3"
 
 var (
@@ -1579,55 +3212,83 @@ func file_erc20_v1_erc20_proto_rawDescGZIP() []byte {
 	return file_erc20_v1_erc20_proto_rawDescData
 }
 
-var file_erc20_v1_erc20_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_erc20_v1_erc20_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_erc20_v1_erc20_proto_goTypes = []any{
-	(*GetERC20BalanceRequest)(nil),    // 0: api.erc20.v1.GetERC20BalanceRequest
-	(*GetERC20BalanceResponse)(nil),   // 1: api.erc20.v1.GetERC20BalanceResponse
-	(*GetERC20InfoRequest)(nil),       // 2: api.erc20.v1.GetERC20InfoRequest
-	(*GetERC20InfoResponse)(nil),      // 3: api.erc20.v1.GetERC20InfoResponse
-	(*TransferERC20Request)(nil),      // 4: api.erc20.v1.TransferERC20Request
-	(*TransferERC20Response)(nil),     // 5: api.erc20.v1.TransferERC20Response
-	(*ApproveERC20Request)(nil),       // 6: api.erc20.v1.ApproveERC20Request
-	(*ApproveERC20Response)(nil),      // 7: api.erc20.v1.ApproveERC20Response
-	(*GetERC20AllowanceRequest)(nil),  // 8: api.erc20.v1.GetERC20AllowanceRequest
-	(*GetERC20AllowanceResponse)(nil), // 9: api.erc20.v1.GetERC20AllowanceResponse
-	(*TransferFromERC20Request)(nil),  // 10: api.erc20.v1.TransferFromERC20Request
-	(*TransferFromERC20Response)(nil), // 11: api.erc20.v1.TransferFromERC20Response
-	(*MintERC20Request)(nil),          // 12: api.erc20.v1.MintERC20Request
-	(*MintERC20Response)(nil),         // 13: api.erc20.v1.MintERC20Response
-	(*BurnERC20Request)(nil),          // 14: api.erc20.v1.BurnERC20Request
-	(*BurnERC20Response)(nil),         // 15: api.erc20.v1.BurnERC20Response
-	(*BurnFromERC20Request)(nil),      // 16: api.erc20.v1.BurnFromERC20Request
-	(*BurnFromERC20Response)(nil),     // 17: api.erc20.v1.BurnFromERC20Response
-	(*DeployERC20Request)(nil),        // 18: api.erc20.v1.DeployERC20Request
-	(*DeployERC20Response)(nil),       // 19: api.erc20.v1.DeployERC20Response
+	(*GetERC20BalanceRequest)(nil),         // 0: api.erc20.v1.GetERC20BalanceRequest
+	(*GetERC20BalanceResponse)(nil),        // 1: api.erc20.v1.GetERC20BalanceResponse
+	(*GetERC20InfoRequest)(nil),            // 2: api.erc20.v1.GetERC20InfoRequest
+	(*GetERC20InfoResponse)(nil),           // 3: api.erc20.v1.GetERC20InfoResponse
+	(*TransferERC20Request)(nil),           // 4: api.erc20.v1.TransferERC20Request
+	(*TransferERC20Response)(nil),          // 5: api.erc20.v1.TransferERC20Response
+	(*ApproveERC20Request)(nil),            // 6: api.erc20.v1.ApproveERC20Request
+	(*ApproveERC20Response)(nil),           // 7: api.erc20.v1.ApproveERC20Response
+	(*GetERC20AllowanceRequest)(nil),       // 8: api.erc20.v1.GetERC20AllowanceRequest
+	(*GetERC20AllowanceResponse)(nil),      // 9: api.erc20.v1.GetERC20AllowanceResponse
+	(*TransferFromERC20Request)(nil),       // 10: api.erc20.v1.TransferFromERC20Request
+	(*TransferFromERC20Response)(nil),      // 11: api.erc20.v1.TransferFromERC20Response
+	(*MintERC20Request)(nil),               // 12: api.erc20.v1.MintERC20Request
+	(*MintERC20Response)(nil),              // 13: api.erc20.v1.MintERC20Response
+	(*BurnERC20Request)(nil),               // 14: api.erc20.v1.BurnERC20Request
+	(*BurnERC20Response)(nil),              // 15: api.erc20.v1.BurnERC20Response
+	(*BurnFromERC20Request)(nil),           // 16: api.erc20.v1.BurnFromERC20Request
+	(*BurnFromERC20Response)(nil),          // 17: api.erc20.v1.BurnFromERC20Response
+	(*DeployERC20Request)(nil),             // 18: api.erc20.v1.DeployERC20Request
+	(*DeployERC20Response)(nil),            // 19: api.erc20.v1.DeployERC20Response
+	(*BatchTransferRow)(nil),               // 20: api.erc20.v1.BatchTransferRow
+	(*BatchTransferERC20Request)(nil),      // 21: api.erc20.v1.BatchTransferERC20Request
+	(*BatchTransferResult)(nil),            // 22: api.erc20.v1.BatchTransferResult
+	(*BatchTransferERC20Response)(nil),     // 23: api.erc20.v1.BatchTransferERC20Response
+	(*GetERC20OwnerRequest)(nil),           // 24: api.erc20.v1.GetERC20OwnerRequest
+	(*GetERC20OwnerResponse)(nil),          // 25: api.erc20.v1.GetERC20OwnerResponse
+	(*TransferERC20OwnershipRequest)(nil),  // 26: api.erc20.v1.TransferERC20OwnershipRequest
+	(*TransferERC20OwnershipResponse)(nil), // 27: api.erc20.v1.TransferERC20OwnershipResponse
+	(*RenounceERC20OwnershipRequest)(nil),  // 28: api.erc20.v1.RenounceERC20OwnershipRequest
+	(*RenounceERC20OwnershipResponse)(nil), // 29: api.erc20.v1.RenounceERC20OwnershipResponse
+	(*SignERC20PermitRequest)(nil),         // 30: api.erc20.v1.SignERC20PermitRequest
+	(*SignERC20PermitResponse)(nil),        // 31: api.erc20.v1.SignERC20PermitResponse
+	(*SubmitERC20PermitRequest)(nil),       // 32: api.erc20.v1.SubmitERC20PermitRequest
+	(*SubmitERC20PermitResponse)(nil),      // 33: api.erc20.v1.SubmitERC20PermitResponse
 }
 var file_erc20_v1_erc20_proto_depIdxs = []int32{
-	0,  // 0: api.erc20.v1.ERC20.GetERC20Balance:input_type -> api.erc20.v1.GetERC20BalanceRequest
-	2,  // 1: api.erc20.v1.ERC20.GetERC20Info:input_type -> api.erc20.v1.GetERC20InfoRequest
-	4,  // 2: api.erc20.v1.ERC20.TransferERC20:input_type -> api.erc20.v1.TransferERC20Request
-	6,  // 3: api.erc20.v1.ERC20.ApproveERC20:input_type -> api.erc20.v1.ApproveERC20Request
-	8,  // 4: api.erc20.v1.ERC20.GetERC20Allowance:input_type -> api.erc20.v1.GetERC20AllowanceRequest
-	10, // 5: api.erc20.v1.ERC20.TransferFromERC20:input_type -> api.erc20.v1.TransferFromERC20Request
-	12, // 6: api.erc20.v1.ERC20.MintERC20:input_type -> api.erc20.v1.MintERC20Request
-	14, // 7: api.erc20.v1.ERC20.BurnERC20:input_type -> api.erc20.v1.BurnERC20Request
-	16, // 8: api.erc20.v1.ERC20.BurnFromERC20:input_type -> api.erc20.v1.BurnFromERC20Request
-	18, // 9: api.erc20.v1.ERC20.DeployERC20:input_type -> api.erc20.v1.DeployERC20Request
-	1,  // 10: api.erc20.v1.ERC20.GetERC20Balance:output_type -> api.erc20.v1.GetERC20BalanceResponse
-	3,  // 11: api.erc20.v1.ERC20.GetERC20Info:output_type -> api.erc20.v1.GetERC20InfoResponse
-	5,  // 12: api.erc20.v1.ERC20.TransferERC20:output_type -> api.erc20.v1.TransferERC20Response
-	7,  // 13: api.erc20.v1.ERC20.ApproveERC20:output_type -> api.erc20.v1.ApproveERC20Response
-	9,  // 14: api.erc20.v1.ERC20.GetERC20Allowance:output_type -> api.erc20.v1.GetERC20AllowanceResponse
-	11, // 15: api.erc20.v1.ERC20.TransferFromERC20:output_type -> api.erc20.v1.TransferFromERC20Response
-	13, // 16: api.erc20.v1.ERC20.MintERC20:output_type -> api.erc20.v1.MintERC20Response
-	15, // 17: api.erc20.v1.ERC20.BurnERC20:output_type -> api.erc20.v1.BurnERC20Response
-	17, // 18: api.erc20.v1.ERC20.BurnFromERC20:output_type -> api.erc20.v1.BurnFromERC20Response
-	19, // 19: api.erc20.v1.ERC20.DeployERC20:output_type -> api.erc20.v1.DeployERC20Response
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	20, // 0: api.erc20.v1.BatchTransferERC20Request.rows:type_name -> api.erc20.v1.BatchTransferRow
+	22, // 1: api.erc20.v1.BatchTransferERC20Response.results:type_name -> api.erc20.v1.BatchTransferResult
+	0,  // 2: api.erc20.v1.ERC20.GetERC20Balance:input_type -> api.erc20.v1.GetERC20BalanceRequest
+	2,  // 3: api.erc20.v1.ERC20.GetERC20Info:input_type -> api.erc20.v1.GetERC20InfoRequest
+	4,  // 4: api.erc20.v1.ERC20.TransferERC20:input_type -> api.erc20.v1.TransferERC20Request
+	6,  // 5: api.erc20.v1.ERC20.ApproveERC20:input_type -> api.erc20.v1.ApproveERC20Request
+	8,  // 6: api.erc20.v1.ERC20.GetERC20Allowance:input_type -> api.erc20.v1.GetERC20AllowanceRequest
+	10, // 7: api.erc20.v1.ERC20.TransferFromERC20:input_type -> api.erc20.v1.TransferFromERC20Request
+	12, // 8: api.erc20.v1.ERC20.MintERC20:input_type -> api.erc20.v1.MintERC20Request
+	14, // 9: api.erc20.v1.ERC20.BurnERC20:input_type -> api.erc20.v1.BurnERC20Request
+	16, // 10: api.erc20.v1.ERC20.BurnFromERC20:input_type -> api.erc20.v1.BurnFromERC20Request
+	18, // 11: api.erc20.v1.ERC20.DeployERC20:input_type -> api.erc20.v1.DeployERC20Request
+	21, // 12: api.erc20.v1.ERC20.BatchTransferERC20:input_type -> api.erc20.v1.BatchTransferERC20Request
+	24, // 13: api.erc20.v1.ERC20.GetERC20Owner:input_type -> api.erc20.v1.GetERC20OwnerRequest
+	26, // 14: api.erc20.v1.ERC20.TransferERC20Ownership:input_type -> api.erc20.v1.TransferERC20OwnershipRequest
+	28, // 15: api.erc20.v1.ERC20.RenounceERC20Ownership:input_type -> api.erc20.v1.RenounceERC20OwnershipRequest
+	30, // 16: api.erc20.v1.ERC20.SignERC20Permit:input_type -> api.erc20.v1.SignERC20PermitRequest
+	32, // 17: api.erc20.v1.ERC20.SubmitERC20Permit:input_type -> api.erc20.v1.SubmitERC20PermitRequest
+	1,  // 18: api.erc20.v1.ERC20.GetERC20Balance:output_type -> api.erc20.v1.GetERC20BalanceResponse
+	3,  // 19: api.erc20.v1.ERC20.GetERC20Info:output_type -> api.erc20.v1.GetERC20InfoResponse
+	5,  // 20: api.erc20.v1.ERC20.TransferERC20:output_type -> api.erc20.v1.TransferERC20Response
+	7,  // 21: api.erc20.v1.ERC20.ApproveERC20:output_type -> api.erc20.v1.ApproveERC20Response
+	9,  // 22: api.erc20.v1.ERC20.GetERC20Allowance:output_type -> api.erc20.v1.GetERC20AllowanceResponse
+	11, // 23: api.erc20.v1.ERC20.TransferFromERC20:output_type -> api.erc20.v1.TransferFromERC20Response
+	13, // 24: api.erc20.v1.ERC20.MintERC20:output_type -> api.erc20.v1.MintERC20Response
+	15, // 25: api.erc20.v1.ERC20.BurnERC20:output_type -> api.erc20.v1.BurnERC20Response
+	17, // 26: api.erc20.v1.ERC20.BurnFromERC20:output_type -> api.erc20.v1.BurnFromERC20Response
+	19, // 27: api.erc20.v1.ERC20.DeployERC20:output_type -> api.erc20.v1.DeployERC20Response
+	23, // 28: api.erc20.v1.ERC20.BatchTransferERC20:output_type -> api.erc20.v1.BatchTransferERC20Response
+	25, // 29: api.erc20.v1.ERC20.GetERC20Owner:output_type -> api.erc20.v1.GetERC20OwnerResponse
+	27, // 30: api.erc20.v1.ERC20.TransferERC20Ownership:output_type -> api.erc20.v1.TransferERC20OwnershipResponse
+	29, // 31: api.erc20.v1.ERC20.RenounceERC20Ownership:output_type -> api.erc20.v1.RenounceERC20OwnershipResponse
+	31, // 32: api.erc20.v1.ERC20.SignERC20Permit:output_type -> api.erc20.v1.SignERC20PermitResponse
+	33, // 33: api.erc20.v1.ERC20.SubmitERC20Permit:output_type -> api.erc20.v1.SubmitERC20PermitResponse
+	18, // [18:34] is the sub-list for method output_type
+	2,  // [2:18] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_erc20_v1_erc20_proto_init() }
@@ -1641,7 +3302,7 @@ func file_erc20_v1_erc20_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_erc20_v1_erc20_proto_rawDesc), len(file_erc20_v1_erc20_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ERC20_GetERC20Balance_FullMethodName   = "/api.erc20.v1.ERC20/GetERC20Balance"
-	ERC20_GetERC20Info_FullMethodName      = "/api.erc20.v1.ERC20/GetERC20Info"
-	ERC20_TransferERC20_FullMethodName     = "/api.erc20.v1.ERC20/TransferERC20"
-	ERC20_ApproveERC20_FullMethodName      = "/api.erc20.v1.ERC20/ApproveERC20"
-	ERC20_GetERC20Allowance_FullMethodName = "/api.erc20.v1.ERC20/GetERC20Allowance"
-	ERC20_TransferFromERC20_FullMethodName = "/api.erc20.v1.ERC20/TransferFromERC20"
-	ERC20_MintERC20_FullMethodName         = "/api.erc20.v1.ERC20/MintERC20"
-	ERC20_BurnERC20_FullMethodName         = "/api.erc20.v1.ERC20/BurnERC20"
-	ERC20_BurnFromERC20_FullMethodName     = "/api.erc20.v1.ERC20/BurnFromERC20"
-	ERC20_DeployERC20_FullMethodName       = "/api.erc20.v1.ERC20/DeployERC20"
+	ERC20_GetERC20Balance_FullMethodName        = "/api.erc20.v1.ERC20/GetERC20Balance"
+	ERC20_GetERC20Info_FullMethodName           = "/api.erc20.v1.ERC20/GetERC20Info"
+	ERC20_TransferERC20_FullMethodName          = "/api.erc20.v1.ERC20/TransferERC20"
+	ERC20_ApproveERC20_FullMethodName           = "/api.erc20.v1.ERC20/ApproveERC20"
+	ERC20_GetERC20Allowance_FullMethodName      = "/api.erc20.v1.ERC20/GetERC20Allowance"
+	ERC20_TransferFromERC20_FullMethodName      = "/api.erc20.v1.ERC20/TransferFromERC20"
+	ERC20_MintERC20_FullMethodName              = "/api.erc20.v1.ERC20/MintERC20"
+	ERC20_BurnERC20_FullMethodName              = "/api.erc20.v1.ERC20/BurnERC20"
+	ERC20_BurnFromERC20_FullMethodName          = "/api.erc20.v1.ERC20/BurnFromERC20"
+	ERC20_DeployERC20_FullMethodName            = "/api.erc20.v1.ERC20/DeployERC20"
+	ERC20_BatchTransferERC20_FullMethodName     = "/api.erc20.v1.ERC20/BatchTransferERC20"
+	ERC20_GetERC20Owner_FullMethodName          = "/api.erc20.v1.ERC20/GetERC20Owner"
+	ERC20_TransferERC20Ownership_FullMethodName = "/api.erc20.v1.ERC20/TransferERC20Ownership"
+	ERC20_RenounceERC20Ownership_FullMethodName = "/api.erc20.v1.ERC20/RenounceERC20Ownership"
+	ERC20_SignERC20Permit_FullMethodName        = "/api.erc20.v1.ERC20/SignERC20Permit"
+	ERC20_SubmitERC20Permit_FullMethodName      = "/api.erc20.v1.ERC20/SubmitERC20Permit"
 )
 
 // ERC20Client is the client API for ERC20 service.
@@ -57,6 +63,18 @@ type ERC20Client interface {
 	BurnFromERC20(ctx context.Context, in *BurnFromERC20Request, opts ...grpc.CallOption) (*BurnFromERC20Response, error)
 	// DeployERC20 deploys a new ERC20 token contract
 	DeployERC20(ctx context.Context, in *DeployERC20Request, opts ...grpc.CallOption) (*DeployERC20Response, error)
+	// BatchTransferERC20 transfers ERC20 tokens to many recipients and reports the result per row
+	BatchTransferERC20(ctx context.Context, in *BatchTransferERC20Request, opts ...grpc.CallOption) (*BatchTransferERC20Response, error)
+	// GetERC20Owner returns the current owner of the contract
+	GetERC20Owner(ctx context.Context, in *GetERC20OwnerRequest, opts ...grpc.CallOption) (*GetERC20OwnerResponse, error)
+	// TransferERC20Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC20Ownership(ctx context.Context, in *TransferERC20OwnershipRequest, opts ...grpc.CallOption) (*TransferERC20OwnershipResponse, error)
+	// RenounceERC20Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC20Ownership(ctx context.Context, in *RenounceERC20OwnershipRequest, opts ...grpc.CallOption) (*RenounceERC20OwnershipResponse, error)
+	// SignERC20Permit builds and signs an EIP-2612 permit for the owner key without sending a transaction
+	SignERC20Permit(ctx context.Context, in *SignERC20PermitRequest, opts ...grpc.CallOption) (*SignERC20PermitResponse, error)
+	// SubmitERC20Permit relays a permit signature and optionally transfers the permitted tokens
+	SubmitERC20Permit(ctx context.Context, in *SubmitERC20PermitRequest, opts ...grpc.CallOption) (*SubmitERC20PermitResponse, error)
 }
 
 type eRC20Client struct {
@@ -167,6 +185,66 @@ func (c *eRC20Client) DeployERC20(ctx context.Context, in *DeployERC20Request, o
 	return out, nil
 }
 
+func (c *eRC20Client) BatchTransferERC20(ctx context.Context, in *BatchTransferERC20Request, opts ...grpc.CallOption) (*BatchTransferERC20Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchTransferERC20Response)
+	err := c.cc.Invoke(ctx, ERC20_BatchTransferERC20_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC20Client) GetERC20Owner(ctx context.Context, in *GetERC20OwnerRequest, opts ...grpc.CallOption) (*GetERC20OwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetERC20OwnerResponse)
+	err := c.cc.Invoke(ctx, ERC20_GetERC20Owner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC20Client) TransferERC20Ownership(ctx context.Context, in *TransferERC20OwnershipRequest, opts ...grpc.CallOption) (*TransferERC20OwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferERC20OwnershipResponse)
+	err := c.cc.Invoke(ctx, ERC20_TransferERC20Ownership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC20Client) RenounceERC20Ownership(ctx context.Context, in *RenounceERC20OwnershipRequest, opts ...grpc.CallOption) (*RenounceERC20OwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenounceERC20OwnershipResponse)
+	err := c.cc.Invoke(ctx, ERC20_RenounceERC20Ownership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC20Client) SignERC20Permit(ctx context.Context, in *SignERC20PermitRequest, opts ...grpc.CallOption) (*SignERC20PermitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignERC20PermitResponse)
+	err := c.cc.Invoke(ctx, ERC20_SignERC20Permit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC20Client) SubmitERC20Permit(ctx context.Context, in *SubmitERC20PermitRequest, opts ...grpc.CallOption) (*SubmitERC20PermitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitERC20PermitResponse)
+	err := c.cc.Invoke(ctx, ERC20_SubmitERC20Permit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ERC20Server is the server API for ERC20 service.
 // All implementations must embed UnimplementedERC20Server
 // for forward compatibility.
@@ -193,6 +271,18 @@ type ERC20Server interface {
 	BurnFromERC20(context.Context, *BurnFromERC20Request) (*BurnFromERC20Response, error)
 	// DeployERC20 deploys a new ERC20 token contract
 	DeployERC20(context.Context, *DeployERC20Request) (*DeployERC20Response, error)
+	// BatchTransferERC20 transfers ERC20 tokens to many recipients and reports the result per row
+	BatchTransferERC20(context.Context, *BatchTransferERC20Request) (*BatchTransferERC20Response, error)
+	// GetERC20Owner returns the current owner of the contract
+	GetERC20Owner(context.Context, *GetERC20OwnerRequest) (*GetERC20OwnerResponse, error)
+	// TransferERC20Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC20Ownership(context.Context, *TransferERC20OwnershipRequest) (*TransferERC20OwnershipResponse, error)
+	// RenounceERC20Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC20Ownership(context.Context, *RenounceERC20OwnershipRequest) (*RenounceERC20OwnershipResponse, error)
+	// SignERC20Permit builds and signs an EIP-2612 permit for the owner key without sending a transaction
+	SignERC20Permit(context.Context, *SignERC20PermitRequest) (*SignERC20PermitResponse, error)
+	// SubmitERC20Permit relays a permit signature and optionally transfers the permitted tokens
+	SubmitERC20Permit(context.Context, *SubmitERC20PermitRequest) (*SubmitERC20PermitResponse, error)
 	mustEmbedUnimplementedERC20Server()
 }
 
@@ -233,6 +323,24 @@ func (UnimplementedERC20Server) BurnFromERC20(context.Context, *BurnFromERC20Req
 func (UnimplementedERC20Server) DeployERC20(context.Context, *DeployERC20Request) (*DeployERC20Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeployERC20 not implemented")
 }
+func (UnimplementedERC20Server) BatchTransferERC20(context.Context, *BatchTransferERC20Request) (*BatchTransferERC20Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchTransferERC20 not implemented")
+}
+func (UnimplementedERC20Server) GetERC20Owner(context.Context, *GetERC20OwnerRequest) (*GetERC20OwnerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetERC20Owner not implemented")
+}
+func (UnimplementedERC20Server) TransferERC20Ownership(context.Context, *TransferERC20OwnershipRequest) (*TransferERC20OwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferERC20Ownership not implemented")
+}
+func (UnimplementedERC20Server) RenounceERC20Ownership(context.Context, *RenounceERC20OwnershipRequest) (*RenounceERC20OwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenounceERC20Ownership not implemented")
+}
+func (UnimplementedERC20Server) SignERC20Permit(context.Context, *SignERC20PermitRequest) (*SignERC20PermitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SignERC20Permit not implemented")
+}
+func (UnimplementedERC20Server) SubmitERC20Permit(context.Context, *SubmitERC20PermitRequest) (*SubmitERC20PermitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitERC20Permit not implemented")
+}
 func (UnimplementedERC20Server) mustEmbedUnimplementedERC20Server() {}
 func (UnimplementedERC20Server) testEmbeddedByValue()               {}
 
@@ -434,6 +542,114 @@ func _ERC20_DeployERC20_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ERC20_BatchTransferERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTransferERC20Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC20Server).BatchTransferERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC20_BatchTransferERC20_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC20Server).BatchTransferERC20(ctx, req.(*BatchTransferERC20Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC20_GetERC20Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetERC20OwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC20Server).GetERC20Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC20_GetERC20Owner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC20Server).GetERC20Owner(ctx, req.(*GetERC20OwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC20_TransferERC20Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferERC20OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC20Server).TransferERC20Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC20_TransferERC20Ownership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC20Server).TransferERC20Ownership(ctx, req.(*TransferERC20OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC20_RenounceERC20Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenounceERC20OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC20Server).RenounceERC20Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC20_RenounceERC20Ownership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC20Server).RenounceERC20Ownership(ctx, req.(*RenounceERC20OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC20_SignERC20Permit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignERC20PermitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC20Server).SignERC20Permit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC20_SignERC20Permit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC20Server).SignERC20Permit(ctx, req.(*SignERC20PermitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC20_SubmitERC20Permit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitERC20PermitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC20Server).SubmitERC20Permit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC20_SubmitERC20Permit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC20Server).SubmitERC20Permit(ctx, req.(*SubmitERC20PermitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ERC20_ServiceDesc is the grpc.ServiceDesc for ERC20 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,6 +697,30 @@ var ERC20_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeployERC20",
 			Handler:    _ERC20_DeployERC20_Handler,
 		},
+		{
+			MethodName: "BatchTransferERC20",
+			Handler:    _ERC20_BatchTransferERC20_Handler,
+		},
+		{
+			MethodName: "GetERC20Owner",
+			Handler:    _ERC20_GetERC20Owner_Handler,
+		},
+		{
+			MethodName: "TransferERC20Ownership",
+			Handler:    _ERC20_TransferERC20Ownership_Handler,
+		},
+		{
+			MethodName: "RenounceERC20Ownership",
+			Handler:    _ERC20_RenounceERC20Ownership_Handler,
+		},
+		{
+			MethodName: "SignERC20Permit",
+			Handler:    _ERC20_SignERC20Permit_Handler,
+		},
+		{
+			MethodName: "SubmitERC20Permit",
+			Handler:    _ERC20_SubmitERC20Permit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "erc20/v1/erc20.proto",
@@ -20,19 +20,27 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationERC20ApproveERC20 = "/api.erc20.v1.ERC20/ApproveERC20"
+const OperationERC20BatchTransferERC20 = "/api.erc20.v1.ERC20/BatchTransferERC20"
 const OperationERC20BurnERC20 = "/api.erc20.v1.ERC20/BurnERC20"
 const OperationERC20BurnFromERC20 = "/api.erc20.v1.ERC20/BurnFromERC20"
 const OperationERC20DeployERC20 = "/api.erc20.v1.ERC20/DeployERC20"
 const OperationERC20GetERC20Allowance = "/api.erc20.v1.ERC20/GetERC20Allowance"
 const OperationERC20GetERC20Balance = "/api.erc20.v1.ERC20/GetERC20Balance"
 const OperationERC20GetERC20Info = "/api.erc20.v1.ERC20/GetERC20Info"
+const OperationERC20GetERC20Owner = "/api.erc20.v1.ERC20/GetERC20Owner"
 const OperationERC20MintERC20 = "/api.erc20.v1.ERC20/MintERC20"
+const OperationERC20RenounceERC20Ownership = "/api.erc20.v1.ERC20/RenounceERC20Ownership"
+const OperationERC20SignERC20Permit = "/api.erc20.v1.ERC20/SignERC20Permit"
+const OperationERC20SubmitERC20Permit = "/api.erc20.v1.ERC20/SubmitERC20Permit"
 const OperationERC20TransferERC20 = "/api.erc20.v1.ERC20/TransferERC20"
+const OperationERC20TransferERC20Ownership = "/api.erc20.v1.ERC20/TransferERC20Ownership"
 const OperationERC20TransferFromERC20 = "/api.erc20.v1.ERC20/TransferFromERC20"
 
 type ERC20HTTPServer interface {
 	// ApproveERC20 ApproveERC20 approves the spender to spend ERC20 tokens
 	ApproveERC20(context.Context, *ApproveERC20Request) (*ApproveERC20Response, error)
+	// BatchTransferERC20 BatchTransferERC20 transfers ERC20 tokens to many recipients and reports the result per row
+	BatchTransferERC20(context.Context, *BatchTransferERC20Request) (*BatchTransferERC20Response, error)
 	// BurnERC20 BurnERC20 burns ERC20 tokens from the caller's balance
 	BurnERC20(context.Context, *BurnERC20Request) (*BurnERC20Response, error)
 	// BurnFromERC20 BurnFromERC20 burns ERC20 tokens from a specified address (requires approval)
@@ -45,10 +53,20 @@ type ERC20HTTPServer interface {
 	GetERC20Balance(context.Context, *GetERC20BalanceRequest) (*GetERC20BalanceResponse, error)
 	// GetERC20Info GetERC20Info returns ERC20 token information (name, symbol, decimals, total supply)
 	GetERC20Info(context.Context, *GetERC20InfoRequest) (*GetERC20InfoResponse, error)
+	// GetERC20Owner GetERC20Owner returns the current owner of the contract
+	GetERC20Owner(context.Context, *GetERC20OwnerRequest) (*GetERC20OwnerResponse, error)
 	// MintERC20 MintERC20 mints new ERC20 tokens (only for contracts with mint function)
 	MintERC20(context.Context, *MintERC20Request) (*MintERC20Response, error)
+	// RenounceERC20Ownership RenounceERC20Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC20Ownership(context.Context, *RenounceERC20OwnershipRequest) (*RenounceERC20OwnershipResponse, error)
+	// SignERC20Permit SignERC20Permit builds and signs an EIP-2612 permit for the owner key without sending a transaction
+	SignERC20Permit(context.Context, *SignERC20PermitRequest) (*SignERC20PermitResponse, error)
+	// SubmitERC20Permit SubmitERC20Permit relays a permit signature and optionally transfers the permitted tokens
+	SubmitERC20Permit(context.Context, *SubmitERC20PermitRequest) (*SubmitERC20PermitResponse, error)
 	// TransferERC20 TransferERC20 transfers ERC20 tokens from the caller to the specified address
 	TransferERC20(context.Context, *TransferERC20Request) (*TransferERC20Response, error)
+	// TransferERC20Ownership TransferERC20Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC20Ownership(context.Context, *TransferERC20OwnershipRequest) (*TransferERC20OwnershipResponse, error)
 	// TransferFromERC20 TransferFromERC20 transfers ERC20 tokens from one address to another (requires approval)
 	TransferFromERC20(context.Context, *TransferFromERC20Request) (*TransferFromERC20Response, error)
 }
@@ -65,6 +83,12 @@ func RegisterERC20HTTPServer(s *http.Server, srv ERC20HTTPServer) {
 	r.POST("/api/v1/erc20/burn", _ERC20_BurnERC200_HTTP_Handler(srv))
 	r.POST("/api/v1/erc20/burn-from", _ERC20_BurnFromERC200_HTTP_Handler(srv))
 	r.POST("/api/v1/erc20/deploy", _ERC20_DeployERC200_HTTP_Handler(srv))
+	r.POST("/api/v1/erc20/batch-transfer", _ERC20_BatchTransferERC200_HTTP_Handler(srv))
+	r.GET("/api/v1/erc20/owner", _ERC20_GetERC20Owner0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc20/transfer-ownership", _ERC20_TransferERC20Ownership0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc20/renounce-ownership", _ERC20_RenounceERC20Ownership0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc20/permit/sign", _ERC20_SignERC20Permit0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc20/permit/submit", _ERC20_SubmitERC20Permit0_HTTP_Handler(srv))
 }
 
 func _ERC20_GetERC20Balance0_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context) error {
@@ -278,9 +302,140 @@ func _ERC20_DeployERC200_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context
 	}
 }
 
+func _ERC20_BatchTransferERC200_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchTransferERC20Request
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC20BatchTransferERC20)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchTransferERC20(ctx, req.(*BatchTransferERC20Request))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchTransferERC20Response)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC20_GetERC20Owner0_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetERC20OwnerRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC20GetERC20Owner)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetERC20Owner(ctx, req.(*GetERC20OwnerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetERC20OwnerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC20_TransferERC20Ownership0_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferERC20OwnershipRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC20TransferERC20Ownership)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TransferERC20Ownership(ctx, req.(*TransferERC20OwnershipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferERC20OwnershipResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC20_RenounceERC20Ownership0_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenounceERC20OwnershipRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC20RenounceERC20Ownership)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenounceERC20Ownership(ctx, req.(*RenounceERC20OwnershipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenounceERC20OwnershipResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC20_SignERC20Permit0_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SignERC20PermitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC20SignERC20Permit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SignERC20Permit(ctx, req.(*SignERC20PermitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SignERC20PermitResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC20_SubmitERC20Permit0_HTTP_Handler(srv ERC20HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitERC20PermitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC20SubmitERC20Permit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitERC20Permit(ctx, req.(*SubmitERC20PermitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SubmitERC20PermitResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ERC20HTTPClient interface {
 	// ApproveERC20 ApproveERC20 approves the spender to spend ERC20 tokens
 	ApproveERC20(ctx context.Context, req *ApproveERC20Request, opts ...http.CallOption) (rsp *ApproveERC20Response, err error)
+	// BatchTransferERC20 BatchTransferERC20 transfers ERC20 tokens to many recipients and reports the result per row
+	BatchTransferERC20(ctx context.Context, req *BatchTransferERC20Request, opts ...http.CallOption) (rsp *BatchTransferERC20Response, err error)
 	// BurnERC20 BurnERC20 burns ERC20 tokens from the caller's balance
 	BurnERC20(ctx context.Context, req *BurnERC20Request, opts ...http.CallOption) (rsp *BurnERC20Response, err error)
 	// BurnFromERC20 BurnFromERC20 burns ERC20 tokens from a specified address (requires approval)
@@ -293,10 +448,20 @@ type ERC20HTTPClient interface {
 	GetERC20Balance(ctx context.Context, req *GetERC20BalanceRequest, opts ...http.CallOption) (rsp *GetERC20BalanceResponse, err error)
 	// GetERC20Info GetERC20Info returns ERC20 token information (name, symbol, decimals, total supply)
 	GetERC20Info(ctx context.Context, req *GetERC20InfoRequest, opts ...http.CallOption) (rsp *GetERC20InfoResponse, err error)
+	// GetERC20Owner GetERC20Owner returns the current owner of the contract
+	GetERC20Owner(ctx context.Context, req *GetERC20OwnerRequest, opts ...http.CallOption) (rsp *GetERC20OwnerResponse, err error)
 	// MintERC20 MintERC20 mints new ERC20 tokens (only for contracts with mint function)
 	MintERC20(ctx context.Context, req *MintERC20Request, opts ...http.CallOption) (rsp *MintERC20Response, err error)
+	// RenounceERC20Ownership RenounceERC20Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC20Ownership(ctx context.Context, req *RenounceERC20OwnershipRequest, opts ...http.CallOption) (rsp *RenounceERC20OwnershipResponse, err error)
+	// SignERC20Permit SignERC20Permit builds and signs an EIP-2612 permit for the owner key without sending a transaction
+	SignERC20Permit(ctx context.Context, req *SignERC20PermitRequest, opts ...http.CallOption) (rsp *SignERC20PermitResponse, err error)
+	// SubmitERC20Permit SubmitERC20Permit relays a permit signature and optionally transfers the permitted tokens
+	SubmitERC20Permit(ctx context.Context, req *SubmitERC20PermitRequest, opts ...http.CallOption) (rsp *SubmitERC20PermitResponse, err error)
 	// TransferERC20 TransferERC20 transfers ERC20 tokens from the caller to the specified address
 	TransferERC20(ctx context.Context, req *TransferERC20Request, opts ...http.CallOption) (rsp *TransferERC20Response, err error)
+	// TransferERC20Ownership TransferERC20Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC20Ownership(ctx context.Context, req *TransferERC20OwnershipRequest, opts ...http.CallOption) (rsp *TransferERC20OwnershipResponse, err error)
 	// TransferFromERC20 TransferFromERC20 transfers ERC20 tokens from one address to another (requires approval)
 	TransferFromERC20(ctx context.Context, req *TransferFromERC20Request, opts ...http.CallOption) (rsp *TransferFromERC20Response, err error)
 }
@@ -323,6 +488,20 @@ func (c *ERC20HTTPClientImpl) ApproveERC20(ctx context.Context, in *ApproveERC20
 	return &out, nil
 }
 
+// BatchTransferERC20 BatchTransferERC20 transfers ERC20 tokens to many recipients and reports the result per row
+func (c *ERC20HTTPClientImpl) BatchTransferERC20(ctx context.Context, in *BatchTransferERC20Request, opts ...http.CallOption) (*BatchTransferERC20Response, error) {
+	var out BatchTransferERC20Response
+	pattern := "/api/v1/erc20/batch-transfer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC20BatchTransferERC20))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // BurnERC20 BurnERC20 burns ERC20 tokens from the caller's balance
 func (c *ERC20HTTPClientImpl) BurnERC20(ctx context.Context, in *BurnERC20Request, opts ...http.CallOption) (*BurnERC20Response, error) {
 	var out BurnERC20Response
@@ -407,6 +586,20 @@ func (c *ERC20HTTPClientImpl) GetERC20Info(ctx context.Context, in *GetERC20Info
 	return &out, nil
 }
 
+// GetERC20Owner GetERC20Owner returns the current owner of the contract
+func (c *ERC20HTTPClientImpl) GetERC20Owner(ctx context.Context, in *GetERC20OwnerRequest, opts ...http.CallOption) (*GetERC20OwnerResponse, error) {
+	var out GetERC20OwnerResponse
+	pattern := "/api/v1/erc20/owner"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationERC20GetERC20Owner))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // MintERC20 MintERC20 mints new ERC20 tokens (only for contracts with mint function)
 func (c *ERC20HTTPClientImpl) MintERC20(ctx context.Context, in *MintERC20Request, opts ...http.CallOption) (*MintERC20Response, error) {
 	var out MintERC20Response
@@ -421,6 +614,48 @@ func (c *ERC20HTTPClientImpl) MintERC20(ctx context.Context, in *MintERC20Reques
 	return &out, nil
 }
 
+// RenounceERC20Ownership RenounceERC20Ownership leaves the contract without an owner (owner only, irreversible)
+func (c *ERC20HTTPClientImpl) RenounceERC20Ownership(ctx context.Context, in *RenounceERC20OwnershipRequest, opts ...http.CallOption) (*RenounceERC20OwnershipResponse, error) {
+	var out RenounceERC20OwnershipResponse
+	pattern := "/api/v1/erc20/renounce-ownership"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC20RenounceERC20Ownership))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SignERC20Permit SignERC20Permit builds and signs an EIP-2612 permit for the owner key without sending a transaction
+func (c *ERC20HTTPClientImpl) SignERC20Permit(ctx context.Context, in *SignERC20PermitRequest, opts ...http.CallOption) (*SignERC20PermitResponse, error) {
+	var out SignERC20PermitResponse
+	pattern := "/api/v1/erc20/permit/sign"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC20SignERC20Permit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitERC20Permit SubmitERC20Permit relays a permit signature and optionally transfers the permitted tokens
+func (c *ERC20HTTPClientImpl) SubmitERC20Permit(ctx context.Context, in *SubmitERC20PermitRequest, opts ...http.CallOption) (*SubmitERC20PermitResponse, error) {
+	var out SubmitERC20PermitResponse
+	pattern := "/api/v1/erc20/permit/submit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC20SubmitERC20Permit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // TransferERC20 TransferERC20 transfers ERC20 tokens from the caller to the specified address
 func (c *ERC20HTTPClientImpl) TransferERC20(ctx context.Context, in *TransferERC20Request, opts ...http.CallOption) (*TransferERC20Response, error) {
 	var out TransferERC20Response
@@ -435,6 +670,20 @@ func (c *ERC20HTTPClientImpl) TransferERC20(ctx context.Context, in *TransferERC
 	return &out, nil
 }
 
+// TransferERC20Ownership TransferERC20Ownership transfers ownership of the contract to a new owner (owner only)
+func (c *ERC20HTTPClientImpl) TransferERC20Ownership(ctx context.Context, in *TransferERC20OwnershipRequest, opts ...http.CallOption) (*TransferERC20OwnershipResponse, error) {
+	var out TransferERC20OwnershipResponse
+	pattern := "/api/v1/erc20/transfer-ownership"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC20TransferERC20Ownership))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // TransferFromERC20 TransferFromERC20 transfers ERC20 tokens from one address to another (requires approval)
 func (c *ERC20HTTPClientImpl) TransferFromERC20(ctx context.Context, in *TransferFromERC20Request, opts ...http.CallOption) (*TransferFromERC20Response, error) {
 	var out TransferFromERC20Response
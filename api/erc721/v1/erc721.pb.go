@@ -709,6 +709,7 @@ type TransferERC721Request struct {
 	ToAddress       string                 `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Recipient address
 	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID to transfer (as string)
 	PrivateKey      string                 `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -778,6 +779,13 @@ func (x *TransferERC721Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *TransferERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type TransferERC721Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -785,6 +793,7 @@ type TransferERC721Response struct {
 	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // From address
 	ToAddress       string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // To address
 	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -854,6 +863,13 @@ func (x *TransferERC721Response) GetTokenId() string {
 	return ""
 }
 
+func (x *TransferERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type SafeTransferERC721Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
@@ -861,6 +877,7 @@ type SafeTransferERC721Request struct {
 	ToAddress       string                 `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Recipient address
 	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID to transfer (as string)
 	PrivateKey      string                 `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -930,6 +947,13 @@ func (x *SafeTransferERC721Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *SafeTransferERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type SafeTransferERC721Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -937,6 +961,7 @@ type SafeTransferERC721Response struct {
 	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // From address
 	ToAddress       string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // To address
 	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1006,6 +1031,13 @@ func (x *SafeTransferERC721Response) GetTokenId() string {
 	return ""
 }
 
+func (x *SafeTransferERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type SafeTransferERC721WithDataRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
@@ -1014,6 +1046,7 @@ type SafeTransferERC721WithDataRequest struct {
 	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID to transfer (as string)
 	Data            []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`                                              // Additional data to send with transfer
 	PrivateKey      string                 `protobuf:"bytes,6,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,7,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1090,6 +1123,13 @@ func (x *SafeTransferERC721WithDataRequest) GetPrivateKey() string {
 	return ""
 }
 
+func (x *SafeTransferERC721WithDataRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type SafeTransferERC721WithDataResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1097,6 +1137,7 @@ type SafeTransferERC721WithDataResponse struct {
 	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // From address
 	ToAddress       string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // To address
 	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1166,12 +1207,20 @@ func (x *SafeTransferERC721WithDataResponse) GetTokenId() string {
 	return ""
 }
 
+func (x *SafeTransferERC721WithDataResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type ApproveERC721Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
 	ApprovedAddress string                 `protobuf:"bytes,2,opt,name=approved_address,json=approvedAddress,proto3" json:"approved_address,omitempty"` // Address to approve
 	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID to approve
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1234,6 +1283,13 @@ func (x *ApproveERC721Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *ApproveERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type ApproveERC721Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1241,6 +1297,7 @@ type ApproveERC721Response struct {
 	OwnerAddress    string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Owner address
 	ApprovedAddress string                 `protobuf:"bytes,4,opt,name=approved_address,json=approvedAddress,proto3" json:"approved_address,omitempty"` // Approved address
 	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1310,12 +1367,20 @@ func (x *ApproveERC721Response) GetTokenId() string {
 	return ""
 }
 
+func (x *ApproveERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type SetApprovalForAllERC721Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
 	OperatorAddress string                 `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"` // Operator address
 	Approved        bool                   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`                                     // Whether to approve or revoke approval
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1378,6 +1443,13 @@ func (x *SetApprovalForAllERC721Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *SetApprovalForAllERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type SetApprovalForAllERC721Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1385,6 +1457,7 @@ type SetApprovalForAllERC721Response struct {
 	OwnerAddress    string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Owner address
 	OperatorAddress string                 `protobuf:"bytes,4,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"` // Operator address
 	Approved        bool                   `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`                                     // Approval status set
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1454,12 +1527,20 @@ func (x *SetApprovalForAllERC721Response) GetApproved() bool {
 	return false
 }
 
+func (x *SetApprovalForAllERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type SafeMintERC721Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
 	ToAddress       string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Address to mint token to
 	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID to mint (as string)
 	PrivateKey      string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1522,12 +1603,20 @@ func (x *SafeMintERC721Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *SafeMintERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type SafeMintERC721Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
 	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
 	ToAddress       string                 `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`                   // Address that received minted token
 	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID minted
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1590,11 +1679,19 @@ func (x *SafeMintERC721Response) GetTokenId() string {
 	return ""
 }
 
+func (x *SafeMintERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type BurnERC721Request struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
 	TokenId         string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID to burn (as string)
 	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key (hex encoded, with or without 0x prefix)
+	Async           bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1650,11 +1747,19 @@ func (x *BurnERC721Request) GetPrivateKey() string {
 	return ""
 }
 
+func (x *BurnERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type BurnERC721Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
 	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
 	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID burned
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1710,12 +1815,20 @@ func (x *BurnERC721Response) GetTokenId() string {
 	return ""
 }
 
+func (x *BurnERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type DeployERC721Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Token name
 	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`                                 // Token symbol
 	PrivateKey    string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`       // Private key of the deployer
 	InitialOwner  string                 `protobuf:"bytes,4,opt,name=initial_owner,json=initialOwner,proto3" json:"initial_owner,omitempty"` // Initial owner address (optional, defaults to deployer)
+	Async         bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                  // Submit as an asynchronous job and return immediately
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1778,6 +1891,13 @@ func (x *DeployERC721Request) GetInitialOwner() string {
 	return ""
 }
 
+func (x *DeployERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 type DeployERC721Response struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
@@ -1785,6 +1905,7 @@ type DeployERC721Response struct {
 	DeployerAddress string                 `protobuf:"bytes,3,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"` // Deployer address
 	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                              // Token name
 	Symbol          string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`                                          // Token symbol
+	JobId           string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1854,162 +1975,1685 @@ func (x *DeployERC721Response) GetSymbol() string {
 	return ""
 }
 
-var File_erc721_v1_erc721_proto protoreflect.FileDescriptor
+func (x *DeployERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
 
-const file_erc721_v1_erc721_proto_rawDesc = "" +
-	"\n" +
-	"\x16erc721/v1/erc721.proto\x12\rapi.erc721.v1\x1a\x1cgoogle/api/annotations.proto\"i\n" +
-	"\x17GetERC721BalanceRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\"\x84\x01\n" +
-	"\x18GetERC721BalanceResponse\x12\x18\n" +
-	"\abalance\x18\x01 \x01(\tR\abalance\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\"F\n" +
-	"\x19GetERC721TokenInfoRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\"s\n" +
-	"\x1aGetERC721TokenInfoResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12)\n" +
-	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\"`\n" +
-	"\x18GetERC721TokenURIRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x02 \x01(\tR\atokenId\"~\n" +
-	"\x19GetERC721TokenURIResponse\x12\x1b\n" +
-	"\ttoken_uri\x18\x01 \x01(\tR\btokenUri\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\"_\n" +
-	"\x17GetERC721OwnerOfRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x02 \x01(\tR\atokenId\"\x85\x01\n" +
-	"\x18GetERC721OwnerOfResponse\x12#\n" +
-	"\rowner_address\x18\x01 \x01(\tR\fownerAddress\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\"`\n" +
-	"\x18GetERC721ApprovedRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x02 \x01(\tR\atokenId\"\x8c\x01\n" +
-	"\x19GetERC721ApprovedResponse\x12)\n" +
-	"\x10approved_address\x18\x01 \x01(\tR\x0fapprovedAddress\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\"\x9a\x01\n" +
-	"\x1dIsApprovedForAllERC721Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\x12)\n" +
-	"\x10operator_address\x18\x03 \x01(\tR\x0foperatorAddress\"\xb7\x01\n" +
-	"\x1eIsApprovedForAllERC721Response\x12\x1a\n" +
-	"\bapproved\x18\x01 \x01(\bR\bapproved\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
-	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\"\xc0\x01\n" +
-	"\x15TransferERC721Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x1f\n" +
-	"\vprivate_key\x18\x05 \x01(\tR\n" +
-	"privateKey\"\xb9\x01\n" +
-	"\x16TransferERC721Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x05 \x01(\tR\atokenId\"\xc4\x01\n" +
-	"\x19SafeTransferERC721Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x1f\n" +
-	"\vprivate_key\x18\x05 \x01(\tR\n" +
-	"privateKey\"\xbd\x01\n" +
-	"\x1aSafeTransferERC721Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x05 \x01(\tR\atokenId\"\xe0\x01\n" +
-	"!SafeTransferERC721WithDataRequest\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x12\n" +
-	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1f\n" +
-	"\vprivate_key\x18\x06 \x01(\tR\n" +
-	"privateKey\"\xc5\x01\n" +
-	"\"SafeTransferERC721WithDataResponse\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
-	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x05 \x01(\tR\atokenId\"\xa8\x01\n" +
-	"\x14ApproveERC721Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12)\n" +
-	"\x10approved_address\x18\x02 \x01(\tR\x0fapprovedAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\xc6\x01\n" +
-	"\x15ApproveERC721Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
-	"\x10approved_address\x18\x04 \x01(\tR\x0fapprovedAddress\x12\x19\n" +
-	"\btoken_id\x18\x05 \x01(\tR\atokenId\"\xb3\x01\n" +
-	"\x1eSetApprovalForAllERC721Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12)\n" +
-	"\x10operator_address\x18\x02 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
-	"\bapproved\x18\x03 \x01(\bR\bapproved\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\xd1\x01\n" +
-	"\x1fSetApprovalForAllERC721Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
-	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
-	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
-	"\bapproved\x18\x05 \x01(\bR\bapproved\"\x9d\x01\n" +
-	"\x15SafeMintERC721Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x1f\n" +
-	"\vprivate_key\x18\x04 \x01(\tR\n" +
-	"privateKey\"\x96\x01\n" +
-	"\x16SafeMintERC721Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
-	"\btoken_id\x18\x04 \x01(\tR\atokenId\"z\n" +
-	"\x11BurnERC721Request\x12)\n" +
-	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x1f\n" +
-	"\vprivate_key\x18\x03 \x01(\tR\n" +
-	"privateKey\"s\n" +
-	"\x12BurnERC721Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
-	"\btoken_id\x18\x03 \x01(\tR\atokenId\"\x87\x01\n" +
-	"\x13DeployERC721Request\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1f\n" +
-	"\vprivate_key\x18\x03 \x01(\tR\n" +
-	"privateKey\x12#\n" +
-	"\rinitial_owner\x18\x04 \x01(\tR\finitialOwner\"\xb1\x01\n" +
-	"\x14DeployERC721Response\x12\x17\n" +
-	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12)\n" +
-	"\x10deployer_address\x18\x03 \x01(\tR\x0fdeployerAddress\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
-	"\x06symbol\x18\x05 \x01(\tR\x06symbol2\xc4\x0f\n" +
+type BatchMintItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToAddress     string                 `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"` // Address to mint the token to
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`       // Token ID (optional, assigned from the contract's token ID sequence when empty)
+	Uri           string                 `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`                              // Token URI (optional, requires safeMint(address,uint256,string))
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMintItem) Reset() {
+	*x = BatchMintItem{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMintItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMintItem) ProtoMessage() {}
+
+func (x *BatchMintItem) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMintItem.ProtoReflect.Descriptor instead.
+func (*BatchMintItem) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BatchMintItem) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *BatchMintItem) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *BatchMintItem) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type BatchMintERC721Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	Items           []*BatchMintItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                            // Tokens to mint
+	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Private key of the contract owner (hex encoded, with or without 0x prefix)
+	BatchId         string                 `protobuf:"bytes,4,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`                         // Resume a previous batch; items are ignored
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchMintERC721Request) Reset() {
+	*x = BatchMintERC721Request{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMintERC721Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMintERC721Request) ProtoMessage() {}
+
+func (x *BatchMintERC721Request) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMintERC721Request.ProtoReflect.Descriptor instead.
+func (*BatchMintERC721Request) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchMintERC721Request) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *BatchMintERC721Request) GetItems() []*BatchMintItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchMintERC721Request) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *BatchMintERC721Request) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type BatchMintResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                         // Item index in the original input
+	ToAddress     string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"` // Address the token is minted to
+	TokenId       string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`       // Token ID (including assigned IDs)
+	Uri           string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`                              // Token URI
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // pending, submitted, confirmed or failed
+	TxHash        string                 `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`          // Transaction hash
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                          // Error message for failed items
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMintResult) Reset() {
+	*x = BatchMintResult{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMintResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMintResult) ProtoMessage() {}
+
+func (x *BatchMintResult) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMintResult.ProtoReflect.Descriptor instead.
+func (*BatchMintResult) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchMintResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchMintResult) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *BatchMintResult) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *BatchMintResult) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BatchMintResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchMintResult) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *BatchMintResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchMintERC721Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BatchId         string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`                         // Batch ID, used to resume the batch
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Minter address
+	Succeeded       int32                  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`                                   // Items submitted or confirmed
+	Failed          int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`                                         // Items that failed and can be resumed
+	Results         []*BatchMintResult     `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`                                        // Per-item results in input order
+	Pending         int32                  `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`                                       // Items not sent yet because of batch.max_txs_per_request, resume with batch_id
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchMintERC721Response) Reset() {
+	*x = BatchMintERC721Response{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMintERC721Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMintERC721Response) ProtoMessage() {}
+
+func (x *BatchMintERC721Response) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMintERC721Response.ProtoReflect.Descriptor instead.
+func (*BatchMintERC721Response) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchMintERC721Response) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchMintERC721Response) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *BatchMintERC721Response) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *BatchMintERC721Response) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchMintERC721Response) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchMintERC721Response) GetResults() []*BatchMintResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchMintERC721Response) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type PauseERC721Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	PrivateKey      string                 `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,3,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PauseERC721Request) Reset() {
+	*x = PauseERC721Request{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseERC721Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseERC721Request) ProtoMessage() {}
+
+func (x *PauseERC721Request) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseERC721Request.ProtoReflect.Descriptor instead.
+func (*PauseERC721Request) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PauseERC721Request) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *PauseERC721Request) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *PauseERC721Request) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *PauseERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type PauseERC721Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Owner address that signed the transaction
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PauseERC721Response) Reset() {
+	*x = PauseERC721Response{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseERC721Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseERC721Response) ProtoMessage() {}
+
+func (x *PauseERC721Response) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseERC721Response.ProtoReflect.Descriptor instead.
+func (*PauseERC721Response) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PauseERC721Response) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *PauseERC721Response) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *PauseERC721Response) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *PauseERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type UnpauseERC721Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	PrivateKey      string                 `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,3,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnpauseERC721Request) Reset() {
+	*x = UnpauseERC721Request{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpauseERC721Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpauseERC721Request) ProtoMessage() {}
+
+func (x *UnpauseERC721Request) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpauseERC721Request.ProtoReflect.Descriptor instead.
+func (*UnpauseERC721Request) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnpauseERC721Request) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *UnpauseERC721Request) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *UnpauseERC721Request) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *UnpauseERC721Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type UnpauseERC721Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	FromAddress     string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`             // Owner address that signed the transaction
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnpauseERC721Response) Reset() {
+	*x = UnpauseERC721Response{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpauseERC721Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpauseERC721Response) ProtoMessage() {}
+
+func (x *UnpauseERC721Response) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpauseERC721Response.ProtoReflect.Descriptor instead.
+func (*UnpauseERC721Response) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UnpauseERC721Response) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *UnpauseERC721Response) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *UnpauseERC721Response) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *UnpauseERC721Response) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetERC721PausedRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC721PausedRequest) Reset() {
+	*x = GetERC721PausedRequest{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC721PausedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC721PausedRequest) ProtoMessage() {}
+
+func (x *GetERC721PausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC721PausedRequest.ProtoReflect.Descriptor instead.
+func (*GetERC721PausedRequest) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetERC721PausedRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type GetERC721PausedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	Paused          bool                   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`                                         // Whether token transfers are paused
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC721PausedResponse) Reset() {
+	*x = GetERC721PausedResponse{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC721PausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC721PausedResponse) ProtoMessage() {}
+
+func (x *GetERC721PausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC721PausedResponse.ProtoReflect.Descriptor instead.
+func (*GetERC721PausedResponse) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetERC721PausedResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC721PausedResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type GetERC721OwnerRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC721OwnerRequest) Reset() {
+	*x = GetERC721OwnerRequest{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC721OwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC721OwnerRequest) ProtoMessage() {}
+
+func (x *GetERC721OwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC721OwnerRequest.ProtoReflect.Descriptor instead.
+func (*GetERC721OwnerRequest) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetERC721OwnerRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type GetERC721OwnerResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	OwnerAddress    string                 `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`          // Current owner (zero address after renouncing)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC721OwnerResponse) Reset() {
+	*x = GetERC721OwnerResponse{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC721OwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC721OwnerResponse) ProtoMessage() {}
+
+func (x *GetERC721OwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC721OwnerResponse.ProtoReflect.Descriptor instead.
+func (*GetERC721OwnerResponse) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetERC721OwnerResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC721OwnerResponse) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+type TransferERC721OwnershipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	NewOwner        string                 `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`                      // Address of the new owner
+	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,4,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferERC721OwnershipRequest) Reset() {
+	*x = TransferERC721OwnershipRequest{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferERC721OwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferERC721OwnershipRequest) ProtoMessage() {}
+
+func (x *TransferERC721OwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferERC721OwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferERC721OwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TransferERC721OwnershipRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TransferERC721OwnershipRequest) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *TransferERC721OwnershipRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *TransferERC721OwnershipRequest) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *TransferERC721OwnershipRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type TransferERC721OwnershipResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	PreviousOwner   string                 `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`       // Owner that signed the transaction
+	NewOwner        string                 `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`                      // New owner
+	JobId           string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferERC721OwnershipResponse) Reset() {
+	*x = TransferERC721OwnershipResponse{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferERC721OwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferERC721OwnershipResponse) ProtoMessage() {}
+
+func (x *TransferERC721OwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferERC721OwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferERC721OwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TransferERC721OwnershipResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TransferERC721OwnershipResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TransferERC721OwnershipResponse) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *TransferERC721OwnershipResponse) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *TransferERC721OwnershipResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type RenounceERC721OwnershipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	Confirmation    string                 `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`                              // Must be "RENOUNCE <contract_address>" to confirm the irreversible renounce
+	PrivateKey      string                 `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`                // Owner private key (hex encoded, with or without 0x prefix); omit when use_admin is set
+	UseAdmin        bool                   `protobuf:"varint,4,opt,name=use_admin,json=useAdmin,proto3" json:"use_admin,omitempty"`                     // Sign with the admin keystore instead of private_key
+	Async           bool                   `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`                                           // Submit as an asynchronous job and return immediately
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenounceERC721OwnershipRequest) Reset() {
+	*x = RenounceERC721OwnershipRequest{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceERC721OwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceERC721OwnershipRequest) ProtoMessage() {}
+
+func (x *RenounceERC721OwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceERC721OwnershipRequest.ProtoReflect.Descriptor instead.
+func (*RenounceERC721OwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RenounceERC721OwnershipRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *RenounceERC721OwnershipRequest) GetConfirmation() string {
+	if x != nil {
+		return x.Confirmation
+	}
+	return ""
+}
+
+func (x *RenounceERC721OwnershipRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *RenounceERC721OwnershipRequest) GetUseAdmin() bool {
+	if x != nil {
+		return x.UseAdmin
+	}
+	return false
+}
+
+func (x *RenounceERC721OwnershipRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type RenounceERC721OwnershipResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TxHash          string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                            // Transaction hash
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	PreviousOwner   string                 `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`       // Owner that signed the transaction
+	JobId           string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                               // Job ID (set when submitted asynchronously)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenounceERC721OwnershipResponse) Reset() {
+	*x = RenounceERC721OwnershipResponse{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceERC721OwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceERC721OwnershipResponse) ProtoMessage() {}
+
+func (x *RenounceERC721OwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceERC721OwnershipResponse.ProtoReflect.Descriptor instead.
+func (*RenounceERC721OwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RenounceERC721OwnershipResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *RenounceERC721OwnershipResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *RenounceERC721OwnershipResponse) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *RenounceERC721OwnershipResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetERC721MetadataRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // ERC721 contract address
+	TokenId         string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID (as string to handle large numbers)
+	Refresh         bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`                                       // Fetch the metadata again instead of using the cache
+	IncludeRaw      bool                   `protobuf:"varint,4,opt,name=include_raw,json=includeRaw,proto3" json:"include_raw,omitempty"`               // Return the original metadata JSON document in raw
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC721MetadataRequest) Reset() {
+	*x = GetERC721MetadataRequest{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC721MetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC721MetadataRequest) ProtoMessage() {}
+
+func (x *GetERC721MetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC721MetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetERC721MetadataRequest) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetERC721MetadataRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC721MetadataRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *GetERC721MetadataRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+func (x *GetERC721MetadataRequest) GetIncludeRaw() bool {
+	if x != nil {
+		return x.IncludeRaw
+	}
+	return false
+}
+
+type NFTAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TraitType     string                 `protobuf:"bytes,1,opt,name=trait_type,json=traitType,proto3" json:"trait_type,omitempty"`       // Trait name
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                                // Trait value (numbers and booleans are converted to strings)
+	DisplayType   string                 `protobuf:"bytes,3,opt,name=display_type,json=displayType,proto3" json:"display_type,omitempty"` // Display hint (e.g. number, boost_percentage, date)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFTAttribute) Reset() {
+	*x = NFTAttribute{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFTAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTAttribute) ProtoMessage() {}
+
+func (x *NFTAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTAttribute.ProtoReflect.Descriptor instead.
+func (*NFTAttribute) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NFTAttribute) GetTraitType() string {
+	if x != nil {
+		return x.TraitType
+	}
+	return ""
+}
+
+func (x *NFTAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *NFTAttribute) GetDisplayType() string {
+	if x != nil {
+		return x.DisplayType
+	}
+	return ""
+}
+
+type NFTMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Token name
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                       // Token description
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                                   // Image URL (ipfs:// and ar:// rewritten to the configured gateways)
+	Attributes    []*NFTAttribute        `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`                         // Token attributes
+	AnimationUrl  string                 `protobuf:"bytes,5,opt,name=animation_url,json=animationUrl,proto3" json:"animation_url,omitempty"` // Animation URL (ipfs:// and ar:// rewritten to the configured gateways)
+	ExternalUrl   string                 `protobuf:"bytes,6,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`    // External URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NFTMetadata) Reset() {
+	*x = NFTMetadata{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NFTMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTMetadata) ProtoMessage() {}
+
+func (x *NFTMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTMetadata.ProtoReflect.Descriptor instead.
+func (*NFTMetadata) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NFTMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetAttributes() []*NFTAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *NFTMetadata) GetAnimationUrl() string {
+	if x != nil {
+		return x.AnimationUrl
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+type GetERC721MetadataResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // Contract address
+	TokenId         string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // Token ID
+	TokenUri        string                 `protobuf:"bytes,3,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`                      // Token URI as returned by the contract
+	ResolvedUri     string                 `protobuf:"bytes,4,opt,name=resolved_uri,json=resolvedUri,proto3" json:"resolved_uri,omitempty"`             // Token URI after {id} substitution
+	Metadata        *NFTMetadata           `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`                                      // Normalized metadata
+	Raw             string                 `protobuf:"bytes,6,opt,name=raw,proto3" json:"raw,omitempty"`                                                // Original metadata JSON document (only with include_raw)
+	Cached          bool                   `protobuf:"varint,7,opt,name=cached,proto3" json:"cached,omitempty"`                                         // Whether the metadata was served from the cache
+	FetchedAt       int64                  `protobuf:"varint,8,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`                  // Unix timestamp when the metadata was fetched
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetERC721MetadataResponse) Reset() {
+	*x = GetERC721MetadataResponse{}
+	mi := &file_erc721_v1_erc721_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetERC721MetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERC721MetadataResponse) ProtoMessage() {}
+
+func (x *GetERC721MetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_erc721_v1_erc721_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetERC721MetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetERC721MetadataResponse) Descriptor() ([]byte, []int) {
+	return file_erc721_v1_erc721_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetERC721MetadataResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetERC721MetadataResponse) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *GetERC721MetadataResponse) GetTokenUri() string {
+	if x != nil {
+		return x.TokenUri
+	}
+	return ""
+}
+
+func (x *GetERC721MetadataResponse) GetResolvedUri() string {
+	if x != nil {
+		return x.ResolvedUri
+	}
+	return ""
+}
+
+func (x *GetERC721MetadataResponse) GetMetadata() *NFTMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetERC721MetadataResponse) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+func (x *GetERC721MetadataResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *GetERC721MetadataResponse) GetFetchedAt() int64 {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return 0
+}
+
+var File_erc721_v1_erc721_proto protoreflect.FileDescriptor
+
+const file_erc721_v1_erc721_proto_rawDesc = "" +
+	"\n" +
+	"\x16erc721/v1/erc721.proto\x12\rapi.erc721.v1\x1a\x1cgoogle/api/annotations.proto\"i\n" +
+	"\x17GetERC721BalanceRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\"\x84\x01\n" +
+	"\x18GetERC721BalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\tR\abalance\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\"F\n" +
+	"\x19GetERC721TokenInfoRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\"s\n" +
+	"\x1aGetERC721TokenInfoResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\"`\n" +
+	"\x18GetERC721TokenURIRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"~\n" +
+	"\x19GetERC721TokenURIResponse\x12\x1b\n" +
+	"\ttoken_uri\x18\x01 \x01(\tR\btokenUri\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\"_\n" +
+	"\x17GetERC721OwnerOfRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"\x85\x01\n" +
+	"\x18GetERC721OwnerOfResponse\x12#\n" +
+	"\rowner_address\x18\x01 \x01(\tR\fownerAddress\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\"`\n" +
+	"\x18GetERC721ApprovedRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"\x8c\x01\n" +
+	"\x19GetERC721ApprovedResponse\x12)\n" +
+	"\x10approved_address\x18\x01 \x01(\tR\x0fapprovedAddress\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\"\x9a\x01\n" +
+	"\x1dIsApprovedForAllERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\x12)\n" +
+	"\x10operator_address\x18\x03 \x01(\tR\x0foperatorAddress\"\xb7\x01\n" +
+	"\x1eIsApprovedForAllERC721Response\x12\x1a\n" +
+	"\bapproved\x18\x01 \x01(\bR\bapproved\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
+	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\"\xd6\x01\n" +
+	"\x15TransferERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x1f\n" +
+	"\vprivate_key\x18\x05 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x06 \x01(\bR\x05async\"\xd0\x01\n" +
+	"\x16TransferERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xda\x01\n" +
+	"\x19SafeTransferERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x1f\n" +
+	"\vprivate_key\x18\x05 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x06 \x01(\bR\x05async\"\xd4\x01\n" +
+	"\x1aSafeTransferERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xf6\x01\n" +
+	"!SafeTransferERC721WithDataRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1f\n" +
+	"\vprivate_key\x18\x06 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\a \x01(\bR\x05async\"\xdc\x01\n" +
+	"\"SafeTransferERC721WithDataResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xbe\x01\n" +
+	"\x14ApproveERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12)\n" +
+	"\x10approved_address\x18\x02 \x01(\tR\x0fapprovedAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xdd\x01\n" +
+	"\x15ApproveERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
+	"\x10approved_address\x18\x04 \x01(\tR\x0fapprovedAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xc9\x01\n" +
+	"\x1eSetApprovalForAllERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12)\n" +
+	"\x10operator_address\x18\x02 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
+	"\bapproved\x18\x03 \x01(\bR\bapproved\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xe8\x01\n" +
+	"\x1fSetApprovalForAllERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12)\n" +
+	"\x10operator_address\x18\x04 \x01(\tR\x0foperatorAddress\x12\x1a\n" +
+	"\bapproved\x18\x05 \x01(\bR\bapproved\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"\xb3\x01\n" +
+	"\x15SafeMintERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xad\x01\n" +
+	"\x16SafeMintERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\"\x90\x01\n" +
+	"\x11BurnERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\"\x8a\x01\n" +
+	"\x12BurnERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"\x9d\x01\n" +
+	"\x13DeployERC721Request\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12#\n" +
+	"\rinitial_owner\x18\x04 \x01(\tR\finitialOwner\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xc8\x01\n" +
+	"\x14DeployERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12)\n" +
+	"\x10deployer_address\x18\x03 \x01(\tR\x0fdeployerAddress\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x05 \x01(\tR\x06symbol\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\"[\n" +
+	"\rBatchMintItem\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x01 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x10\n" +
+	"\x03uri\x18\x03 \x01(\tR\x03uri\"\xb3\x01\n" +
+	"\x16BatchMintERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x122\n" +
+	"\x05items\x18\x02 \x03(\v2\x1c.api.erc721.v1.BatchMintItemR\x05items\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x19\n" +
+	"\bbatch_id\x18\x04 \x01(\tR\abatchId\"\xba\x01\n" +
+	"\x0fBatchMintResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x17\n" +
+	"\atx_hash\x18\x06 \x01(\tR\x06txHash\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\x8c\x02\n" +
+	"\x17BatchMintERC721Response\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x128\n" +
+	"\aresults\x18\x06 \x03(\v2\x1e.api.erc721.v1.BatchMintResultR\aresults\x12\x18\n" +
+	"\apending\x18\a \x01(\x05R\apending\"\x93\x01\n" +
+	"\x12PauseERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x03 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\"\x93\x01\n" +
+	"\x13PauseERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"\x95\x01\n" +
+	"\x14UnpauseERC721Request\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x03 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\"\x95\x01\n" +
+	"\x15UnpauseERC721Response\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"C\n" +
+	"\x16GetERC721PausedRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\"\\\n" +
+	"\x17GetERC721PausedResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x16\n" +
+	"\x06paused\x18\x02 \x01(\bR\x06paused\"B\n" +
+	"\x15GetERC721OwnerRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\"h\n" +
+	"\x16GetERC721OwnerResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12#\n" +
+	"\rowner_address\x18\x02 \x01(\tR\fownerAddress\"\xbc\x01\n" +
+	"\x1eTransferERC721OwnershipRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x1b\n" +
+	"\tnew_owner\x18\x02 \x01(\tR\bnewOwner\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x04 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xc0\x01\n" +
+	"\x1fTransferERC721OwnershipResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12%\n" +
+	"\x0eprevious_owner\x18\x03 \x01(\tR\rpreviousOwner\x12\x1b\n" +
+	"\tnew_owner\x18\x04 \x01(\tR\bnewOwner\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\"\xc3\x01\n" +
+	"\x1eRenounceERC721OwnershipRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\"\n" +
+	"\fconfirmation\x18\x02 \x01(\tR\fconfirmation\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1b\n" +
+	"\tuse_admin\x18\x04 \x01(\bR\buseAdmin\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xa3\x01\n" +
+	"\x1fRenounceERC721OwnershipResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12%\n" +
+	"\x0eprevious_owner\x18\x03 \x01(\tR\rpreviousOwner\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"\x9b\x01\n" +
+	"\x18GetERC721MetadataRequest\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\x12\x1f\n" +
+	"\vinclude_raw\x18\x04 \x01(\bR\n" +
+	"includeRaw\"f\n" +
+	"\fNFTAttribute\x12\x1d\n" +
+	"\n" +
+	"trait_type\x18\x01 \x01(\tR\ttraitType\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12!\n" +
+	"\fdisplay_type\x18\x03 \x01(\tR\vdisplayType\"\xde\x01\n" +
+	"\vNFTMetadata\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12;\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v2\x1b.api.erc721.v1.NFTAttributeR\n" +
+	"attributes\x12#\n" +
+	"\ranimation_url\x18\x05 \x01(\tR\fanimationUrl\x12!\n" +
+	"\fexternal_url\x18\x06 \x01(\tR\vexternalUrl\"\xa2\x02\n" +
+	"\x19GetERC721MetadataResponse\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x1b\n" +
+	"\ttoken_uri\x18\x03 \x01(\tR\btokenUri\x12!\n" +
+	"\fresolved_uri\x18\x04 \x01(\tR\vresolvedUri\x126\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x1a.api.erc721.v1.NFTMetadataR\bmetadata\x12\x10\n" +
+	"\x03raw\x18\x06 \x01(\tR\x03raw\x12\x16\n" +
+	"\x06cached\x18\a \x01(\bR\x06cached\x12\x1d\n" +
+	"\n" +
+	"fetched_at\x18\b \x01(\x03R\tfetchedAt2\x9d\x18\n" +
 	"\x06ERC721\x12\x83\x01\n" +
 	"\x10GetERC721Balance\x12&.api.erc721.v1.GetERC721BalanceRequest\x1a'.api.erc721.v1.GetERC721BalanceResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/erc721/balance\x12\x86\x01\n" +
 	"\x12GetERC721TokenInfo\x12(.api.erc721.v1.GetERC721TokenInfoRequest\x1a).api.erc721.v1.GetERC721TokenInfoResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/erc721/info\x12\x88\x01\n" +
-	"\x11GetERC721TokenURI\x12'.api.erc721.v1.GetERC721TokenURIRequest\x1a(.api.erc721.v1.GetERC721TokenURIResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/erc721/token-uri\x12\x84\x01\n" +
+	"\x11GetERC721TokenURI\x12'.api.erc721.v1.GetERC721TokenURIRequest\x1a(.api.erc721.v1.GetERC721TokenURIResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/erc721/token-uri\x12\x87\x01\n" +
+	"\x11GetERC721Metadata\x12'.api.erc721.v1.GetERC721MetadataRequest\x1a(.api.erc721.v1.GetERC721MetadataResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/erc721/metadata\x12\x84\x01\n" +
 	"\x10GetERC721OwnerOf\x12&.api.erc721.v1.GetERC721OwnerOfRequest\x1a'.api.erc721.v1.GetERC721OwnerOfResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/erc721/owner-of\x12\x87\x01\n" +
 	"\x11GetERC721Approved\x12'.api.erc721.v1.GetERC721ApprovedRequest\x1a(.api.erc721.v1.GetERC721ApprovedResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/erc721/approved\x12\xa1\x01\n" +
 	"\x16IsApprovedForAllERC721\x12,.api.erc721.v1.IsApprovedForAllERC721Request\x1a-.api.erc721.v1.IsApprovedForAllERC721Response\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/erc721/is-approved-for-all\x12\x81\x01\n" +
@@ -2021,7 +3665,14 @@ const file_erc721_v1_erc721_proto_rawDesc = "" +
 	"\x0eSafeMintERC721\x12$.api.erc721.v1.SafeMintERC721Request\x1a%.api.erc721.v1.SafeMintERC721Response\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/erc721/safe-mint\x12q\n" +
 	"\n" +
 	"BurnERC721\x12 .api.erc721.v1.BurnERC721Request\x1a!.api.erc721.v1.BurnERC721Response\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/erc721/burn\x12y\n" +
-	"\fDeployERC721\x12\".api.erc721.v1.DeployERC721Request\x1a#.api.erc721.v1.DeployERC721Response\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/erc721/deployB8\n" +
+	"\fDeployERC721\x12\".api.erc721.v1.DeployERC721Request\x1a#.api.erc721.v1.DeployERC721Response\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/erc721/deploy\x12\x86\x01\n" +
+	"\x0fBatchMintERC721\x12%.api.erc721.v1.BatchMintERC721Request\x1a&.api.erc721.v1.BatchMintERC721Response\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/erc721/batch-mint\x12u\n" +
+	"\vPauseERC721\x12!.api.erc721.v1.PauseERC721Request\x1a\".api.erc721.v1.PauseERC721Response\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/erc721/pause\x12}\n" +
+	"\rUnpauseERC721\x12#.api.erc721.v1.UnpauseERC721Request\x1a$.api.erc721.v1.UnpauseERC721Response\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/erc721/unpause\x12\x7f\n" +
+	"\x0fGetERC721Paused\x12%.api.erc721.v1.GetERC721PausedRequest\x1a&.api.erc721.v1.GetERC721PausedResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/erc721/paused\x12{\n" +
+	"\x0eGetERC721Owner\x12$.api.erc721.v1.GetERC721OwnerRequest\x1a%.api.erc721.v1.GetERC721OwnerResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/erc721/owner\x12\xa6\x01\n" +
+	"\x17TransferERC721Ownership\x12-.api.erc721.v1.TransferERC721OwnershipRequest\x1a..api.erc721.v1.TransferERC721OwnershipResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/erc721/transfer-ownership\x12\xa6\x01\n" +
+	"\x17RenounceERC721Ownership\x12-.api.erc721.v1.RenounceERC721OwnershipRequest\x1a..api.erc721.v1.RenounceERC721OwnershipResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/erc721/renounce-ownershipB8\n" +
 	"\rapi.erc721.v1P\x01Z%eth-contract-service/api/erc721/v1;v1b\x06proto3"
 
 var (
@@ -2036,7 +3687,7 @@ func file_erc721_v1_erc721_proto_rawDescGZIP() []byte {
 	return file_erc721_v1_erc721_proto_rawDescData
 }
 
-var file_erc721_v1_erc721_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_erc721_v1_erc721_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_erc721_v1_erc721_proto_goTypes = []any{
 	(*GetERC721BalanceRequest)(nil),            // 0: api.erc721.v1.GetERC721BalanceRequest
 	(*GetERC721BalanceResponse)(nil),           // 1: api.erc721.v1.GetERC721BalanceResponse
@@ -2066,41 +3717,81 @@ var file_erc721_v1_erc721_proto_goTypes = []any{
 	(*BurnERC721Response)(nil),                 // 25: api.erc721.v1.BurnERC721Response
 	(*DeployERC721Request)(nil),                // 26: api.erc721.v1.DeployERC721Request
 	(*DeployERC721Response)(nil),               // 27: api.erc721.v1.DeployERC721Response
+	(*BatchMintItem)(nil),                      // 28: api.erc721.v1.BatchMintItem
+	(*BatchMintERC721Request)(nil),             // 29: api.erc721.v1.BatchMintERC721Request
+	(*BatchMintResult)(nil),                    // 30: api.erc721.v1.BatchMintResult
+	(*BatchMintERC721Response)(nil),            // 31: api.erc721.v1.BatchMintERC721Response
+	(*PauseERC721Request)(nil),                 // 32: api.erc721.v1.PauseERC721Request
+	(*PauseERC721Response)(nil),                // 33: api.erc721.v1.PauseERC721Response
+	(*UnpauseERC721Request)(nil),               // 34: api.erc721.v1.UnpauseERC721Request
+	(*UnpauseERC721Response)(nil),              // 35: api.erc721.v1.UnpauseERC721Response
+	(*GetERC721PausedRequest)(nil),             // 36: api.erc721.v1.GetERC721PausedRequest
+	(*GetERC721PausedResponse)(nil),            // 37: api.erc721.v1.GetERC721PausedResponse
+	(*GetERC721OwnerRequest)(nil),              // 38: api.erc721.v1.GetERC721OwnerRequest
+	(*GetERC721OwnerResponse)(nil),             // 39: api.erc721.v1.GetERC721OwnerResponse
+	(*TransferERC721OwnershipRequest)(nil),     // 40: api.erc721.v1.TransferERC721OwnershipRequest
+	(*TransferERC721OwnershipResponse)(nil),    // 41: api.erc721.v1.TransferERC721OwnershipResponse
+	(*RenounceERC721OwnershipRequest)(nil),     // 42: api.erc721.v1.RenounceERC721OwnershipRequest
+	(*RenounceERC721OwnershipResponse)(nil),    // 43: api.erc721.v1.RenounceERC721OwnershipResponse
+	(*GetERC721MetadataRequest)(nil),           // 44: api.erc721.v1.GetERC721MetadataRequest
+	(*NFTAttribute)(nil),                       // 45: api.erc721.v1.NFTAttribute
+	(*NFTMetadata)(nil),                        // 46: api.erc721.v1.NFTMetadata
+	(*GetERC721MetadataResponse)(nil),          // 47: api.erc721.v1.GetERC721MetadataResponse
 }
 var file_erc721_v1_erc721_proto_depIdxs = []int32{
-	0,  // 0: api.erc721.v1.ERC721.GetERC721Balance:input_type -> api.erc721.v1.GetERC721BalanceRequest
-	2,  // 1: api.erc721.v1.ERC721.GetERC721TokenInfo:input_type -> api.erc721.v1.GetERC721TokenInfoRequest
-	4,  // 2: api.erc721.v1.ERC721.GetERC721TokenURI:input_type -> api.erc721.v1.GetERC721TokenURIRequest
-	6,  // 3: api.erc721.v1.ERC721.GetERC721OwnerOf:input_type -> api.erc721.v1.GetERC721OwnerOfRequest
-	8,  // 4: api.erc721.v1.ERC721.GetERC721Approved:input_type -> api.erc721.v1.GetERC721ApprovedRequest
-	10, // 5: api.erc721.v1.ERC721.IsApprovedForAllERC721:input_type -> api.erc721.v1.IsApprovedForAllERC721Request
-	12, // 6: api.erc721.v1.ERC721.TransferERC721:input_type -> api.erc721.v1.TransferERC721Request
-	14, // 7: api.erc721.v1.ERC721.SafeTransferERC721:input_type -> api.erc721.v1.SafeTransferERC721Request
-	16, // 8: api.erc721.v1.ERC721.SafeTransferERC721WithData:input_type -> api.erc721.v1.SafeTransferERC721WithDataRequest
-	18, // 9: api.erc721.v1.ERC721.ApproveERC721:input_type -> api.erc721.v1.ApproveERC721Request
-	20, // 10: api.erc721.v1.ERC721.SetApprovalForAllERC721:input_type -> api.erc721.v1.SetApprovalForAllERC721Request
-	22, // 11: api.erc721.v1.ERC721.SafeMintERC721:input_type -> api.erc721.v1.SafeMintERC721Request
-	24, // 12: api.erc721.v1.ERC721.BurnERC721:input_type -> api.erc721.v1.BurnERC721Request
-	26, // 13: api.erc721.v1.ERC721.DeployERC721:input_type -> api.erc721.v1.DeployERC721Request
-	1,  // 14: api.erc721.v1.ERC721.GetERC721Balance:output_type -> api.erc721.v1.GetERC721BalanceResponse
-	3,  // 15: api.erc721.v1.ERC721.GetERC721TokenInfo:output_type -> api.erc721.v1.GetERC721TokenInfoResponse
-	5,  // 16: api.erc721.v1.ERC721.GetERC721TokenURI:output_type -> api.erc721.v1.GetERC721TokenURIResponse
-	7,  // 17: api.erc721.v1.ERC721.GetERC721OwnerOf:output_type -> api.erc721.v1.GetERC721OwnerOfResponse
-	9,  // 18: api.erc721.v1.ERC721.GetERC721Approved:output_type -> api.erc721.v1.GetERC721ApprovedResponse
-	11, // 19: api.erc721.v1.ERC721.IsApprovedForAllERC721:output_type -> api.erc721.v1.IsApprovedForAllERC721Response
-	13, // 20: api.erc721.v1.ERC721.TransferERC721:output_type -> api.erc721.v1.TransferERC721Response
-	15, // 21: api.erc721.v1.ERC721.SafeTransferERC721:output_type -> api.erc721.v1.SafeTransferERC721Response
-	17, // 22: api.erc721.v1.ERC721.SafeTransferERC721WithData:output_type -> api.erc721.v1.SafeTransferERC721WithDataResponse
-	19, // 23: api.erc721.v1.ERC721.ApproveERC721:output_type -> api.erc721.v1.ApproveERC721Response
-	21, // 24: api.erc721.v1.ERC721.SetApprovalForAllERC721:output_type -> api.erc721.v1.SetApprovalForAllERC721Response
-	23, // 25: api.erc721.v1.ERC721.SafeMintERC721:output_type -> api.erc721.v1.SafeMintERC721Response
-	25, // 26: api.erc721.v1.ERC721.BurnERC721:output_type -> api.erc721.v1.BurnERC721Response
-	27, // 27: api.erc721.v1.ERC721.DeployERC721:output_type -> api.erc721.v1.DeployERC721Response
-	14, // [14:28] is the sub-list for method output_type
-	0,  // [0:14] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	28, // 0: api.erc721.v1.BatchMintERC721Request.items:type_name -> api.erc721.v1.BatchMintItem
+	30, // 1: api.erc721.v1.BatchMintERC721Response.results:type_name -> api.erc721.v1.BatchMintResult
+	45, // 2: api.erc721.v1.NFTMetadata.attributes:type_name -> api.erc721.v1.NFTAttribute
+	46, // 3: api.erc721.v1.GetERC721MetadataResponse.metadata:type_name -> api.erc721.v1.NFTMetadata
+	0,  // 4: api.erc721.v1.ERC721.GetERC721Balance:input_type -> api.erc721.v1.GetERC721BalanceRequest
+	2,  // 5: api.erc721.v1.ERC721.GetERC721TokenInfo:input_type -> api.erc721.v1.GetERC721TokenInfoRequest
+	4,  // 6: api.erc721.v1.ERC721.GetERC721TokenURI:input_type -> api.erc721.v1.GetERC721TokenURIRequest
+	44, // 7: api.erc721.v1.ERC721.GetERC721Metadata:input_type -> api.erc721.v1.GetERC721MetadataRequest
+	6,  // 8: api.erc721.v1.ERC721.GetERC721OwnerOf:input_type -> api.erc721.v1.GetERC721OwnerOfRequest
+	8,  // 9: api.erc721.v1.ERC721.GetERC721Approved:input_type -> api.erc721.v1.GetERC721ApprovedRequest
+	10, // 10: api.erc721.v1.ERC721.IsApprovedForAllERC721:input_type -> api.erc721.v1.IsApprovedForAllERC721Request
+	12, // 11: api.erc721.v1.ERC721.TransferERC721:input_type -> api.erc721.v1.TransferERC721Request
+	14, // 12: api.erc721.v1.ERC721.SafeTransferERC721:input_type -> api.erc721.v1.SafeTransferERC721Request
+	16, // 13: api.erc721.v1.ERC721.SafeTransferERC721WithData:input_type -> api.erc721.v1.SafeTransferERC721WithDataRequest
+	18, // 14: api.erc721.v1.ERC721.ApproveERC721:input_type -> api.erc721.v1.ApproveERC721Request
+	20, // 15: api.erc721.v1.ERC721.SetApprovalForAllERC721:input_type -> api.erc721.v1.SetApprovalForAllERC721Request
+	22, // 16: api.erc721.v1.ERC721.SafeMintERC721:input_type -> api.erc721.v1.SafeMintERC721Request
+	24, // 17: api.erc721.v1.ERC721.BurnERC721:input_type -> api.erc721.v1.BurnERC721Request
+	26, // 18: api.erc721.v1.ERC721.DeployERC721:input_type -> api.erc721.v1.DeployERC721Request
+	29, // 19: api.erc721.v1.ERC721.BatchMintERC721:input_type -> api.erc721.v1.BatchMintERC721Request
+	32, // 20: api.erc721.v1.ERC721.PauseERC721:input_type -> api.erc721.v1.PauseERC721Request
+	34, // 21: api.erc721.v1.ERC721.UnpauseERC721:input_type -> api.erc721.v1.UnpauseERC721Request
+	36, // 22: api.erc721.v1.ERC721.GetERC721Paused:input_type -> api.erc721.v1.GetERC721PausedRequest
+	38, // 23: api.erc721.v1.ERC721.GetERC721Owner:input_type -> api.erc721.v1.GetERC721OwnerRequest
+	40, // 24: api.erc721.v1.ERC721.TransferERC721Ownership:input_type -> api.erc721.v1.TransferERC721OwnershipRequest
+	42, // 25: api.erc721.v1.ERC721.RenounceERC721Ownership:input_type -> api.erc721.v1.RenounceERC721OwnershipRequest
+	1,  // 26: api.erc721.v1.ERC721.GetERC721Balance:output_type -> api.erc721.v1.GetERC721BalanceResponse
+	3,  // 27: api.erc721.v1.ERC721.GetERC721TokenInfo:output_type -> api.erc721.v1.GetERC721TokenInfoResponse
+	5,  // 28: api.erc721.v1.ERC721.GetERC721TokenURI:output_type -> api.erc721.v1.GetERC721TokenURIResponse
+	47, // 29: api.erc721.v1.ERC721.GetERC721Metadata:output_type -> api.erc721.v1.GetERC721MetadataResponse
+	7,  // 30: api.erc721.v1.ERC721.GetERC721OwnerOf:output_type -> api.erc721.v1.GetERC721OwnerOfResponse
+	9,  // 31: api.erc721.v1.ERC721.GetERC721Approved:output_type -> api.erc721.v1.GetERC721ApprovedResponse
+	11, // 32: api.erc721.v1.ERC721.IsApprovedForAllERC721:output_type -> api.erc721.v1.IsApprovedForAllERC721Response
+	13, // 33: api.erc721.v1.ERC721.TransferERC721:output_type -> api.erc721.v1.TransferERC721Response
+	15, // 34: api.erc721.v1.ERC721.SafeTransferERC721:output_type -> api.erc721.v1.SafeTransferERC721Response
+	17, // 35: api.erc721.v1.ERC721.SafeTransferERC721WithData:output_type -> api.erc721.v1.SafeTransferERC721WithDataResponse
+	19, // 36: api.erc721.v1.ERC721.ApproveERC721:output_type -> api.erc721.v1.ApproveERC721Response
+	21, // 37: api.erc721.v1.ERC721.SetApprovalForAllERC721:output_type -> api.erc721.v1.SetApprovalForAllERC721Response
+	23, // 38: api.erc721.v1.ERC721.SafeMintERC721:output_type -> api.erc721.v1.SafeMintERC721Response
+	25, // 39: api.erc721.v1.ERC721.BurnERC721:output_type -> api.erc721.v1.BurnERC721Response
+	27, // 40: api.erc721.v1.ERC721.DeployERC721:output_type -> api.erc721.v1.DeployERC721Response
+	31, // 41: api.erc721.v1.ERC721.BatchMintERC721:output_type -> api.erc721.v1.BatchMintERC721Response
+	33, // 42: api.erc721.v1.ERC721.PauseERC721:output_type -> api.erc721.v1.PauseERC721Response
+	35, // 43: api.erc721.v1.ERC721.UnpauseERC721:output_type -> api.erc721.v1.UnpauseERC721Response
+	37, // 44: api.erc721.v1.ERC721.GetERC721Paused:output_type -> api.erc721.v1.GetERC721PausedResponse
+	39, // 45: api.erc721.v1.ERC721.GetERC721Owner:output_type -> api.erc721.v1.GetERC721OwnerResponse
+	41, // 46: api.erc721.v1.ERC721.TransferERC721Ownership:output_type -> api.erc721.v1.TransferERC721OwnershipResponse
+	43, // 47: api.erc721.v1.ERC721.RenounceERC721Ownership:output_type -> api.erc721.v1.RenounceERC721OwnershipResponse
+	26, // [26:48] is the sub-list for method output_type
+	4,  // [4:26] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_erc721_v1_erc721_proto_init() }
@@ -2114,7 +3805,7 @@ func file_erc721_v1_erc721_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_erc721_v1_erc721_proto_rawDesc), len(file_erc721_v1_erc721_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
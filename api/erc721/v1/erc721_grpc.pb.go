@@ -22,6 +22,7 @@ const (
 	ERC721_GetERC721Balance_FullMethodName           = "/api.erc721.v1.ERC721/GetERC721Balance"
 	ERC721_GetERC721TokenInfo_FullMethodName         = "/api.erc721.v1.ERC721/GetERC721TokenInfo"
 	ERC721_GetERC721TokenURI_FullMethodName          = "/api.erc721.v1.ERC721/GetERC721TokenURI"
+	ERC721_GetERC721Metadata_FullMethodName          = "/api.erc721.v1.ERC721/GetERC721Metadata"
 	ERC721_GetERC721OwnerOf_FullMethodName           = "/api.erc721.v1.ERC721/GetERC721OwnerOf"
 	ERC721_GetERC721Approved_FullMethodName          = "/api.erc721.v1.ERC721/GetERC721Approved"
 	ERC721_IsApprovedForAllERC721_FullMethodName     = "/api.erc721.v1.ERC721/IsApprovedForAllERC721"
@@ -33,6 +34,13 @@ const (
 	ERC721_SafeMintERC721_FullMethodName             = "/api.erc721.v1.ERC721/SafeMintERC721"
 	ERC721_BurnERC721_FullMethodName                 = "/api.erc721.v1.ERC721/BurnERC721"
 	ERC721_DeployERC721_FullMethodName               = "/api.erc721.v1.ERC721/DeployERC721"
+	ERC721_BatchMintERC721_FullMethodName            = "/api.erc721.v1.ERC721/BatchMintERC721"
+	ERC721_PauseERC721_FullMethodName                = "/api.erc721.v1.ERC721/PauseERC721"
+	ERC721_UnpauseERC721_FullMethodName              = "/api.erc721.v1.ERC721/UnpauseERC721"
+	ERC721_GetERC721Paused_FullMethodName            = "/api.erc721.v1.ERC721/GetERC721Paused"
+	ERC721_GetERC721Owner_FullMethodName             = "/api.erc721.v1.ERC721/GetERC721Owner"
+	ERC721_TransferERC721Ownership_FullMethodName    = "/api.erc721.v1.ERC721/TransferERC721Ownership"
+	ERC721_RenounceERC721Ownership_FullMethodName    = "/api.erc721.v1.ERC721/RenounceERC721Ownership"
 )
 
 // ERC721Client is the client API for ERC721 service.
@@ -47,6 +55,8 @@ type ERC721Client interface {
 	GetERC721TokenInfo(ctx context.Context, in *GetERC721TokenInfoRequest, opts ...grpc.CallOption) (*GetERC721TokenInfoResponse, error)
 	// GetERC721TokenURI returns the URI for a specific token
 	GetERC721TokenURI(ctx context.Context, in *GetERC721TokenURIRequest, opts ...grpc.CallOption) (*GetERC721TokenURIResponse, error)
+	// GetERC721Metadata resolves the token URI and returns the normalized metadata document
+	GetERC721Metadata(ctx context.Context, in *GetERC721MetadataRequest, opts ...grpc.CallOption) (*GetERC721MetadataResponse, error)
 	// GetERC721OwnerOf returns the owner of a specific token
 	GetERC721OwnerOf(ctx context.Context, in *GetERC721OwnerOfRequest, opts ...grpc.CallOption) (*GetERC721OwnerOfResponse, error)
 	// GetERC721Approved returns the approved address for a token
@@ -69,6 +79,20 @@ type ERC721Client interface {
 	BurnERC721(ctx context.Context, in *BurnERC721Request, opts ...grpc.CallOption) (*BurnERC721Response, error)
 	// DeployERC721 deploys a new ERC721 token contract
 	DeployERC721(ctx context.Context, in *DeployERC721Request, opts ...grpc.CallOption) (*DeployERC721Response, error)
+	// BatchMintERC721 mints many tokens and reports the result per item
+	BatchMintERC721(ctx context.Context, in *BatchMintERC721Request, opts ...grpc.CallOption) (*BatchMintERC721Response, error)
+	// PauseERC721 pauses all token transfers (owner only)
+	PauseERC721(ctx context.Context, in *PauseERC721Request, opts ...grpc.CallOption) (*PauseERC721Response, error)
+	// UnpauseERC721 resumes token transfers (owner only)
+	UnpauseERC721(ctx context.Context, in *UnpauseERC721Request, opts ...grpc.CallOption) (*UnpauseERC721Response, error)
+	// GetERC721Paused returns whether the contract is paused
+	GetERC721Paused(ctx context.Context, in *GetERC721PausedRequest, opts ...grpc.CallOption) (*GetERC721PausedResponse, error)
+	// GetERC721Owner returns the current owner of the contract
+	GetERC721Owner(ctx context.Context, in *GetERC721OwnerRequest, opts ...grpc.CallOption) (*GetERC721OwnerResponse, error)
+	// TransferERC721Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC721Ownership(ctx context.Context, in *TransferERC721OwnershipRequest, opts ...grpc.CallOption) (*TransferERC721OwnershipResponse, error)
+	// RenounceERC721Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC721Ownership(ctx context.Context, in *RenounceERC721OwnershipRequest, opts ...grpc.CallOption) (*RenounceERC721OwnershipResponse, error)
 }
 
 type eRC721Client struct {
@@ -109,6 +133,16 @@ func (c *eRC721Client) GetERC721TokenURI(ctx context.Context, in *GetERC721Token
 	return out, nil
 }
 
+func (c *eRC721Client) GetERC721Metadata(ctx context.Context, in *GetERC721MetadataRequest, opts ...grpc.CallOption) (*GetERC721MetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetERC721MetadataResponse)
+	err := c.cc.Invoke(ctx, ERC721_GetERC721Metadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eRC721Client) GetERC721OwnerOf(ctx context.Context, in *GetERC721OwnerOfRequest, opts ...grpc.CallOption) (*GetERC721OwnerOfResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetERC721OwnerOfResponse)
@@ -219,6 +253,76 @@ func (c *eRC721Client) DeployERC721(ctx context.Context, in *DeployERC721Request
 	return out, nil
 }
 
+func (c *eRC721Client) BatchMintERC721(ctx context.Context, in *BatchMintERC721Request, opts ...grpc.CallOption) (*BatchMintERC721Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMintERC721Response)
+	err := c.cc.Invoke(ctx, ERC721_BatchMintERC721_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC721Client) PauseERC721(ctx context.Context, in *PauseERC721Request, opts ...grpc.CallOption) (*PauseERC721Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseERC721Response)
+	err := c.cc.Invoke(ctx, ERC721_PauseERC721_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC721Client) UnpauseERC721(ctx context.Context, in *UnpauseERC721Request, opts ...grpc.CallOption) (*UnpauseERC721Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpauseERC721Response)
+	err := c.cc.Invoke(ctx, ERC721_UnpauseERC721_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC721Client) GetERC721Paused(ctx context.Context, in *GetERC721PausedRequest, opts ...grpc.CallOption) (*GetERC721PausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetERC721PausedResponse)
+	err := c.cc.Invoke(ctx, ERC721_GetERC721Paused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC721Client) GetERC721Owner(ctx context.Context, in *GetERC721OwnerRequest, opts ...grpc.CallOption) (*GetERC721OwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetERC721OwnerResponse)
+	err := c.cc.Invoke(ctx, ERC721_GetERC721Owner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC721Client) TransferERC721Ownership(ctx context.Context, in *TransferERC721OwnershipRequest, opts ...grpc.CallOption) (*TransferERC721OwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferERC721OwnershipResponse)
+	err := c.cc.Invoke(ctx, ERC721_TransferERC721Ownership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eRC721Client) RenounceERC721Ownership(ctx context.Context, in *RenounceERC721OwnershipRequest, opts ...grpc.CallOption) (*RenounceERC721OwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenounceERC721OwnershipResponse)
+	err := c.cc.Invoke(ctx, ERC721_RenounceERC721Ownership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ERC721Server is the server API for ERC721 service.
 // All implementations must embed UnimplementedERC721Server
 // for forward compatibility.
@@ -231,6 +335,8 @@ type ERC721Server interface {
 	GetERC721TokenInfo(context.Context, *GetERC721TokenInfoRequest) (*GetERC721TokenInfoResponse, error)
 	// GetERC721TokenURI returns the URI for a specific token
 	GetERC721TokenURI(context.Context, *GetERC721TokenURIRequest) (*GetERC721TokenURIResponse, error)
+	// GetERC721Metadata resolves the token URI and returns the normalized metadata document
+	GetERC721Metadata(context.Context, *GetERC721MetadataRequest) (*GetERC721MetadataResponse, error)
 	// GetERC721OwnerOf returns the owner of a specific token
 	GetERC721OwnerOf(context.Context, *GetERC721OwnerOfRequest) (*GetERC721OwnerOfResponse, error)
 	// GetERC721Approved returns the approved address for a token
@@ -253,6 +359,20 @@ type ERC721Server interface {
 	BurnERC721(context.Context, *BurnERC721Request) (*BurnERC721Response, error)
 	// DeployERC721 deploys a new ERC721 token contract
 	DeployERC721(context.Context, *DeployERC721Request) (*DeployERC721Response, error)
+	// BatchMintERC721 mints many tokens and reports the result per item
+	BatchMintERC721(context.Context, *BatchMintERC721Request) (*BatchMintERC721Response, error)
+	// PauseERC721 pauses all token transfers (owner only)
+	PauseERC721(context.Context, *PauseERC721Request) (*PauseERC721Response, error)
+	// UnpauseERC721 resumes token transfers (owner only)
+	UnpauseERC721(context.Context, *UnpauseERC721Request) (*UnpauseERC721Response, error)
+	// GetERC721Paused returns whether the contract is paused
+	GetERC721Paused(context.Context, *GetERC721PausedRequest) (*GetERC721PausedResponse, error)
+	// GetERC721Owner returns the current owner of the contract
+	GetERC721Owner(context.Context, *GetERC721OwnerRequest) (*GetERC721OwnerResponse, error)
+	// TransferERC721Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC721Ownership(context.Context, *TransferERC721OwnershipRequest) (*TransferERC721OwnershipResponse, error)
+	// RenounceERC721Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC721Ownership(context.Context, *RenounceERC721OwnershipRequest) (*RenounceERC721OwnershipResponse, error)
 	mustEmbedUnimplementedERC721Server()
 }
 
@@ -272,6 +392,9 @@ func (UnimplementedERC721Server) GetERC721TokenInfo(context.Context, *GetERC721T
 func (UnimplementedERC721Server) GetERC721TokenURI(context.Context, *GetERC721TokenURIRequest) (*GetERC721TokenURIResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetERC721TokenURI not implemented")
 }
+func (UnimplementedERC721Server) GetERC721Metadata(context.Context, *GetERC721MetadataRequest) (*GetERC721MetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetERC721Metadata not implemented")
+}
 func (UnimplementedERC721Server) GetERC721OwnerOf(context.Context, *GetERC721OwnerOfRequest) (*GetERC721OwnerOfResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetERC721OwnerOf not implemented")
 }
@@ -305,6 +428,27 @@ func (UnimplementedERC721Server) BurnERC721(context.Context, *BurnERC721Request)
 func (UnimplementedERC721Server) DeployERC721(context.Context, *DeployERC721Request) (*DeployERC721Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeployERC721 not implemented")
 }
+func (UnimplementedERC721Server) BatchMintERC721(context.Context, *BatchMintERC721Request) (*BatchMintERC721Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchMintERC721 not implemented")
+}
+func (UnimplementedERC721Server) PauseERC721(context.Context, *PauseERC721Request) (*PauseERC721Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseERC721 not implemented")
+}
+func (UnimplementedERC721Server) UnpauseERC721(context.Context, *UnpauseERC721Request) (*UnpauseERC721Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpauseERC721 not implemented")
+}
+func (UnimplementedERC721Server) GetERC721Paused(context.Context, *GetERC721PausedRequest) (*GetERC721PausedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetERC721Paused not implemented")
+}
+func (UnimplementedERC721Server) GetERC721Owner(context.Context, *GetERC721OwnerRequest) (*GetERC721OwnerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetERC721Owner not implemented")
+}
+func (UnimplementedERC721Server) TransferERC721Ownership(context.Context, *TransferERC721OwnershipRequest) (*TransferERC721OwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferERC721Ownership not implemented")
+}
+func (UnimplementedERC721Server) RenounceERC721Ownership(context.Context, *RenounceERC721OwnershipRequest) (*RenounceERC721OwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenounceERC721Ownership not implemented")
+}
 func (UnimplementedERC721Server) mustEmbedUnimplementedERC721Server() {}
 func (UnimplementedERC721Server) testEmbeddedByValue()                {}
 
@@ -380,6 +524,24 @@ func _ERC721_GetERC721TokenURI_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ERC721_GetERC721Metadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetERC721MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).GetERC721Metadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_GetERC721Metadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).GetERC721Metadata(ctx, req.(*GetERC721MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ERC721_GetERC721OwnerOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetERC721OwnerOfRequest)
 	if err := dec(in); err != nil {
@@ -578,6 +740,132 @@ func _ERC721_DeployERC721_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ERC721_BatchMintERC721_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMintERC721Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).BatchMintERC721(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_BatchMintERC721_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).BatchMintERC721(ctx, req.(*BatchMintERC721Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC721_PauseERC721_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseERC721Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).PauseERC721(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_PauseERC721_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).PauseERC721(ctx, req.(*PauseERC721Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC721_UnpauseERC721_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseERC721Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).UnpauseERC721(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_UnpauseERC721_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).UnpauseERC721(ctx, req.(*UnpauseERC721Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC721_GetERC721Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetERC721PausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).GetERC721Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_GetERC721Paused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).GetERC721Paused(ctx, req.(*GetERC721PausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC721_GetERC721Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetERC721OwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).GetERC721Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_GetERC721Owner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).GetERC721Owner(ctx, req.(*GetERC721OwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC721_TransferERC721Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferERC721OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).TransferERC721Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_TransferERC721Ownership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).TransferERC721Ownership(ctx, req.(*TransferERC721OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ERC721_RenounceERC721Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenounceERC721OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ERC721Server).RenounceERC721Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ERC721_RenounceERC721Ownership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ERC721Server).RenounceERC721Ownership(ctx, req.(*RenounceERC721OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ERC721_ServiceDesc is the grpc.ServiceDesc for ERC721 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -597,6 +885,10 @@ var ERC721_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetERC721TokenURI",
 			Handler:    _ERC721_GetERC721TokenURI_Handler,
 		},
+		{
+			MethodName: "GetERC721Metadata",
+			Handler:    _ERC721_GetERC721Metadata_Handler,
+		},
 		{
 			MethodName: "GetERC721OwnerOf",
 			Handler:    _ERC721_GetERC721OwnerOf_Handler,
@@ -641,6 +933,34 @@ var ERC721_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeployERC721",
 			Handler:    _ERC721_DeployERC721_Handler,
 		},
+		{
+			MethodName: "BatchMintERC721",
+			Handler:    _ERC721_BatchMintERC721_Handler,
+		},
+		{
+			MethodName: "PauseERC721",
+			Handler:    _ERC721_PauseERC721_Handler,
+		},
+		{
+			MethodName: "UnpauseERC721",
+			Handler:    _ERC721_UnpauseERC721_Handler,
+		},
+		{
+			MethodName: "GetERC721Paused",
+			Handler:    _ERC721_GetERC721Paused_Handler,
+		},
+		{
+			MethodName: "GetERC721Owner",
+			Handler:    _ERC721_GetERC721Owner_Handler,
+		},
+		{
+			MethodName: "TransferERC721Ownership",
+			Handler:    _ERC721_TransferERC721Ownership_Handler,
+		},
+		{
+			MethodName: "RenounceERC721Ownership",
+			Handler:    _ERC721_RenounceERC721Ownership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "erc721/v1/erc721.proto",
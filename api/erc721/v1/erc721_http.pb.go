@@ -20,23 +20,33 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationERC721ApproveERC721 = "/api.erc721.v1.ERC721/ApproveERC721"
+const OperationERC721BatchMintERC721 = "/api.erc721.v1.ERC721/BatchMintERC721"
 const OperationERC721BurnERC721 = "/api.erc721.v1.ERC721/BurnERC721"
 const OperationERC721DeployERC721 = "/api.erc721.v1.ERC721/DeployERC721"
 const OperationERC721GetERC721Approved = "/api.erc721.v1.ERC721/GetERC721Approved"
 const OperationERC721GetERC721Balance = "/api.erc721.v1.ERC721/GetERC721Balance"
+const OperationERC721GetERC721Metadata = "/api.erc721.v1.ERC721/GetERC721Metadata"
+const OperationERC721GetERC721Owner = "/api.erc721.v1.ERC721/GetERC721Owner"
 const OperationERC721GetERC721OwnerOf = "/api.erc721.v1.ERC721/GetERC721OwnerOf"
+const OperationERC721GetERC721Paused = "/api.erc721.v1.ERC721/GetERC721Paused"
 const OperationERC721GetERC721TokenInfo = "/api.erc721.v1.ERC721/GetERC721TokenInfo"
 const OperationERC721GetERC721TokenURI = "/api.erc721.v1.ERC721/GetERC721TokenURI"
 const OperationERC721IsApprovedForAllERC721 = "/api.erc721.v1.ERC721/IsApprovedForAllERC721"
+const OperationERC721PauseERC721 = "/api.erc721.v1.ERC721/PauseERC721"
+const OperationERC721RenounceERC721Ownership = "/api.erc721.v1.ERC721/RenounceERC721Ownership"
 const OperationERC721SafeMintERC721 = "/api.erc721.v1.ERC721/SafeMintERC721"
 const OperationERC721SafeTransferERC721 = "/api.erc721.v1.ERC721/SafeTransferERC721"
 const OperationERC721SafeTransferERC721WithData = "/api.erc721.v1.ERC721/SafeTransferERC721WithData"
 const OperationERC721SetApprovalForAllERC721 = "/api.erc721.v1.ERC721/SetApprovalForAllERC721"
 const OperationERC721TransferERC721 = "/api.erc721.v1.ERC721/TransferERC721"
+const OperationERC721TransferERC721Ownership = "/api.erc721.v1.ERC721/TransferERC721Ownership"
+const OperationERC721UnpauseERC721 = "/api.erc721.v1.ERC721/UnpauseERC721"
 
 type ERC721HTTPServer interface {
 	// ApproveERC721 ApproveERC721 approves another address to transfer the specified token
 	ApproveERC721(context.Context, *ApproveERC721Request) (*ApproveERC721Response, error)
+	// BatchMintERC721 BatchMintERC721 mints many tokens and reports the result per item
+	BatchMintERC721(context.Context, *BatchMintERC721Request) (*BatchMintERC721Response, error)
 	// BurnERC721 BurnERC721 burns an ERC721 token
 	BurnERC721(context.Context, *BurnERC721Request) (*BurnERC721Response, error)
 	// DeployERC721 DeployERC721 deploys a new ERC721 token contract
@@ -45,14 +55,24 @@ type ERC721HTTPServer interface {
 	GetERC721Approved(context.Context, *GetERC721ApprovedRequest) (*GetERC721ApprovedResponse, error)
 	// GetERC721Balance GetERC721Balance returns the ERC721 token balance (number of NFTs) of the specified address
 	GetERC721Balance(context.Context, *GetERC721BalanceRequest) (*GetERC721BalanceResponse, error)
+	// GetERC721Metadata GetERC721Metadata resolves the token URI and returns the normalized metadata document
+	GetERC721Metadata(context.Context, *GetERC721MetadataRequest) (*GetERC721MetadataResponse, error)
+	// GetERC721Owner GetERC721Owner returns the current owner of the contract
+	GetERC721Owner(context.Context, *GetERC721OwnerRequest) (*GetERC721OwnerResponse, error)
 	// GetERC721OwnerOf GetERC721OwnerOf returns the owner of a specific token
 	GetERC721OwnerOf(context.Context, *GetERC721OwnerOfRequest) (*GetERC721OwnerOfResponse, error)
+	// GetERC721Paused GetERC721Paused returns whether the contract is paused
+	GetERC721Paused(context.Context, *GetERC721PausedRequest) (*GetERC721PausedResponse, error)
 	// GetERC721TokenInfo GetERC721TokenInfo returns ERC721 token information (name, symbol)
 	GetERC721TokenInfo(context.Context, *GetERC721TokenInfoRequest) (*GetERC721TokenInfoResponse, error)
 	// GetERC721TokenURI GetERC721TokenURI returns the URI for a specific token
 	GetERC721TokenURI(context.Context, *GetERC721TokenURIRequest) (*GetERC721TokenURIResponse, error)
 	// IsApprovedForAllERC721 IsApprovedForAllERC721 checks if an operator is approved for all tokens of an owner
 	IsApprovedForAllERC721(context.Context, *IsApprovedForAllERC721Request) (*IsApprovedForAllERC721Response, error)
+	// PauseERC721 PauseERC721 pauses all token transfers (owner only)
+	PauseERC721(context.Context, *PauseERC721Request) (*PauseERC721Response, error)
+	// RenounceERC721Ownership RenounceERC721Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC721Ownership(context.Context, *RenounceERC721OwnershipRequest) (*RenounceERC721OwnershipResponse, error)
 	// SafeMintERC721 SafeMintERC721 safely mints a new ERC721 token
 	SafeMintERC721(context.Context, *SafeMintERC721Request) (*SafeMintERC721Response, error)
 	// SafeTransferERC721 SafeTransferERC721 safely transfers an ERC721 token (calls onERC721Received on recipient)
@@ -63,6 +83,10 @@ type ERC721HTTPServer interface {
 	SetApprovalForAllERC721(context.Context, *SetApprovalForAllERC721Request) (*SetApprovalForAllERC721Response, error)
 	// TransferERC721 TransferERC721 transfers an ERC721 token from the caller to the specified address
 	TransferERC721(context.Context, *TransferERC721Request) (*TransferERC721Response, error)
+	// TransferERC721Ownership TransferERC721Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC721Ownership(context.Context, *TransferERC721OwnershipRequest) (*TransferERC721OwnershipResponse, error)
+	// UnpauseERC721 UnpauseERC721 resumes token transfers (owner only)
+	UnpauseERC721(context.Context, *UnpauseERC721Request) (*UnpauseERC721Response, error)
 }
 
 func RegisterERC721HTTPServer(s *http.Server, srv ERC721HTTPServer) {
@@ -70,6 +94,7 @@ func RegisterERC721HTTPServer(s *http.Server, srv ERC721HTTPServer) {
 	r.GET("/api/v1/erc721/balance", _ERC721_GetERC721Balance0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc721/info", _ERC721_GetERC721TokenInfo0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc721/token-uri", _ERC721_GetERC721TokenURI0_HTTP_Handler(srv))
+	r.GET("/api/v1/erc721/metadata", _ERC721_GetERC721Metadata0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc721/owner-of", _ERC721_GetERC721OwnerOf0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc721/approved", _ERC721_GetERC721Approved0_HTTP_Handler(srv))
 	r.GET("/api/v1/erc721/is-approved-for-all", _ERC721_IsApprovedForAllERC7210_HTTP_Handler(srv))
@@ -81,6 +106,13 @@ func RegisterERC721HTTPServer(s *http.Server, srv ERC721HTTPServer) {
 	r.POST("/api/v1/erc721/safe-mint", _ERC721_SafeMintERC7210_HTTP_Handler(srv))
 	r.POST("/api/v1/erc721/burn", _ERC721_BurnERC7210_HTTP_Handler(srv))
 	r.POST("/api/v1/erc721/deploy", _ERC721_DeployERC7210_HTTP_Handler(srv))
+	r.POST("/api/v1/erc721/batch-mint", _ERC721_BatchMintERC7210_HTTP_Handler(srv))
+	r.POST("/api/v1/erc721/pause", _ERC721_PauseERC7210_HTTP_Handler(srv))
+	r.POST("/api/v1/erc721/unpause", _ERC721_UnpauseERC7210_HTTP_Handler(srv))
+	r.GET("/api/v1/erc721/paused", _ERC721_GetERC721Paused0_HTTP_Handler(srv))
+	r.GET("/api/v1/erc721/owner", _ERC721_GetERC721Owner0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc721/transfer-ownership", _ERC721_TransferERC721Ownership0_HTTP_Handler(srv))
+	r.POST("/api/v1/erc721/renounce-ownership", _ERC721_RenounceERC721Ownership0_HTTP_Handler(srv))
 }
 
 func _ERC721_GetERC721Balance0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
@@ -140,6 +172,25 @@ func _ERC721_GetERC721TokenURI0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http
 	}
 }
 
+func _ERC721_GetERC721Metadata0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetERC721MetadataRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721GetERC721Metadata)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetERC721Metadata(ctx, req.(*GetERC721MetadataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetERC721MetadataResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ERC721_GetERC721OwnerOf0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetERC721OwnerOfRequest
@@ -373,9 +424,159 @@ func _ERC721_DeployERC7210_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Cont
 	}
 }
 
+func _ERC721_BatchMintERC7210_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchMintERC721Request
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721BatchMintERC721)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchMintERC721(ctx, req.(*BatchMintERC721Request))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchMintERC721Response)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC721_PauseERC7210_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PauseERC721Request
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721PauseERC721)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseERC721(ctx, req.(*PauseERC721Request))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PauseERC721Response)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC721_UnpauseERC7210_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnpauseERC721Request
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721UnpauseERC721)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnpauseERC721(ctx, req.(*UnpauseERC721Request))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnpauseERC721Response)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC721_GetERC721Paused0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetERC721PausedRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721GetERC721Paused)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetERC721Paused(ctx, req.(*GetERC721PausedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetERC721PausedResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC721_GetERC721Owner0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetERC721OwnerRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721GetERC721Owner)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetERC721Owner(ctx, req.(*GetERC721OwnerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetERC721OwnerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC721_TransferERC721Ownership0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferERC721OwnershipRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721TransferERC721Ownership)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TransferERC721Ownership(ctx, req.(*TransferERC721OwnershipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferERC721OwnershipResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ERC721_RenounceERC721Ownership0_HTTP_Handler(srv ERC721HTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenounceERC721OwnershipRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationERC721RenounceERC721Ownership)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenounceERC721Ownership(ctx, req.(*RenounceERC721OwnershipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenounceERC721OwnershipResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ERC721HTTPClient interface {
 	// ApproveERC721 ApproveERC721 approves another address to transfer the specified token
 	ApproveERC721(ctx context.Context, req *ApproveERC721Request, opts ...http.CallOption) (rsp *ApproveERC721Response, err error)
+	// BatchMintERC721 BatchMintERC721 mints many tokens and reports the result per item
+	BatchMintERC721(ctx context.Context, req *BatchMintERC721Request, opts ...http.CallOption) (rsp *BatchMintERC721Response, err error)
 	// BurnERC721 BurnERC721 burns an ERC721 token
 	BurnERC721(ctx context.Context, req *BurnERC721Request, opts ...http.CallOption) (rsp *BurnERC721Response, err error)
 	// DeployERC721 DeployERC721 deploys a new ERC721 token contract
@@ -384,14 +585,24 @@ type ERC721HTTPClient interface {
 	GetERC721Approved(ctx context.Context, req *GetERC721ApprovedRequest, opts ...http.CallOption) (rsp *GetERC721ApprovedResponse, err error)
 	// GetERC721Balance GetERC721Balance returns the ERC721 token balance (number of NFTs) of the specified address
 	GetERC721Balance(ctx context.Context, req *GetERC721BalanceRequest, opts ...http.CallOption) (rsp *GetERC721BalanceResponse, err error)
+	// GetERC721Metadata GetERC721Metadata resolves the token URI and returns the normalized metadata document
+	GetERC721Metadata(ctx context.Context, req *GetERC721MetadataRequest, opts ...http.CallOption) (rsp *GetERC721MetadataResponse, err error)
+	// GetERC721Owner GetERC721Owner returns the current owner of the contract
+	GetERC721Owner(ctx context.Context, req *GetERC721OwnerRequest, opts ...http.CallOption) (rsp *GetERC721OwnerResponse, err error)
 	// GetERC721OwnerOf GetERC721OwnerOf returns the owner of a specific token
 	GetERC721OwnerOf(ctx context.Context, req *GetERC721OwnerOfRequest, opts ...http.CallOption) (rsp *GetERC721OwnerOfResponse, err error)
+	// GetERC721Paused GetERC721Paused returns whether the contract is paused
+	GetERC721Paused(ctx context.Context, req *GetERC721PausedRequest, opts ...http.CallOption) (rsp *GetERC721PausedResponse, err error)
 	// GetERC721TokenInfo GetERC721TokenInfo returns ERC721 token information (name, symbol)
 	GetERC721TokenInfo(ctx context.Context, req *GetERC721TokenInfoRequest, opts ...http.CallOption) (rsp *GetERC721TokenInfoResponse, err error)
 	// GetERC721TokenURI GetERC721TokenURI returns the URI for a specific token
 	GetERC721TokenURI(ctx context.Context, req *GetERC721TokenURIRequest, opts ...http.CallOption) (rsp *GetERC721TokenURIResponse, err error)
 	// IsApprovedForAllERC721 IsApprovedForAllERC721 checks if an operator is approved for all tokens of an owner
 	IsApprovedForAllERC721(ctx context.Context, req *IsApprovedForAllERC721Request, opts ...http.CallOption) (rsp *IsApprovedForAllERC721Response, err error)
+	// PauseERC721 PauseERC721 pauses all token transfers (owner only)
+	PauseERC721(ctx context.Context, req *PauseERC721Request, opts ...http.CallOption) (rsp *PauseERC721Response, err error)
+	// RenounceERC721Ownership RenounceERC721Ownership leaves the contract without an owner (owner only, irreversible)
+	RenounceERC721Ownership(ctx context.Context, req *RenounceERC721OwnershipRequest, opts ...http.CallOption) (rsp *RenounceERC721OwnershipResponse, err error)
 	// SafeMintERC721 SafeMintERC721 safely mints a new ERC721 token
 	SafeMintERC721(ctx context.Context, req *SafeMintERC721Request, opts ...http.CallOption) (rsp *SafeMintERC721Response, err error)
 	// SafeTransferERC721 SafeTransferERC721 safely transfers an ERC721 token (calls onERC721Received on recipient)
@@ -402,6 +613,10 @@ type ERC721HTTPClient interface {
 	SetApprovalForAllERC721(ctx context.Context, req *SetApprovalForAllERC721Request, opts ...http.CallOption) (rsp *SetApprovalForAllERC721Response, err error)
 	// TransferERC721 TransferERC721 transfers an ERC721 token from the caller to the specified address
 	TransferERC721(ctx context.Context, req *TransferERC721Request, opts ...http.CallOption) (rsp *TransferERC721Response, err error)
+	// TransferERC721Ownership TransferERC721Ownership transfers ownership of the contract to a new owner (owner only)
+	TransferERC721Ownership(ctx context.Context, req *TransferERC721OwnershipRequest, opts ...http.CallOption) (rsp *TransferERC721OwnershipResponse, err error)
+	// UnpauseERC721 UnpauseERC721 resumes token transfers (owner only)
+	UnpauseERC721(ctx context.Context, req *UnpauseERC721Request, opts ...http.CallOption) (rsp *UnpauseERC721Response, err error)
 }
 
 type ERC721HTTPClientImpl struct {
@@ -426,6 +641,20 @@ func (c *ERC721HTTPClientImpl) ApproveERC721(ctx context.Context, in *ApproveERC
 	return &out, nil
 }
 
+// BatchMintERC721 BatchMintERC721 mints many tokens and reports the result per item
+func (c *ERC721HTTPClientImpl) BatchMintERC721(ctx context.Context, in *BatchMintERC721Request, opts ...http.CallOption) (*BatchMintERC721Response, error) {
+	var out BatchMintERC721Response
+	pattern := "/api/v1/erc721/batch-mint"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC721BatchMintERC721))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // BurnERC721 BurnERC721 burns an ERC721 token
 func (c *ERC721HTTPClientImpl) BurnERC721(ctx context.Context, in *BurnERC721Request, opts ...http.CallOption) (*BurnERC721Response, error) {
 	var out BurnERC721Response
@@ -482,6 +711,34 @@ func (c *ERC721HTTPClientImpl) GetERC721Balance(ctx context.Context, in *GetERC7
 	return &out, nil
 }
 
+// GetERC721Metadata GetERC721Metadata resolves the token URI and returns the normalized metadata document
+func (c *ERC721HTTPClientImpl) GetERC721Metadata(ctx context.Context, in *GetERC721MetadataRequest, opts ...http.CallOption) (*GetERC721MetadataResponse, error) {
+	var out GetERC721MetadataResponse
+	pattern := "/api/v1/erc721/metadata"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationERC721GetERC721Metadata))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetERC721Owner GetERC721Owner returns the current owner of the contract
+func (c *ERC721HTTPClientImpl) GetERC721Owner(ctx context.Context, in *GetERC721OwnerRequest, opts ...http.CallOption) (*GetERC721OwnerResponse, error) {
+	var out GetERC721OwnerResponse
+	pattern := "/api/v1/erc721/owner"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationERC721GetERC721Owner))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetERC721OwnerOf GetERC721OwnerOf returns the owner of a specific token
 func (c *ERC721HTTPClientImpl) GetERC721OwnerOf(ctx context.Context, in *GetERC721OwnerOfRequest, opts ...http.CallOption) (*GetERC721OwnerOfResponse, error) {
 	var out GetERC721OwnerOfResponse
@@ -496,6 +753,20 @@ func (c *ERC721HTTPClientImpl) GetERC721OwnerOf(ctx context.Context, in *GetERC7
 	return &out, nil
 }
 
+// GetERC721Paused GetERC721Paused returns whether the contract is paused
+func (c *ERC721HTTPClientImpl) GetERC721Paused(ctx context.Context, in *GetERC721PausedRequest, opts ...http.CallOption) (*GetERC721PausedResponse, error) {
+	var out GetERC721PausedResponse
+	pattern := "/api/v1/erc721/paused"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationERC721GetERC721Paused))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetERC721TokenInfo GetERC721TokenInfo returns ERC721 token information (name, symbol)
 func (c *ERC721HTTPClientImpl) GetERC721TokenInfo(ctx context.Context, in *GetERC721TokenInfoRequest, opts ...http.CallOption) (*GetERC721TokenInfoResponse, error) {
 	var out GetERC721TokenInfoResponse
@@ -538,6 +809,34 @@ func (c *ERC721HTTPClientImpl) IsApprovedForAllERC721(ctx context.Context, in *I
 	return &out, nil
 }
 
+// PauseERC721 PauseERC721 pauses all token transfers (owner only)
+func (c *ERC721HTTPClientImpl) PauseERC721(ctx context.Context, in *PauseERC721Request, opts ...http.CallOption) (*PauseERC721Response, error) {
+	var out PauseERC721Response
+	pattern := "/api/v1/erc721/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC721PauseERC721))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RenounceERC721Ownership RenounceERC721Ownership leaves the contract without an owner (owner only, irreversible)
+func (c *ERC721HTTPClientImpl) RenounceERC721Ownership(ctx context.Context, in *RenounceERC721OwnershipRequest, opts ...http.CallOption) (*RenounceERC721OwnershipResponse, error) {
+	var out RenounceERC721OwnershipResponse
+	pattern := "/api/v1/erc721/renounce-ownership"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC721RenounceERC721Ownership))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SafeMintERC721 SafeMintERC721 safely mints a new ERC721 token
 func (c *ERC721HTTPClientImpl) SafeMintERC721(ctx context.Context, in *SafeMintERC721Request, opts ...http.CallOption) (*SafeMintERC721Response, error) {
 	var out SafeMintERC721Response
@@ -607,3 +906,31 @@ func (c *ERC721HTTPClientImpl) TransferERC721(ctx context.Context, in *TransferE
 	}
 	return &out, nil
 }
+
+// TransferERC721Ownership TransferERC721Ownership transfers ownership of the contract to a new owner (owner only)
+func (c *ERC721HTTPClientImpl) TransferERC721Ownership(ctx context.Context, in *TransferERC721OwnershipRequest, opts ...http.CallOption) (*TransferERC721OwnershipResponse, error) {
+	var out TransferERC721OwnershipResponse
+	pattern := "/api/v1/erc721/transfer-ownership"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC721TransferERC721Ownership))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnpauseERC721 UnpauseERC721 resumes token transfers (owner only)
+func (c *ERC721HTTPClientImpl) UnpauseERC721(ctx context.Context, in *UnpauseERC721Request, opts ...http.CallOption) (*UnpauseERC721Response, error) {
+	var out UnpauseERC721Response
+	pattern := "/api/v1/erc721/unpause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationERC721UnpauseERC721))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -3,16 +3,19 @@ package main
 import (
 	"eth-contract-service/internal/conf"
 	"eth-contract-service/internal/server"
+	"eth-contract-service/internal/treasury"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, confJobs *conf.Jobs, logger log.Logger) (*kratos.App, func(), error) {
 	grpcServer := server.NewGRPCServer(confServer, logger)
 	httpServer := server.NewHTTPServer(confServer, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	jobServer := server.NewJobServer(confJobs, logger)
+	treasuryMonitor := treasury.NewMonitor(logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer, treasuryMonitor)
 	return app, nil, nil
 }
 
@@ -3,13 +3,18 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
 
 	"eth-contract-service/internal/conf"
 	"eth-contract-service/internal/global"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/reload"
+	"eth-contract-service/internal/treasury"
 	"eth-contract-service/provider/logger"
+	"eth-contract-service/provider/tracing"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -46,10 +51,12 @@ func init() {
 //   - logger: The logger instance for application logging
 //   - gs: The gRPC server instance
 //   - hs: The HTTP server instance
+//   - js: The asynchronous job worker server
+//   - tm: The treasury balance monitor
 //
 // Returns:
 //   - *kratos.App: A configured kratos application ready to run
-func newApp(logger log.Logger, gs *grpc.Server, hs *khttp.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *khttp.Server, js *job.Server, tm *treasury.Monitor) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -59,6 +66,8 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *khttp.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			js,
+			tm,
 		),
 	)
 }
@@ -69,6 +78,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *khttp.Server) *kratos.App {
 //
 // The function will panic if critical initialization steps fail:
 //   - Configuration loading fails
+//   - Configuration watch cannot be registered
 //   - Application wiring fails
 //   - Application startup fails
 func main() {
@@ -104,21 +114,37 @@ func main() {
 		os.Exit(1)
 	}
 
-	logger := logger.NewZapLogger(bc.Log)
+	zapLogger := logger.NewZapLogger(bc.Log)
 
 	// Add fixed fields to logger
-	// Note: trace.id and span.id are not added here as they are context-dependent
-	// and should be added via middleware during request processing
-	logger = logger.With(
+	zapLogger = zapLogger.With(
 		zap.String("service.id", id),
 		zap.String("service.name", Name),
 		zap.String("service.version", Version),
 	)
 
+	// trace.id and span.id are resolved from the context passed to log.Helper.WithContext
+	var logger log.Logger = log.With(zapLogger,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
+	)
+
 	// Initialize global variables
 	global.Init(&bc, logger)
+	defer func() {
+		// Flush the spans buffered for export
+		if err := tracing.Shutdown(context.Background()); err != nil {
+			log.NewHelper(logger).Errorf("Failed to flush traces: %v", err)
+		}
+	}()
+
+	// Apply changes to reloadable sections without a restart
+	if err := reload.Watch(c, &bc, logger); err != nil {
+		log.NewHelper(logger).Errorf("Failed to watch configuration: %v", err)
+		os.Exit(1)
+	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jobs, logger)
 	if err != nil {
 		log.NewHelper(logger).Errorf("Failed to wire application: %v", err)
 		os.Exit(1)
@@ -0,0 +1,97 @@
+// Package main provides a command that creates the encrypted HD wallet file loaded
+// through the hd_wallet configuration.
+//
+// Usage:
+//
+//	HD_WALLET_PASSWORD=... hdwallet -out ./keystore/hd.json -generate
+//	echo "<mnemonic words>" | HD_WALLET_PASSWORD=... hdwallet -out ./keystore/hd.json
+//	echo "<hex seed>" | HD_WALLET_PASSWORD=... hdwallet -out ./keystore/hd.json -type seed
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"eth-contract-service/provider/keystore"
+
+	ethKeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/tyler-smith/go-bip39"
+)
+
+var (
+	// flagOut is the path of the encrypted HD wallet file
+	flagOut string
+	// flagType is the secret type read from stdin: mnemonic or seed
+	flagType string
+	// flagGenerate generates a new 24-word mnemonic instead of reading one
+	flagGenerate bool
+	// flagLight uses the light scrypt parameters
+	flagLight bool
+)
+
+func init() {
+	flag.StringVar(&flagOut, "out", "./keystore/hd.json", "path of the encrypted HD wallet file")
+	flag.StringVar(&flagType, "type", keystore.HDSecretMnemonic, "secret read from stdin: mnemonic or seed")
+	flag.BoolVar(&flagGenerate, "generate", false, "generate a new 24-word mnemonic")
+	flag.BoolVar(&flagLight, "light", false, "use light scrypt parameters (for development only)")
+}
+
+func main() {
+	flag.Parse()
+
+	password := os.Getenv("HD_WALLET_PASSWORD")
+	if password == "" {
+		fail("HD_WALLET_PASSWORD must be set")
+	}
+	if _, err := os.Stat(flagOut); err == nil {
+		fail("%s already exists", flagOut)
+	}
+
+	var secret string
+	if flagGenerate {
+		if flagType != keystore.HDSecretMnemonic {
+			fail("-generate only supports -type mnemonic")
+		}
+		entropy, err := bip39.NewEntropy(256)
+		if err != nil {
+			fail("failed to generate entropy: %v", err)
+		}
+		secret, err = bip39.NewMnemonic(entropy)
+		if err != nil {
+			fail("failed to generate mnemonic: %v", err)
+		}
+	} else {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			fail("failed to read %s from stdin: %v", flagType, err)
+		}
+		secret = strings.TrimSpace(line)
+	}
+
+	scryptN, scryptP := ethKeystore.StandardScryptN, ethKeystore.StandardScryptP
+	if flagLight {
+		scryptN, scryptP = ethKeystore.LightScryptN, ethKeystore.LightScryptP
+	}
+	data, err := keystore.EncryptHDSecret(flagType, secret, password, scryptN, scryptP)
+	if err != nil {
+		fail("%v", err)
+	}
+	if err := os.WriteFile(flagOut, data, 0600); err != nil {
+		fail("failed to write %s: %v", flagOut, err)
+	}
+
+	if flagGenerate {
+		fmt.Println("mnemonic (write it down, it is not shown again):")
+		fmt.Println(secret)
+	}
+	fmt.Printf("HD wallet written to %s\n", flagOut)
+}
+
+// fail prints an error and exits
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,250 @@
+// Package batch provides persistence for bulk write operations.
+// A batch stores one item per row of the request together with its result, so that a
+// partially failed run can be resumed without resending the rows that already succeeded.
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/internal/txmanager"
+	"eth-contract-service/provider/db"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Status represents the state of a batch item
+type Status string
+
+const (
+	// StatusPending means the item has not been sent yet
+	StatusPending Status = "pending"
+	// StatusSubmitted means the transaction carrying the item was broadcast
+	StatusSubmitted Status = "submitted"
+	// StatusConfirmed means the transaction carrying the item was mined successfully
+	StatusConfirmed Status = "confirmed"
+	// StatusFailed means sending failed or the transaction reverted
+	StatusFailed Status = "failed"
+)
+
+// ErrNotFound is returned when a batch does not exist
+var ErrNotFound = errors.New("batch not found")
+
+// Batch is a persisted bulk write operation
+type Batch struct {
+	ID              string    `gorm:"primaryKey;size:36" json:"id"`
+	Operation       string    `gorm:"size:64;index" json:"operation"`
+	ContractAddress string    `gorm:"size:42" json:"contract_address"`
+	FromAddress     string    `gorm:"size:42" json:"from_address"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for batches
+func (Batch) TableName() string {
+	return "batches"
+}
+
+// Item is a single row of a batch
+type Item struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	BatchID   string    `gorm:"size:36;index" json:"batch_id"`
+	Seq       int       `json:"seq"`                     // position of the row in the request
+	Params    string    `gorm:"type:text" json:"params"` // JSON encoded row parameters
+	Status    Status    `gorm:"size:16" json:"status"`
+	TxHash    string    `gorm:"size:66" json:"tx_hash"`
+	Error     string    `gorm:"type:text" json:"error"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for batch items
+func (Item) TableName() string {
+	return "batch_items"
+}
+
+// Decode unmarshals the row parameters into v
+func (i *Item) Decode(v interface{}) error {
+	return json.Unmarshal([]byte(i.Params), v)
+}
+
+// Done reports whether the item needs no further sending.
+// Submitted items are resolved again by Refresh when the batch is resumed.
+func (i *Item) Done() bool {
+	return i.Status == StatusSubmitted || i.Status == StatusConfirmed
+}
+
+// Create persists a new batch with one pending item per row.
+//
+// Parameters:
+//   - ctx: Context for the database operation
+//   - operation: Name of the bulk operation, e.g. erc20_transfer
+//   - contractAddr: Target contract address
+//   - from: Sender address
+//   - rows: Row parameters, JSON encoded into the items
+//
+// Returns:
+//   - *Batch: The created batch
+//   - []*Item: The created items in row order
+//   - error: Error if the batch cannot be stored
+func Create[T any](ctx context.Context, operation string, contractAddr, from common.Address, rows []T) (*Batch, []*Item, error) {
+	b := &Batch{
+		ID:              uuid.NewString(),
+		Operation:       operation,
+		ContractAddress: contractAddr.Hex(),
+		FromAddress:     from.Hex(),
+	}
+	items := make([]*Item, 0, len(rows))
+	for i, row := range rows {
+		params, err := json.Marshal(row)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to encode row %d", i)
+		}
+		items = append(items, &Item{BatchID: b.ID, Seq: i, Params: string(params), Status: StatusPending})
+	}
+
+	err := db.Get().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(b).Error; err != nil {
+			return err
+		}
+		return tx.CreateInBatches(items, 500).Error
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create batch")
+	}
+	return b, items, nil
+}
+
+// Get loads a batch and its items in row order.
+//
+// Parameters:
+//   - ctx: Context for the database operation
+//   - id: Batch ID
+//
+// Returns:
+//   - *Batch: The batch
+//   - []*Item: The items in row order
+//   - error: ErrNotFound if the batch does not exist
+func Get(ctx context.Context, id string) (*Batch, []*Item, error) {
+	gdb := db.Get().WithContext(ctx)
+	var b Batch
+	if err := gdb.Where("id = ?", id).Limit(1).Find(&b).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get batch")
+	}
+	if b.ID == "" {
+		return nil, nil, ErrNotFound
+	}
+
+	var items []*Item
+	if err := gdb.Where("batch_id = ?", id).Order("seq ASC").Find(&items).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get batch items")
+	}
+	return &b, items, nil
+}
+
+// SaveItems persists the status of the given items.
+func SaveItems(ctx context.Context, items ...*Item) error {
+	return db.Get().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			if err := tx.Save(item).Error; err != nil {
+				return errors.Wrapf(err, "failed to save batch item %d", item.Seq)
+			}
+		}
+		return nil
+	})
+}
+
+// Refresh resolves the transactions of submitted items before a resume.
+// Mined items become confirmed. Items whose transaction reverted, was cancelled, was
+// replaced by an unrelated transaction of the same nonce or is no longer known to the
+// node become failed so that they are sent again. Items still pending are left untouched.
+func Refresh(ctx context.Context, items []*Item) error {
+	resolutions := make(map[string]*txmanager.Resolution)
+	var changed []*Item
+	for _, item := range items {
+		if item.Status != StatusSubmitted || item.TxHash == "" {
+			continue
+		}
+
+		res, ok := resolutions[item.TxHash]
+		if !ok {
+			var err error
+			res, err = txmanager.Resolve(ctx, common.HexToHash(item.TxHash))
+			if err != nil && err != appErrors.ErrTransactionNotFound {
+				return errors.Wrapf(err, "failed to resolve %s", item.TxHash)
+			}
+			resolutions[item.TxHash] = res
+		}
+
+		switch {
+		case res == nil:
+			item.Status = StatusFailed
+			item.Error = "transaction not found"
+		case res.Status == txmanager.StatusConfirmed:
+			item.Status = StatusConfirmed
+			item.TxHash = res.MinedHash.Hex()
+		case res.Status == txmanager.StatusFailed:
+			item.Status = StatusFailed
+			item.Error = "transaction reverted"
+		case res.Status == txmanager.StatusCancelled:
+			item.Status = StatusFailed
+			item.Error = "transaction cancelled"
+		case res.Status == txmanager.StatusDropped:
+			item.Status = StatusFailed
+			item.Error = "nonce was used by another transaction"
+		default:
+			continue
+		}
+		changed = append(changed, item)
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+	return SaveItems(ctx, changed...)
+}
+
+// Mark sets the status of the items, records the transaction hash or error and saves them.
+func Mark(ctx context.Context, items []*Item, tx *types.Transaction, sendErr error) error {
+	for _, item := range items {
+		if sendErr != nil {
+			item.Status = StatusFailed
+			item.Error = sendErr.Error()
+			continue
+		}
+		item.Status = StatusSubmitted
+		item.TxHash = tx.Hash().Hex()
+		item.Error = ""
+	}
+	return SaveItems(ctx, items...)
+}
+
+// Budget limits the transactions sent by one bulk request, so that a request stays
+// within the HTTP timeout. Items left over stay pending and are sent on resume.
+type Budget struct {
+	left int
+}
+
+// NewBudget returns a budget of batch.max_txs_per_request transactions.
+func NewBudget() *Budget {
+	return &Budget{left: settings.MaxTxsPerRequest}
+}
+
+// Take reserves one transaction and reports whether the budget allowed it.
+func (b *Budget) Take() bool {
+	if b.left <= 0 {
+		return false
+	}
+	b.left--
+	return true
+}
+
+// Exhausted reports whether no transaction can be sent anymore.
+func (b *Budget) Exhausted() bool {
+	return b.left <= 0
+}
@@ -0,0 +1,148 @@
+package batch
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/provider/db"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Settings holds the effective bulk operation settings
+type Settings struct {
+	// TokenIDStart is the first token ID handed out by a new sequence
+	TokenIDStart *big.Int
+	// MaxItemsPerTx limits the token IDs per ERC1155 mintBatch transaction
+	MaxItemsPerTx int
+	// BlockGasRatio is the maximum share of the block gas limit used by one transaction
+	BlockGasRatio float64
+	// MaxTxsPerRequest limits the transactions sent by one bulk request
+	MaxTxsPerRequest int
+}
+
+var (
+	// settings stores the effective bulk operation settings
+	settings = Settings{
+		TokenIDStart:     big.NewInt(1),
+		MaxItemsPerTx:    100,
+		BlockGasRatio:    0.5,
+		MaxTxsPerRequest: 50,
+	}
+	// initOnce ensures the batch tables are initialized only once
+	initOnce sync.Once
+)
+
+// Sequence is a persisted token ID counter per contract
+type Sequence struct {
+	ContractAddress string `gorm:"primaryKey;size:42" json:"contract_address"`
+	NextID          string `gorm:"size:78" json:"next_id"` // decimal uint256
+}
+
+// TableName returns the table name for token ID sequences
+func (Sequence) TableName() string {
+	return "token_id_sequences"
+}
+
+// Init initializes the batch tables and applies the bulk operation settings.
+//
+// Parameters:
+//   - ctx: Context for the initialization operation
+//   - cfg: Bulk operation configuration (optional)
+//   - logger: Logger instance for batch logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid or the migration fails
+func Init(ctx context.Context, cfg *conf.Batch, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if cfg != nil {
+			if cfg.TokenIdStart != "" {
+				start, ok := new(big.Int).SetString(cfg.TokenIdStart, 10)
+				if !ok || start.Sign() < 0 {
+					initErr = errors.Errorf("invalid batch.token_id_start: %s", cfg.TokenIdStart)
+					return
+				}
+				settings.TokenIDStart = start
+			}
+			if cfg.MaxItemsPerTx > 0 {
+				settings.MaxItemsPerTx = int(cfg.MaxItemsPerTx)
+			}
+			if cfg.BlockGasRatio > 0 && cfg.BlockGasRatio <= 1 {
+				settings.BlockGasRatio = cfg.BlockGasRatio
+			}
+			if cfg.MaxTxsPerRequest > 0 {
+				settings.MaxTxsPerRequest = int(cfg.MaxTxsPerRequest)
+			}
+		}
+
+		if err := db.Get().WithContext(ctx).AutoMigrate(&Batch{}, &Item{}, &Sequence{}); err != nil {
+			initErr = errors.Wrap(err, "failed to migrate batch tables")
+			return
+		}
+
+		log.NewHelper(logger).Infof("batch tables initialized: token_id_start=%s, max_items_per_tx=%d, max_txs_per_request=%d",
+			settings.TokenIDStart.String(), settings.MaxItemsPerTx, settings.MaxTxsPerRequest)
+	})
+
+	return initErr
+}
+
+// GetSettings returns the effective bulk operation settings.
+func GetSettings() Settings {
+	return settings
+}
+
+// NextTokenIDs reserves n consecutive token IDs from the sequence of the contract.
+// The sequence row is created if missing and locked for the duration of the
+// reservation, so concurrent requests never receive the same IDs.
+//
+// Parameters:
+//   - ctx: Context for the database operation
+//   - contractAddr: Contract the token IDs belong to
+//   - n: Number of token IDs to reserve
+//
+// Returns:
+//   - []*big.Int: The reserved token IDs in ascending order
+//   - error: Error if the sequence cannot be updated
+func NextTokenIDs(ctx context.Context, contractAddr common.Address, n int) ([]*big.Int, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	ids := make([]*big.Int, 0, n)
+	err := db.Get().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Create the row first: FOR UPDATE takes no lock on a row that does not exist yet
+		seq := Sequence{ContractAddress: contractAddr.Hex(), NextID: settings.TokenIDStart.String()}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&seq).Error; err != nil {
+			return err
+		}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("contract_address = ?", contractAddr.Hex()).Limit(1).Find(&seq).Error
+		if err != nil {
+			return err
+		}
+
+		next, ok := new(big.Int).SetString(seq.NextID, 10)
+		if !ok {
+			return errors.Errorf("corrupt token ID sequence for %s: %s", seq.ContractAddress, seq.NextID)
+		}
+
+		for i := 0; i < n; i++ {
+			ids = append(ids, new(big.Int).Set(next))
+			next.Add(next, big.NewInt(1))
+		}
+		seq.NextID = next.String()
+		return tx.Save(&seq).Error
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reserve token IDs")
+	}
+	return ids, nil
+}
@@ -28,7 +28,23 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Log           *Log                   `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
 	Ethereum      *Ethereum              `protobuf:"bytes,4,opt,name=ethereum,proto3" json:"ethereum,omitempty"`
-	Admin         *Admin                 `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"` // Admin configuration
+	Admin         *Admin                 `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`                        // Admin configuration
+	Jobs          *Jobs                  `protobuf:"bytes,6,opt,name=jobs,proto3" json:"jobs,omitempty"`                          // Asynchronous job queue configuration
+	Transactions  *Transactions          `protobuf:"bytes,7,opt,name=transactions,proto3" json:"transactions,omitempty"`          // Pending transaction replacement configuration
+	Batch         *Batch                 `protobuf:"bytes,8,opt,name=batch,proto3" json:"batch,omitempty"`                        // Bulk minting and transfer configuration
+	Metadata      *Metadata              `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`                  // NFT metadata resolution configuration
+	Signing       *Signing               `protobuf:"bytes,10,opt,name=signing,proto3" json:"signing,omitempty"`                   // Off-chain message signing policy
+	Relayer       *Relayer               `protobuf:"bytes,11,opt,name=relayer,proto3" json:"relayer,omitempty"`                   // ERC-2771 meta-transaction relayer configuration
+	Safe          *Safe                  `protobuf:"bytes,12,opt,name=safe,proto3" json:"safe,omitempty"`                         // Safe multisig proposal configuration
+	HdWallet      *HDWallet              `protobuf:"bytes,13,opt,name=hd_wallet,json=hdWallet,proto3" json:"hd_wallet,omitempty"` // BIP-32/39/44 hierarchical deterministic wallet
+	Signers       []*Signer              `protobuf:"bytes,14,rep,name=signers,proto3" json:"signers,omitempty"`                   // Named signers referenced by signer fields
+	Keys          *Keys                  `protobuf:"bytes,15,opt,name=keys,proto3" json:"keys,omitempty"`                         // Managed keystore directory
+	Metrics       *Metrics               `protobuf:"bytes,16,opt,name=metrics,proto3" json:"metrics,omitempty"`                   // Prometheus metrics endpoint
+	Tracing       *Tracing               `protobuf:"bytes,17,opt,name=tracing,proto3" json:"tracing,omitempty"`                   // OpenTelemetry tracing export
+	Health        *Health                `protobuf:"bytes,18,opt,name=health,proto3" json:"health,omitempty"`                     // Readiness check thresholds
+	Treasury      *Treasury              `protobuf:"bytes,19,opt,name=treasury,proto3" json:"treasury,omitempty"`                 // Signer balance monitoring and gas top-ups
+	Deploy        *Deploy                `protobuf:"bytes,20,opt,name=deploy,proto3" json:"deploy,omitempty"`                     // Contract deployments from registered artifacts
+	Portfolio     *Portfolio             `protobuf:"bytes,21,opt,name=portfolio,proto3" json:"portfolio,omitempty"`               // Token holdings lookup
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +114,118 @@ func (x *Bootstrap) GetAdmin() *Admin {
 	return nil
 }
 
+func (x *Bootstrap) GetJobs() *Jobs {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTransactions() *Transactions {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSigning() *Signing {
+	if x != nil {
+		return x.Signing
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRelayer() *Relayer {
+	if x != nil {
+		return x.Relayer
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSafe() *Safe {
+	if x != nil {
+		return x.Safe
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetHdWallet() *HDWallet {
+	if x != nil {
+		return x.HdWallet
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSigners() []*Signer {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetKeys() *Keys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTracing() *Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetHealth() *Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTreasury() *Treasury {
+	if x != nil {
+		return x.Treasury
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetDeploy() *Deploy {
+	if x != nil {
+		return x.Deploy
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetPortfolio() *Portfolio {
+	if x != nil {
+		return x.Portfolio
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -106,21 +234,1158 @@ type Server struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
-	mi := &file_conf_proto_msgTypes[1]
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+type Log struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Log level: debug(-1), info(0), warn(1), error(2), dpanic(3), panic(4),
+	// fatal(5)
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// Log format: json or console
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	mi := &file_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Log) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Log) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type Ethereum struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RpcUrl               string                 `protobuf:"bytes,1,opt,name=rpc_url,json=rpcUrl,proto3" json:"rpc_url,omitempty"`                                                                   // Ethereum RPC endpoint URL
+	ChainId              int64                  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                                                               // Chain ID (1 for mainnet, 5 for goerli, etc.)
+	Timeout              *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                               // Request timeout
+	MaxRetries           int32                  `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`                                                      // Maximum retry attempts for failed requests
+	Contracts            map[string]string      `protobuf:"bytes,5,rep,name=contracts,proto3" json:"contracts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Contract addresses map, e.g., erc20: 0xXXXXX
+	ReconnectInterval    *durationpb.Duration   `protobuf:"bytes,6,opt,name=reconnect_interval,json=reconnectInterval,proto3" json:"reconnect_interval,omitempty"`                                  // Delay before the first reconnection attempt, doubled after each failure (default: 1s)
+	MaxReconnectInterval *durationpb.Duration   `protobuf:"bytes,7,opt,name=max_reconnect_interval,json=maxReconnectInterval,proto3" json:"max_reconnect_interval,omitempty"`                       // Upper bound of the reconnection delay (default: 30s)
+	HeartbeatInterval    *durationpb.Duration   `protobuf:"bytes,8,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`                                  // How often a connected client verifies the node and its chain ID (default: 15s)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Ethereum) Reset() {
+	*x = Ethereum{}
+	mi := &file_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ethereum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ethereum) ProtoMessage() {}
+
+func (x *Ethereum) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ethereum.ProtoReflect.Descriptor instead.
+func (*Ethereum) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Ethereum) GetRpcUrl() string {
+	if x != nil {
+		return x.RpcUrl
+	}
+	return ""
+}
+
+func (x *Ethereum) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *Ethereum) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Ethereum) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *Ethereum) GetContracts() map[string]string {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+func (x *Ethereum) GetReconnectInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReconnectInterval
+	}
+	return nil
+}
+
+func (x *Ethereum) GetMaxReconnectInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MaxReconnectInterval
+	}
+	return nil
+}
+
+func (x *Ethereum) GetHeartbeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return nil
+}
+
+type Admin struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	KeystorePath     string                 `protobuf:"bytes,1,opt,name=keystore_path,json=keystorePath,proto3" json:"keystore_path,omitempty"`             // Path to keystore v3 file
+	KeystorePassword string                 `protobuf:"bytes,2,opt,name=keystore_password,json=keystorePassword,proto3" json:"keystore_password,omitempty"` // Password for keystore file
+	Address          string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                                           // Admin address (optional, will be derived from keystore if not provided)
+	// if not provided)
+	AllowUseAdmin bool     `protobuf:"varint,4,opt,name=allow_use_admin,json=allowUseAdmin,proto3" json:"allow_use_admin,omitempty"` // Allow requests to sign with the admin signer through use_admin (default: false)
+	ApiTokens     []string `protobuf:"bytes,5,rep,name=api_tokens,json=apiTokens,proto3" json:"api_tokens,omitempty"`                // Bearer tokens that authorize use_admin requests and admin endpoints (required with allow_use_admin)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin) Reset() {
+	*x = Admin{}
+	mi := &file_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin) ProtoMessage() {}
+
+func (x *Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
+func (*Admin) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Admin) GetKeystorePath() string {
+	if x != nil {
+		return x.KeystorePath
+	}
+	return ""
+}
+
+func (x *Admin) GetKeystorePassword() string {
+	if x != nil {
+		return x.KeystorePassword
+	}
+	return ""
+}
+
+func (x *Admin) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Admin) GetAllowUseAdmin() bool {
+	if x != nil {
+		return x.AllowUseAdmin
+	}
+	return false
+}
+
+func (x *Admin) GetApiTokens() []string {
+	if x != nil {
+		return x.ApiTokens
+	}
+	return nil
+}
+
+type Jobs struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Enabled             bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                   // Enable asynchronous job processing for write operations
+	Backend             string                 `protobuf:"bytes,2,opt,name=backend,proto3" json:"backend,omitempty"`                                                    // Job store backend: db (default) or redis
+	Workers             int32                  `protobuf:"varint,3,opt,name=workers,proto3" json:"workers,omitempty"`                                                   // Number of worker goroutines (default: 4)
+	PollInterval        *durationpb.Duration   `protobuf:"bytes,4,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`                      // Queue polling interval (default: 1s)
+	RebroadcastInterval *durationpb.Duration   `protobuf:"bytes,5,opt,name=rebroadcast_interval,json=rebroadcastInterval,proto3" json:"rebroadcast_interval,omitempty"` // Re-send a pending transaction after this interval (default: 1m)
+	ReceiptTimeout      *durationpb.Duration   `protobuf:"bytes,6,opt,name=receipt_timeout,json=receiptTimeout,proto3" json:"receipt_timeout,omitempty"`                // Flag a submitted job as overdue without a receipt after this duration (default: 30m)
+	MaxAttempts         int32                  `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                        // Maximum attempts before a job is failed (default: 3)
+	EncryptionKey       string                 `protobuf:"bytes,8,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`                   // Hex encoded 32-byte AES key used to seal job payloads at rest
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Jobs) Reset() {
+	*x = Jobs{}
+	mi := &file_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Jobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jobs) ProtoMessage() {}
+
+func (x *Jobs) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jobs.ProtoReflect.Descriptor instead.
+func (*Jobs) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Jobs) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Jobs) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *Jobs) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Jobs) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Jobs) GetRebroadcastInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RebroadcastInterval
+	}
+	return nil
+}
+
+func (x *Jobs) GetReceiptTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReceiptTimeout
+	}
+	return nil
+}
+
+func (x *Jobs) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Jobs) GetEncryptionKey() string {
+	if x != nil {
+		return x.EncryptionKey
+	}
+	return ""
+}
+
+type Transactions struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FeeBumpPercent       uint32                 `protobuf:"varint,1,opt,name=fee_bump_percent,json=feeBumpPercent,proto3" json:"fee_bump_percent,omitempty"`                      // Fee increase for replacements in percent (default: 10, minimum: 10)
+	MaxFeePerGas         string                 `protobuf:"bytes,2,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`                           // Upper bound for gas price / max fee per gas in wei (optional)
+	MaxPriorityFeePerGas string                 `protobuf:"bytes,3,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"` // Upper bound for max priority fee per gas in wei (optional)
+	AutoBump             bool                   `protobuf:"varint,4,opt,name=auto_bump,json=autoBump,proto3" json:"auto_bump,omitempty"`                                          // Automatically speed up pending asynchronous job transactions
+	BumpAfter            *durationpb.Duration   `protobuf:"bytes,5,opt,name=bump_after,json=bumpAfter,proto3" json:"bump_after,omitempty"`                                        // Wait this long for a receipt before bumping fees (default: 3m)
+	MaxAutoBumps         int32                  `protobuf:"varint,6,opt,name=max_auto_bumps,json=maxAutoBumps,proto3" json:"max_auto_bumps,omitempty"`                            // Maximum automatic bumps per transaction (default: 5)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Transactions) Reset() {
+	*x = Transactions{}
+	mi := &file_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transactions) ProtoMessage() {}
+
+func (x *Transactions) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transactions.ProtoReflect.Descriptor instead.
+func (*Transactions) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Transactions) GetFeeBumpPercent() uint32 {
+	if x != nil {
+		return x.FeeBumpPercent
+	}
+	return 0
+}
+
+func (x *Transactions) GetMaxFeePerGas() string {
+	if x != nil {
+		return x.MaxFeePerGas
+	}
+	return ""
+}
+
+func (x *Transactions) GetMaxPriorityFeePerGas() string {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (x *Transactions) GetAutoBump() bool {
+	if x != nil {
+		return x.AutoBump
+	}
+	return false
+}
+
+func (x *Transactions) GetBumpAfter() *durationpb.Duration {
+	if x != nil {
+		return x.BumpAfter
+	}
+	return nil
+}
+
+func (x *Transactions) GetMaxAutoBumps() int32 {
+	if x != nil {
+		return x.MaxAutoBumps
+	}
+	return 0
+}
+
+type Batch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TokenIdStart     string                 `protobuf:"bytes,1,opt,name=token_id_start,json=tokenIdStart,proto3" json:"token_id_start,omitempty"`                // First token ID handed out by a new token ID sequence (default: 1)
+	MaxItemsPerTx    int32                  `protobuf:"varint,2,opt,name=max_items_per_tx,json=maxItemsPerTx,proto3" json:"max_items_per_tx,omitempty"`          // Maximum token IDs per ERC1155 mintBatch transaction (default: 100)
+	BlockGasRatio    float64                `protobuf:"fixed64,3,opt,name=block_gas_ratio,json=blockGasRatio,proto3" json:"block_gas_ratio,omitempty"`           // Maximum share of the block gas limit used by one transaction (default: 0.5)
+	MaxTxsPerRequest int32                  `protobuf:"varint,4,opt,name=max_txs_per_request,json=maxTxsPerRequest,proto3" json:"max_txs_per_request,omitempty"` // Maximum transactions sent by one bulk request, the rest is resumed by batch_id (default: 50)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	mi := &file_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Batch) GetTokenIdStart() string {
+	if x != nil {
+		return x.TokenIdStart
+	}
+	return ""
+}
+
+func (x *Batch) GetMaxItemsPerTx() int32 {
+	if x != nil {
+		return x.MaxItemsPerTx
+	}
+	return 0
+}
+
+func (x *Batch) GetBlockGasRatio() float64 {
+	if x != nil {
+		return x.BlockGasRatio
+	}
+	return 0
+}
+
+func (x *Batch) GetMaxTxsPerRequest() int32 {
+	if x != nil {
+		return x.MaxTxsPerRequest
+	}
+	return 0
+}
+
+type Metadata struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IpfsGateway    string                 `protobuf:"bytes,1,opt,name=ipfs_gateway,json=ipfsGateway,proto3" json:"ipfs_gateway,omitempty"`          // Gateway used for ipfs:// URIs (default: https://ipfs.io/ipfs/)
+	ArweaveGateway string                 `protobuf:"bytes,2,opt,name=arweave_gateway,json=arweaveGateway,proto3" json:"arweave_gateway,omitempty"` // Gateway used for ar:// URIs (default: https://arweave.net/)
+	Timeout        *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                                     // Fetch timeout (default: 10s)
+	CacheTtl       *durationpb.Duration   `protobuf:"bytes,4,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"`                   // How long resolved metadata is cached (default: 1h)
+	MaxSize        int64                  `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`                     // Maximum metadata document size in bytes (default: 1048576)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	mi := &file_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata) ProtoMessage() {}
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Metadata) GetIpfsGateway() string {
+	if x != nil {
+		return x.IpfsGateway
+	}
+	return ""
+}
+
+func (x *Metadata) GetArweaveGateway() string {
+	if x != nil {
+		return x.ArweaveGateway
+	}
+	return ""
+}
+
+func (x *Metadata) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Metadata) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+func (x *Metadata) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+type Signing struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	AllowedDomains            []string               `protobuf:"bytes,1,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`                                    // EIP-712 domain names that may be signed (empty allows any name)
+	AllowedVerifyingContracts []string               `protobuf:"bytes,2,rep,name=allowed_verifying_contracts,json=allowedVerifyingContracts,proto3" json:"allowed_verifying_contracts,omitempty"` // EIP-712 verifying contracts that may be signed (empty allows any contract)
+	AllowPersonalMessages     bool                   `protobuf:"varint,3,opt,name=allow_personal_messages,json=allowPersonalMessages,proto3" json:"allow_personal_messages,omitempty"`            // Allow EIP-191 personal message signing
+	AllowedChainIds           []uint64               `protobuf:"varint,4,rep,packed,name=allowed_chain_ids,json=allowedChainIds,proto3" json:"allowed_chain_ids,omitempty"`                       // EIP-712 domain chain IDs that may be signed besides ethereum.chain_id
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Signing) Reset() {
+	*x = Signing{}
+	mi := &file_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signing) ProtoMessage() {}
+
+func (x *Signing) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signing.ProtoReflect.Descriptor instead.
+func (*Signing) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Signing) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *Signing) GetAllowedVerifyingContracts() []string {
+	if x != nil {
+		return x.AllowedVerifyingContracts
+	}
+	return nil
+}
+
+func (x *Signing) GetAllowPersonalMessages() bool {
+	if x != nil {
+		return x.AllowPersonalMessages
+	}
+	return false
+}
+
+func (x *Signing) GetAllowedChainIds() []uint64 {
+	if x != nil {
+		return x.AllowedChainIds
+	}
+	return nil
+}
+
+type Relayer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Forwarder      string                 `protobuf:"bytes,1,opt,name=forwarder,proto3" json:"forwarder,omitempty"`                                    // MinimalForwarder contract address (empty disables the relayer)
+	DomainName     string                 `protobuf:"bytes,2,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`                // EIP-712 domain name of the forwarder (default: MinimalForwarder)
+	DomainVersion  string                 `protobuf:"bytes,3,opt,name=domain_version,json=domainVersion,proto3" json:"domain_version,omitempty"`       // EIP-712 domain version of the forwarder (default: 0.0.1)
+	Signer         string                 `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`                                          // Keystore signer that pays for relayed transactions (default: admin)
+	DailyQuota     int32                  `protobuf:"varint,5,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`               // Relayed requests per sender and UTC day (0 means unlimited)
+	MaxGas         uint64                 `protobuf:"varint,6,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`                           // Maximum gas of a forward request (default: 1000000)
+	AllowedTargets []string               `protobuf:"bytes,7,rep,name=allowed_targets,json=allowedTargets,proto3" json:"allowed_targets,omitempty"`    // Contracts that may be called through the relayer (empty allows none)
+	AllowAnyTarget bool                   `protobuf:"varint,8,opt,name=allow_any_target,json=allowAnyTarget,proto3" json:"allow_any_target,omitempty"` // Allow calls to any contract, ignoring allowed_targets
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Relayer) Reset() {
+	*x = Relayer{}
+	mi := &file_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relayer) ProtoMessage() {}
+
+func (x *Relayer) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relayer.ProtoReflect.Descriptor instead.
+func (*Relayer) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Relayer) GetForwarder() string {
+	if x != nil {
+		return x.Forwarder
+	}
+	return ""
+}
+
+func (x *Relayer) GetDomainName() string {
+	if x != nil {
+		return x.DomainName
+	}
+	return ""
+}
+
+func (x *Relayer) GetDomainVersion() string {
+	if x != nil {
+		return x.DomainVersion
+	}
+	return ""
+}
+
+func (x *Relayer) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *Relayer) GetDailyQuota() int32 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+func (x *Relayer) GetMaxGas() uint64 {
+	if x != nil {
+		return x.MaxGas
+	}
+	return 0
+}
+
+func (x *Relayer) GetAllowedTargets() []string {
+	if x != nil {
+		return x.AllowedTargets
+	}
+	return nil
+}
+
+func (x *Relayer) GetAllowAnyTarget() bool {
+	if x != nil {
+		return x.AllowAnyTarget
+	}
+	return false
+}
+
+type Safe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executor      string                 `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`                           // Keystore signer that sends execTransaction (default: admin)
+	AutoExecute   bool                   `protobuf:"varint,2,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"` // Execute a proposal as soon as the owner threshold is met
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Safe) Reset() {
+	*x = Safe{}
+	mi := &file_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Safe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Safe) ProtoMessage() {}
+
+func (x *Safe) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Safe.ProtoReflect.Descriptor instead.
+func (*Safe) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Safe) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *Safe) GetAutoExecute() bool {
+	if x != nil {
+		return x.AutoExecute
+	}
+	return false
+}
+
+type HDWallet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                         // Path to the encrypted mnemonic or seed file (empty disables the HD wallet)
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                 // Password of the encrypted file
+	Passphrase    string                 `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`             // BIP-39 passphrase applied to the mnemonic (optional)
+	BasePath      string                 `protobuf:"bytes,4,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty"` // Derivation path of the account chain (default: m/44'/60'/0'/0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HDWallet) Reset() {
+	*x = HDWallet{}
+	mi := &file_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HDWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HDWallet) ProtoMessage() {}
+
+func (x *HDWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HDWallet.ProtoReflect.Descriptor instead.
+func (*HDWallet) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HDWallet) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HDWallet) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *HDWallet) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *HDWallet) GetBasePath() string {
+	if x != nil {
+		return x.BasePath
+	}
+	return ""
+}
+
+type Signer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Name referenced by signer fields (a signer named admin replaces the admin keystore)
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                     // keystore, remote, mock or a registered HSM backend such as pkcs11
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                               // Account address (required for remote signers, checked for the others)
+	KeystorePath  string                 `protobuf:"bytes,4,opt,name=keystore_path,json=keystorePath,proto3" json:"keystore_path,omitempty"` // keystore: path to keystore v3 file
+	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`                             // keystore: keystore password; HSM: PIN of the token
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`                                       // remote: JSON-RPC endpoint of the signing service
+	Api           string                 `protobuf:"bytes,7,opt,name=api,proto3" json:"api,omitempty"`                                       // remote: web3signer (eth_* methods, default) or clef (account_* methods)
+	Timeout       *durationpb.Duration   `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`                               // remote: request timeout (default: 10s)
+	Module        string                 `protobuf:"bytes,9,opt,name=module,proto3" json:"module,omitempty"`                                 // HSM: path of the PKCS#11 module
+	TokenLabel    string                 `protobuf:"bytes,10,opt,name=token_label,json=tokenLabel,proto3" json:"token_label,omitempty"`      // HSM: label of the token holding the key
+	KeyLabel      string                 `protobuf:"bytes,11,opt,name=key_label,json=keyLabel,proto3" json:"key_label,omitempty"`            // HSM: label of the key object
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signer) Reset() {
+	*x = Signer{}
+	mi := &file_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signer) ProtoMessage() {}
+
+func (x *Signer) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signer.ProtoReflect.Descriptor instead.
+func (*Signer) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Signer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Signer) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Signer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Signer) GetKeystorePath() string {
+	if x != nil {
+		return x.KeystorePath
+	}
+	return ""
+}
+
+func (x *Signer) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Signer) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Signer) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *Signer) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Signer) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *Signer) GetTokenLabel() string {
+	if x != nil {
+		return x.TokenLabel
+	}
+	return ""
+}
+
+func (x *Signer) GetKeyLabel() string {
+	if x != nil {
+		return x.KeyLabel
+	}
+	return ""
+}
+
+type Keys struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dir           string                 `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`                         // Keystore directory of managed keys (empty disables key management)
+	ScryptN       int32                  `protobuf:"varint,2,opt,name=scrypt_n,json=scryptN,proto3" json:"scrypt_n,omitempty"` // scrypt N used to encrypt new and re-encrypted keys (default: 262144)
+	ScryptP       int32                  `protobuf:"varint,3,opt,name=scrypt_p,json=scryptP,proto3" json:"scrypt_p,omitempty"` // scrypt P used to encrypt new and re-encrypted keys (default: 1)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Keys) Reset() {
+	*x = Keys{}
+	mi := &file_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Keys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keys) ProtoMessage() {}
+
+func (x *Keys) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keys.ProtoReflect.Descriptor instead.
+func (*Keys) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Keys) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *Keys) GetScryptN() int32 {
+	if x != nil {
+		return x.ScryptN
+	}
+	return 0
+}
+
+func (x *Keys) GetScryptP() int32 {
+	if x != nil {
+		return x.ScryptP
+	}
+	return 0
+}
+
+type Metrics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Enabled             bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                     // Serve Prometheus metrics on the HTTP server
+	Path                string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                                            // Metrics endpoint path (default: /metrics)
+	ReceiptPollInterval *durationpb.Duration   `protobuf:"bytes,3,opt,name=receipt_poll_interval,json=receiptPollInterval,proto3" json:"receipt_poll_interval,omitempty"` // How often receipts of sent transactions are checked (default: 15s)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	mi := &file_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *Metrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*Metrics) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[1]
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -131,48 +1396,58 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Server) GetHttp() *Server_HTTP {
+func (x *Metrics) GetEnabled() bool {
 	if x != nil {
-		return x.Http
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *Server) GetGrpc() *Server_GRPC {
+func (x *Metrics) GetPath() string {
 	if x != nil {
-		return x.Grpc
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Metrics) GetReceiptPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReceiptPollInterval
 	}
 	return nil
 }
 
-type Data struct {
+type Tracing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                             // Export spans to an OTLP collector
+	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                            // Collector address, e.g. localhost:4317 (default: the OTLP exporter default)
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`                            // grpc or http (default: grpc)
+	Insecure      bool                   `protobuf:"varint,4,opt,name=insecure,proto3" json:"insecure,omitempty"`                           // Connect to the collector without TLS
+	SampleRatio   float64                `protobuf:"fixed64,5,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"` // Fraction of new traces to record (default: 1)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data) Reset() {
-	*x = Data{}
-	mi := &file_conf_proto_msgTypes[2]
+func (x *Tracing) Reset() {
+	*x = Tracing{}
+	mi := &file_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data) String() string {
+func (x *Tracing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data) ProtoMessage() {}
+func (*Tracing) ProtoMessage() {}
 
-func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[2]
+func (x *Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -183,51 +1458,70 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data.ProtoReflect.Descriptor instead.
-func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
+func (*Tracing) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Data) GetDatabase() *Data_Database {
+func (x *Tracing) GetEnabled() bool {
 	if x != nil {
-		return x.Database
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *Data) GetRedis() *Data_Redis {
+func (x *Tracing) GetEndpoint() string {
 	if x != nil {
-		return x.Redis
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-type Log struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Log level: debug(-1), info(0), warn(1), error(2), dpanic(3), panic(4),
-	// fatal(5)
-	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
-	// Log format: json or console
-	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Tracing) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
 }
 
-func (x *Log) Reset() {
-	*x = Log{}
-	mi := &file_conf_proto_msgTypes[3]
+func (x *Tracing) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Tracing) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
+type Health struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Timeout          *durationpb.Duration   `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`                                             // Timeout of each readiness check (default: 5s)
+	MaxBlockAge      *durationpb.Duration   `protobuf:"bytes,2,opt,name=max_block_age,json=maxBlockAge,proto3" json:"max_block_age,omitempty"`                // Age of the latest block above which the node is lagging (default: 2m)
+	MinSignerBalance string                 `protobuf:"bytes,3,opt,name=min_signer_balance,json=minSignerBalance,proto3" json:"min_signer_balance,omitempty"` // Signer balance in wei below which signers are degraded (optional)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Health) Reset() {
+	*x = Health{}
+	mi := &file_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Log) String() string {
+func (x *Health) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Log) ProtoMessage() {}
+func (*Health) ProtoMessage() {}
 
-func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[3]
+func (x *Health) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,51 +1532,60 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Log.ProtoReflect.Descriptor instead.
-func (*Log) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Health.ProtoReflect.Descriptor instead.
+func (*Health) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Log) GetLevel() int32 {
+func (x *Health) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.Level
+		return x.Timeout
 	}
-	return 0
+	return nil
 }
 
-func (x *Log) GetFormat() string {
+func (x *Health) GetMaxBlockAge() *durationpb.Duration {
 	if x != nil {
-		return x.Format
+		return x.MaxBlockAge
+	}
+	return nil
+}
+
+func (x *Health) GetMinSignerBalance() string {
+	if x != nil {
+		return x.MinSignerBalance
 	}
 	return ""
 }
 
-type Ethereum struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RpcUrl        string                 `protobuf:"bytes,1,opt,name=rpc_url,json=rpcUrl,proto3" json:"rpc_url,omitempty"`                                                                   // Ethereum RPC endpoint URL
-	ChainId       int64                  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                                                               // Chain ID (1 for mainnet, 5 for goerli, etc.)
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                               // Request timeout
-	MaxRetries    int32                  `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`                                                      // Maximum retry attempts for failed requests
-	Contracts     map[string]string      `protobuf:"bytes,5,rep,name=contracts,proto3" json:"contracts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Contract addresses map, e.g., erc20: 0xXXXXX
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Treasury struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                         // Periodically check the native balances of the signers
+	CheckInterval   *durationpb.Duration   `protobuf:"bytes,2,opt,name=check_interval,json=checkInterval,proto3" json:"check_interval,omitempty"`         // How often balances are checked (default: 1m)
+	MinBalance      string                 `protobuf:"bytes,3,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`                  // Alert threshold in wei of signers without their own threshold (optional)
+	Thresholds      []*Treasury_Threshold  `protobuf:"bytes,4,rep,name=thresholds,proto3" json:"thresholds,omitempty"`                                    // Per-signer thresholds; listed signers are monitored besides the configured signers
+	FundingSigner   string                 `protobuf:"bytes,5,opt,name=funding_signer,json=fundingSigner,proto3" json:"funding_signer,omitempty"`         // Signer that pays top-ups (optional, top-ups are disabled when empty)
+	DailyTopUpCap   string                 `protobuf:"bytes,6,opt,name=daily_top_up_cap,json=dailyTopUpCap,proto3" json:"daily_top_up_cap,omitempty"`     // Total wei the funding signer sends per UTC day (required for top-ups)
+	AlertWebhookUrl string                 `protobuf:"bytes,7,opt,name=alert_webhook_url,json=alertWebhookUrl,proto3" json:"alert_webhook_url,omitempty"` // URL receiving alerts as JSON POST requests (optional)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Ethereum) Reset() {
-	*x = Ethereum{}
-	mi := &file_conf_proto_msgTypes[4]
+func (x *Treasury) Reset() {
+	*x = Treasury{}
+	mi := &file_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ethereum) String() string {
+func (x *Treasury) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ethereum) ProtoMessage() {}
+func (*Treasury) ProtoMessage() {}
 
-func (x *Ethereum) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[4]
+func (x *Treasury) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,70 +1596,83 @@ func (x *Ethereum) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ethereum.ProtoReflect.Descriptor instead.
-func (*Ethereum) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Treasury.ProtoReflect.Descriptor instead.
+func (*Treasury) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Ethereum) GetRpcUrl() string {
+func (x *Treasury) GetEnabled() bool {
 	if x != nil {
-		return x.RpcUrl
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Ethereum) GetChainId() int64 {
+func (x *Treasury) GetCheckInterval() *durationpb.Duration {
 	if x != nil {
-		return x.ChainId
+		return x.CheckInterval
 	}
-	return 0
+	return nil
 }
 
-func (x *Ethereum) GetTimeout() *durationpb.Duration {
+func (x *Treasury) GetMinBalance() string {
 	if x != nil {
-		return x.Timeout
+		return x.MinBalance
+	}
+	return ""
+}
+
+func (x *Treasury) GetThresholds() []*Treasury_Threshold {
+	if x != nil {
+		return x.Thresholds
 	}
 	return nil
 }
 
-func (x *Ethereum) GetMaxRetries() int32 {
+func (x *Treasury) GetFundingSigner() string {
 	if x != nil {
-		return x.MaxRetries
+		return x.FundingSigner
 	}
-	return 0
+	return ""
 }
 
-func (x *Ethereum) GetContracts() map[string]string {
+func (x *Treasury) GetDailyTopUpCap() string {
 	if x != nil {
-		return x.Contracts
+		return x.DailyTopUpCap
 	}
-	return nil
+	return ""
 }
 
-type Admin struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	KeystorePath     string                 `protobuf:"bytes,1,opt,name=keystore_path,json=keystorePath,proto3" json:"keystore_path,omitempty"`             // Path to keystore v3 file
-	KeystorePassword string                 `protobuf:"bytes,2,opt,name=keystore_password,json=keystorePassword,proto3" json:"keystore_password,omitempty"` // Password for keystore file
-	Address          string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                                           // Admin address (optional, will be derived from keystore if not provided)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Treasury) GetAlertWebhookUrl() string {
+	if x != nil {
+		return x.AlertWebhookUrl
+	}
+	return ""
 }
 
-func (x *Admin) Reset() {
-	*x = Admin{}
-	mi := &file_conf_proto_msgTypes[5]
+type Deploy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Create2Factory string                 `protobuf:"bytes,1,opt,name=create2_factory,json=create2Factory,proto3" json:"create2_factory,omitempty"` // CREATE2 factory taking the salt followed by the init code as calldata (default: 0x4e59b44847b379578588920ca78fbf26c0b4956c)
+	WaitTimeout    *durationpb.Duration   `protobuf:"bytes,2,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`          // How long DeployContract waits for the receipt before returning a pending deployment (default: 20s)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Deploy) Reset() {
+	*x = Deploy{}
+	mi := &file_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Admin) String() string {
+func (x *Deploy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Admin) ProtoMessage() {}
+func (*Deploy) ProtoMessage() {}
 
-func (x *Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[5]
+func (x *Deploy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,30 +1683,83 @@ func (x *Admin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
-func (*Admin) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Deploy.ProtoReflect.Descriptor instead.
+func (*Deploy) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Admin) GetKeystorePath() string {
+func (x *Deploy) GetCreate2Factory() string {
 	if x != nil {
-		return x.KeystorePath
+		return x.Create2Factory
 	}
 	return ""
 }
 
-func (x *Admin) GetKeystorePassword() string {
+func (x *Deploy) GetWaitTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.KeystorePassword
+		return x.WaitTimeout
 	}
-	return ""
+	return nil
 }
 
-func (x *Admin) GetAddress() string {
+type Portfolio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromBlock     uint64                 `protobuf:"varint,1,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`                                                                                 // First block scanned for received token IDs (default: 0)
+	StartBlocks   map[string]uint64      `protobuf:"bytes,2,rep,name=start_blocks,json=startBlocks,proto3" json:"start_blocks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Deployment block per contract address or ethereum.contracts name, overrides from_block
+	LogRange      uint64                 `protobuf:"varint,3,opt,name=log_range,json=logRange,proto3" json:"log_range,omitempty"`                                                                                    // Blocks per eth_getLogs request (default: 10000)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Portfolio) Reset() {
+	*x = Portfolio{}
+	mi := &file_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Portfolio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Portfolio) ProtoMessage() {}
+
+func (x *Portfolio) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[21]
 	if x != nil {
-		return x.Address
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Portfolio.ProtoReflect.Descriptor instead.
+func (*Portfolio) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Portfolio) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *Portfolio) GetStartBlocks() map[string]uint64 {
+	if x != nil {
+		return x.StartBlocks
+	}
+	return nil
+}
+
+func (x *Portfolio) GetLogRange() uint64 {
+	if x != nil {
+		return x.LogRange
+	}
+	return 0
 }
 
 type Server_HTTP struct {
@@ -404,7 +1773,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +1785,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +1833,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +1845,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +1892,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +1904,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +1948,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +1960,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,19 +2018,96 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Treasury_Threshold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signer        string                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`                                    // Signer name, e.g. admin, relayer or role:erc721-minter
+	MinBalance    string                 `protobuf:"bytes,2,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`          // Alert below this balance in wei (default: treasury.min_balance)
+	TargetBalance string                 `protobuf:"bytes,3,opt,name=target_balance,json=targetBalance,proto3" json:"target_balance,omitempty"` // Top up to this balance in wei once below min_balance (optional, no top-up when empty)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Treasury_Threshold) Reset() {
+	*x = Treasury_Threshold{}
+	mi := &file_conf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Treasury_Threshold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Treasury_Threshold) ProtoMessage() {}
+
+func (x *Treasury_Threshold) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Treasury_Threshold.ProtoReflect.Descriptor instead.
+func (*Treasury_Threshold) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *Treasury_Threshold) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *Treasury_Threshold) GetMinBalance() string {
+	if x != nil {
+		return x.MinBalance
+	}
+	return ""
+}
+
+func (x *Treasury_Threshold) GetTargetBalance() string {
+	if x != nil {
+		return x.TargetBalance
+	}
+	return ""
+}
+
 var File_conf_proto protoreflect.FileDescriptor
 
 const file_conf_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xdb\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xc2\a\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
 	"\x03log\x18\x03 \x01(\v2\x0f.kratos.api.LogR\x03log\x120\n" +
 	"\bethereum\x18\x04 \x01(\v2\x14.kratos.api.EthereumR\bethereum\x12'\n" +
-	"\x05admin\x18\x05 \x01(\v2\x11.kratos.api.AdminR\x05admin\"\xb8\x02\n" +
+	"\x05admin\x18\x05 \x01(\v2\x11.kratos.api.AdminR\x05admin\x12$\n" +
+	"\x04jobs\x18\x06 \x01(\v2\x10.kratos.api.JobsR\x04jobs\x12<\n" +
+	"\ftransactions\x18\a \x01(\v2\x18.kratos.api.TransactionsR\ftransactions\x12'\n" +
+	"\x05batch\x18\b \x01(\v2\x11.kratos.api.BatchR\x05batch\x120\n" +
+	"\bmetadata\x18\t \x01(\v2\x14.kratos.api.MetadataR\bmetadata\x12-\n" +
+	"\asigning\x18\n" +
+	" \x01(\v2\x13.kratos.api.SigningR\asigning\x12-\n" +
+	"\arelayer\x18\v \x01(\v2\x13.kratos.api.RelayerR\arelayer\x12$\n" +
+	"\x04safe\x18\f \x01(\v2\x10.kratos.api.SafeR\x04safe\x121\n" +
+	"\thd_wallet\x18\r \x01(\v2\x14.kratos.api.HDWalletR\bhdWallet\x12,\n" +
+	"\asigners\x18\x0e \x03(\v2\x12.kratos.api.SignerR\asigners\x12$\n" +
+	"\x04keys\x18\x0f \x01(\v2\x10.kratos.api.KeysR\x04keys\x12-\n" +
+	"\ametrics\x18\x10 \x01(\v2\x13.kratos.api.MetricsR\ametrics\x12-\n" +
+	"\atracing\x18\x11 \x01(\v2\x13.kratos.api.TracingR\atracing\x12*\n" +
+	"\x06health\x18\x12 \x01(\v2\x12.kratos.api.HealthR\x06health\x120\n" +
+	"\btreasury\x18\x13 \x01(\v2\x14.kratos.api.TreasuryR\btreasury\x12*\n" +
+	"\x06deploy\x18\x14 \x01(\v2\x12.kratos.api.DeployR\x06deploy\x123\n" +
+	"\tportfolio\x18\x15 \x01(\v2\x15.kratos.api.PortfolioR\tportfolio\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -688,21 +2134,140 @@ const file_conf_proto_rawDesc = "" +
 	"\rwrite_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"3\n" +
 	"\x03Log\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x16\n" +
-	"\x06format\x18\x02 \x01(\tR\x06format\"\x95\x02\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\xfa\x03\n" +
 	"\bEthereum\x12\x17\n" +
 	"\arpc_url\x18\x01 \x01(\tR\x06rpcUrl\x12\x19\n" +
 	"\bchain_id\x18\x02 \x01(\x03R\achainId\x123\n" +
 	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x1f\n" +
 	"\vmax_retries\x18\x04 \x01(\x05R\n" +
 	"maxRetries\x12A\n" +
-	"\tcontracts\x18\x05 \x03(\v2#.kratos.api.Ethereum.ContractsEntryR\tcontracts\x1a<\n" +
+	"\tcontracts\x18\x05 \x03(\v2#.kratos.api.Ethereum.ContractsEntryR\tcontracts\x12H\n" +
+	"\x12reconnect_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x11reconnectInterval\x12O\n" +
+	"\x16max_reconnect_interval\x18\a \x01(\v2\x19.google.protobuf.DurationR\x14maxReconnectInterval\x12H\n" +
+	"\x12heartbeat_interval\x18\b \x01(\v2\x19.google.protobuf.DurationR\x11heartbeatInterval\x1a<\n" +
 	"\x0eContractsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x01\n" +
 	"\x05Admin\x12#\n" +
 	"\rkeystore_path\x18\x01 \x01(\tR\fkeystorePath\x12+\n" +
 	"\x11keystore_password\x18\x02 \x01(\tR\x10keystorePassword\x12\x18\n" +
-	"\aaddress\x18\x03 \x01(\tR\aaddressB)Z'eth-contract-service/internal/conf;confb\x06proto3"
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12&\n" +
+	"\x0fallow_use_admin\x18\x04 \x01(\bR\rallowUseAdmin\x12\x1d\n" +
+	"\n" +
+	"api_tokens\x18\x05 \x03(\tR\tapiTokens\"\xf0\x02\n" +
+	"\x04Jobs\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\abackend\x18\x02 \x01(\tR\abackend\x12\x18\n" +
+	"\aworkers\x18\x03 \x01(\x05R\aworkers\x12>\n" +
+	"\rpoll_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12L\n" +
+	"\x14rebroadcast_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x13rebroadcastInterval\x12B\n" +
+	"\x0freceipt_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0ereceiptTimeout\x12!\n" +
+	"\fmax_attempts\x18\a \x01(\x05R\vmaxAttempts\x12%\n" +
+	"\x0eencryption_key\x18\b \x01(\tR\rencryptionKey\"\x94\x02\n" +
+	"\fTransactions\x12(\n" +
+	"\x10fee_bump_percent\x18\x01 \x01(\rR\x0efeeBumpPercent\x12%\n" +
+	"\x0fmax_fee_per_gas\x18\x02 \x01(\tR\fmaxFeePerGas\x126\n" +
+	"\x18max_priority_fee_per_gas\x18\x03 \x01(\tR\x14maxPriorityFeePerGas\x12\x1b\n" +
+	"\tauto_bump\x18\x04 \x01(\bR\bautoBump\x128\n" +
+	"\n" +
+	"bump_after\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\tbumpAfter\x12$\n" +
+	"\x0emax_auto_bumps\x18\x06 \x01(\x05R\fmaxAutoBumps\"\xad\x01\n" +
+	"\x05Batch\x12$\n" +
+	"\x0etoken_id_start\x18\x01 \x01(\tR\ftokenIdStart\x12'\n" +
+	"\x10max_items_per_tx\x18\x02 \x01(\x05R\rmaxItemsPerTx\x12&\n" +
+	"\x0fblock_gas_ratio\x18\x03 \x01(\x01R\rblockGasRatio\x12-\n" +
+	"\x13max_txs_per_request\x18\x04 \x01(\x05R\x10maxTxsPerRequest\"\xde\x01\n" +
+	"\bMetadata\x12!\n" +
+	"\fipfs_gateway\x18\x01 \x01(\tR\vipfsGateway\x12'\n" +
+	"\x0farweave_gateway\x18\x02 \x01(\tR\x0earweaveGateway\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x126\n" +
+	"\tcache_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bcacheTtl\x12\x19\n" +
+	"\bmax_size\x18\x05 \x01(\x03R\amaxSize\"\xd6\x01\n" +
+	"\aSigning\x12'\n" +
+	"\x0fallowed_domains\x18\x01 \x03(\tR\x0eallowedDomains\x12>\n" +
+	"\x1ballowed_verifying_contracts\x18\x02 \x03(\tR\x19allowedVerifyingContracts\x126\n" +
+	"\x17allow_personal_messages\x18\x03 \x01(\bR\x15allowPersonalMessages\x12*\n" +
+	"\x11allowed_chain_ids\x18\x04 \x03(\x04R\x0fallowedChainIds\"\x94\x02\n" +
+	"\aRelayer\x12\x1c\n" +
+	"\tforwarder\x18\x01 \x01(\tR\tforwarder\x12\x1f\n" +
+	"\vdomain_name\x18\x02 \x01(\tR\n" +
+	"domainName\x12%\n" +
+	"\x0edomain_version\x18\x03 \x01(\tR\rdomainVersion\x12\x16\n" +
+	"\x06signer\x18\x04 \x01(\tR\x06signer\x12\x1f\n" +
+	"\vdaily_quota\x18\x05 \x01(\x05R\n" +
+	"dailyQuota\x12\x17\n" +
+	"\amax_gas\x18\x06 \x01(\x04R\x06maxGas\x12'\n" +
+	"\x0fallowed_targets\x18\a \x03(\tR\x0eallowedTargets\x12(\n" +
+	"\x10allow_any_target\x18\b \x01(\bR\x0eallowAnyTarget\"E\n" +
+	"\x04Safe\x12\x1a\n" +
+	"\bexecutor\x18\x01 \x01(\tR\bexecutor\x12!\n" +
+	"\fauto_execute\x18\x02 \x01(\bR\vautoExecute\"w\n" +
+	"\bHDWallet\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x03 \x01(\tR\n" +
+	"passphrase\x12\x1b\n" +
+	"\tbase_path\x18\x04 \x01(\tR\bbasePath\"\xba\x02\n" +
+	"\x06Signer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12#\n" +
+	"\rkeystore_path\x18\x04 \x01(\tR\fkeystorePath\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x10\n" +
+	"\x03api\x18\a \x01(\tR\x03api\x123\n" +
+	"\atimeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x16\n" +
+	"\x06module\x18\t \x01(\tR\x06module\x12\x1f\n" +
+	"\vtoken_label\x18\n" +
+	" \x01(\tR\n" +
+	"tokenLabel\x12\x1b\n" +
+	"\tkey_label\x18\v \x01(\tR\bkeyLabel\"N\n" +
+	"\x04Keys\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x12\x19\n" +
+	"\bscrypt_n\x18\x02 \x01(\x05R\ascryptN\x12\x19\n" +
+	"\bscrypt_p\x18\x03 \x01(\x05R\ascryptP\"\x86\x01\n" +
+	"\aMetrics\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12M\n" +
+	"\x15receipt_poll_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x13receiptPollInterval\"\x9a\x01\n" +
+	"\aTracing\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\x12\x1a\n" +
+	"\binsecure\x18\x04 \x01(\bR\binsecure\x12!\n" +
+	"\fsample_ratio\x18\x05 \x01(\x01R\vsampleRatio\"\xaa\x01\n" +
+	"\x06Health\x123\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12=\n" +
+	"\rmax_block_age\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vmaxBlockAge\x12,\n" +
+	"\x12min_signer_balance\x18\x03 \x01(\tR\x10minSignerBalance\"\xb0\x03\n" +
+	"\bTreasury\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12@\n" +
+	"\x0echeck_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rcheckInterval\x12\x1f\n" +
+	"\vmin_balance\x18\x03 \x01(\tR\n" +
+	"minBalance\x12>\n" +
+	"\n" +
+	"thresholds\x18\x04 \x03(\v2\x1e.kratos.api.Treasury.ThresholdR\n" +
+	"thresholds\x12%\n" +
+	"\x0efunding_signer\x18\x05 \x01(\tR\rfundingSigner\x12'\n" +
+	"\x10daily_top_up_cap\x18\x06 \x01(\tR\rdailyTopUpCap\x12*\n" +
+	"\x11alert_webhook_url\x18\a \x01(\tR\x0falertWebhookUrl\x1ak\n" +
+	"\tThreshold\x12\x16\n" +
+	"\x06signer\x18\x01 \x01(\tR\x06signer\x12\x1f\n" +
+	"\vmin_balance\x18\x02 \x01(\tR\n" +
+	"minBalance\x12%\n" +
+	"\x0etarget_balance\x18\x03 \x01(\tR\rtargetBalance\"o\n" +
+	"\x06Deploy\x12'\n" +
+	"\x0fcreate2_factory\x18\x01 \x01(\tR\x0ecreate2Factory\x12<\n" +
+	"\fwait_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vwaitTimeout\"\xd2\x01\n" +
+	"\tPortfolio\x12\x1d\n" +
+	"\n" +
+	"from_block\x18\x01 \x01(\x04R\tfromBlock\x12I\n" +
+	"\fstart_blocks\x18\x02 \x03(\v2&.kratos.api.Portfolio.StartBlocksEntryR\vstartBlocks\x12\x1b\n" +
+	"\tlog_range\x18\x03 \x01(\x04R\blogRange\x1a>\n" +
+	"\x10StartBlocksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01B)Z'eth-contract-service/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_proto_rawDescOnce sync.Once
@@ -716,7 +2281,7 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -724,12 +2289,30 @@ var file_conf_proto_goTypes = []any{
 	(*Log)(nil),                 // 3: kratos.api.Log
 	(*Ethereum)(nil),            // 4: kratos.api.Ethereum
 	(*Admin)(nil),               // 5: kratos.api.Admin
-	(*Server_HTTP)(nil),         // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 8: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 9: kratos.api.Data.Redis
-	nil,                         // 10: kratos.api.Ethereum.ContractsEntry
-	(*durationpb.Duration)(nil), // 11: google.protobuf.Duration
+	(*Jobs)(nil),                // 6: kratos.api.Jobs
+	(*Transactions)(nil),        // 7: kratos.api.Transactions
+	(*Batch)(nil),               // 8: kratos.api.Batch
+	(*Metadata)(nil),            // 9: kratos.api.Metadata
+	(*Signing)(nil),             // 10: kratos.api.Signing
+	(*Relayer)(nil),             // 11: kratos.api.Relayer
+	(*Safe)(nil),                // 12: kratos.api.Safe
+	(*HDWallet)(nil),            // 13: kratos.api.HDWallet
+	(*Signer)(nil),              // 14: kratos.api.Signer
+	(*Keys)(nil),                // 15: kratos.api.Keys
+	(*Metrics)(nil),             // 16: kratos.api.Metrics
+	(*Tracing)(nil),             // 17: kratos.api.Tracing
+	(*Health)(nil),              // 18: kratos.api.Health
+	(*Treasury)(nil),            // 19: kratos.api.Treasury
+	(*Deploy)(nil),              // 20: kratos.api.Deploy
+	(*Portfolio)(nil),           // 21: kratos.api.Portfolio
+	(*Server_HTTP)(nil),         // 22: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 23: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 24: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 25: kratos.api.Data.Redis
+	nil,                         // 26: kratos.api.Ethereum.ContractsEntry
+	(*Treasury_Threshold)(nil),  // 27: kratos.api.Treasury.Threshold
+	nil,                         // 28: kratos.api.Portfolio.StartBlocksEntry
+	(*durationpb.Duration)(nil), // 29: google.protobuf.Duration
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -737,21 +2320,54 @@ var file_conf_proto_depIdxs = []int32{
 	3,  // 2: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
 	4,  // 3: kratos.api.Bootstrap.ethereum:type_name -> kratos.api.Ethereum
 	5,  // 4: kratos.api.Bootstrap.admin:type_name -> kratos.api.Admin
-	6,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	7,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	8,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	9,  // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	11, // 9: kratos.api.Ethereum.timeout:type_name -> google.protobuf.Duration
-	10, // 10: kratos.api.Ethereum.contracts:type_name -> kratos.api.Ethereum.ContractsEntry
-	11, // 11: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	11, // 12: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	11, // 13: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	11, // 14: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	6,  // 5: kratos.api.Bootstrap.jobs:type_name -> kratos.api.Jobs
+	7,  // 6: kratos.api.Bootstrap.transactions:type_name -> kratos.api.Transactions
+	8,  // 7: kratos.api.Bootstrap.batch:type_name -> kratos.api.Batch
+	9,  // 8: kratos.api.Bootstrap.metadata:type_name -> kratos.api.Metadata
+	10, // 9: kratos.api.Bootstrap.signing:type_name -> kratos.api.Signing
+	11, // 10: kratos.api.Bootstrap.relayer:type_name -> kratos.api.Relayer
+	12, // 11: kratos.api.Bootstrap.safe:type_name -> kratos.api.Safe
+	13, // 12: kratos.api.Bootstrap.hd_wallet:type_name -> kratos.api.HDWallet
+	14, // 13: kratos.api.Bootstrap.signers:type_name -> kratos.api.Signer
+	15, // 14: kratos.api.Bootstrap.keys:type_name -> kratos.api.Keys
+	16, // 15: kratos.api.Bootstrap.metrics:type_name -> kratos.api.Metrics
+	17, // 16: kratos.api.Bootstrap.tracing:type_name -> kratos.api.Tracing
+	18, // 17: kratos.api.Bootstrap.health:type_name -> kratos.api.Health
+	19, // 18: kratos.api.Bootstrap.treasury:type_name -> kratos.api.Treasury
+	20, // 19: kratos.api.Bootstrap.deploy:type_name -> kratos.api.Deploy
+	21, // 20: kratos.api.Bootstrap.portfolio:type_name -> kratos.api.Portfolio
+	22, // 21: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	23, // 22: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	24, // 23: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	25, // 24: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	29, // 25: kratos.api.Ethereum.timeout:type_name -> google.protobuf.Duration
+	26, // 26: kratos.api.Ethereum.contracts:type_name -> kratos.api.Ethereum.ContractsEntry
+	29, // 27: kratos.api.Ethereum.reconnect_interval:type_name -> google.protobuf.Duration
+	29, // 28: kratos.api.Ethereum.max_reconnect_interval:type_name -> google.protobuf.Duration
+	29, // 29: kratos.api.Ethereum.heartbeat_interval:type_name -> google.protobuf.Duration
+	29, // 30: kratos.api.Jobs.poll_interval:type_name -> google.protobuf.Duration
+	29, // 31: kratos.api.Jobs.rebroadcast_interval:type_name -> google.protobuf.Duration
+	29, // 32: kratos.api.Jobs.receipt_timeout:type_name -> google.protobuf.Duration
+	29, // 33: kratos.api.Transactions.bump_after:type_name -> google.protobuf.Duration
+	29, // 34: kratos.api.Metadata.timeout:type_name -> google.protobuf.Duration
+	29, // 35: kratos.api.Metadata.cache_ttl:type_name -> google.protobuf.Duration
+	29, // 36: kratos.api.Signer.timeout:type_name -> google.protobuf.Duration
+	29, // 37: kratos.api.Metrics.receipt_poll_interval:type_name -> google.protobuf.Duration
+	29, // 38: kratos.api.Health.timeout:type_name -> google.protobuf.Duration
+	29, // 39: kratos.api.Health.max_block_age:type_name -> google.protobuf.Duration
+	29, // 40: kratos.api.Treasury.check_interval:type_name -> google.protobuf.Duration
+	27, // 41: kratos.api.Treasury.thresholds:type_name -> kratos.api.Treasury.Threshold
+	29, // 42: kratos.api.Deploy.wait_timeout:type_name -> google.protobuf.Duration
+	28, // 43: kratos.api.Portfolio.start_blocks:type_name -> kratos.api.Portfolio.StartBlocksEntry
+	29, // 44: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	29, // 45: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	29, // 46: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	29, // 47: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	48, // [48:48] is the sub-list for method output_type
+	48, // [48:48] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -765,7 +2381,7 @@ func file_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
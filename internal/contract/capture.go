@@ -0,0 +1,170 @@
+package contract
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// NonceFunc returns the nonce to use for the next transaction sent by the given address.
+type NonceFunc func(ctx context.Context, from common.Address) (uint64, error)
+
+// TxCapture collects the signed transaction produced by a write operation instead of
+// letting the contract binding broadcast it. It is used by the asynchronous job workers,
+// which assign nonces themselves and take care of broadcasting and receipt tracking.
+type TxCapture struct {
+	nonce NonceFunc
+
+	mu       sync.Mutex
+	from     common.Address
+	tx       *types.Transaction
+	fixed    *uint64
+	sender   *common.Address
+	unsigned bool
+}
+
+type txCaptureKey struct{}
+
+// onBehalfGasLimit is a placeholder gas limit for captured calls executed by another account
+const onBehalfGasLimit = 1
+
+// NewTxCapture creates a capture that assigns nonces with the given function.
+// A nil function leaves nonce selection to the contract binding.
+func NewTxCapture(nonce NonceFunc) *TxCapture {
+	return &TxCapture{nonce: nonce}
+}
+
+// WithTxCapture returns a context that makes CreateTransactOpts produce signed,
+// unsent transactions recorded in the given capture.
+func WithTxCapture(ctx context.Context, capture *TxCapture) context.Context {
+	return context.WithValue(ctx, txCaptureKey{}, capture)
+}
+
+// TxCaptureFromContext returns the capture attached to the context, if any.
+func TxCaptureFromContext(ctx context.Context) *TxCapture {
+	capture, _ := ctx.Value(txCaptureKey{}).(*TxCapture)
+	return capture
+}
+
+// OnBehalfOf makes the capture build calls that are executed by another account, such as
+// a Safe, rather than by the signer. Gas estimation and fee lookup are skipped because the
+// call is only valid when sent from that account; only the target, value and data of the
+// captured transaction are meaningful.
+func (c *TxCapture) OnBehalfOf(sender common.Address) *TxCapture {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sender = &sender
+	return c
+}
+
+// Unsigned makes the capture build the transaction for an external signer holding the key
+// of from. The transaction is built as if sent by from, with gas, fees and the nonce from
+// the capture's nonce function filled in, and is captured without a signature; the key of
+// the request is not used.
+func (c *TxCapture) Unsigned(from common.Address) *TxCapture {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sender = &from
+	c.unsigned = true
+	return c
+}
+
+// SenderFromContext returns the account that will execute the transactions built for the
+// given signer: the account set with OnBehalfOf or Unsigned, or the signer itself.
+func SenderFromContext(ctx context.Context, signer common.Address) common.Address {
+	capture := TxCaptureFromContext(ctx)
+	if capture == nil {
+		return signer
+	}
+	capture.mu.Lock()
+	defer capture.mu.Unlock()
+	if capture.sender == nil {
+		return signer
+	}
+	return *capture.sender
+}
+
+// From returns the signer address seen by the capture.
+func (c *TxCapture) From() common.Address {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.from
+}
+
+// Transaction returns the last transaction signed while the capture was active, or built
+// without a signature for an Unsigned capture.
+func (c *TxCapture) Transaction() *types.Transaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tx
+}
+
+// Broadcasts reports whether the captured transaction is broadcast as captured, as the job
+// workers do, so that its hash is final. Calls built for another account or for an
+// external signer are sent as a different transaction, if at all.
+func (c *TxCapture) Broadcasts() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sender == nil
+}
+
+// Nonce returns the nonce assigned by the capture and whether one was assigned.
+func (c *TxCapture) Nonce() (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fixed == nil {
+		return 0, false
+	}
+	return *c.fixed, true
+}
+
+// apply configures the transaction options so that the binding signs but does not send.
+func (c *TxCapture) apply(ctx context.Context, auth *bind.TransactOpts) error {
+	c.mu.Lock()
+	sender, unsigned := c.sender, c.unsigned
+	if unsigned {
+		auth.From = *sender
+	}
+	c.from = auth.From
+	c.mu.Unlock()
+
+	if sender != nil && !unsigned {
+		auth.Nonce = new(big.Int)
+		auth.GasPrice = new(big.Int)
+		auth.GasLimit = onBehalfGasLimit
+	}
+
+	if c.nonce != nil && (sender == nil || unsigned) {
+		nonce, err := c.nonce(ctx, auth.From)
+		if err != nil {
+			return err
+		}
+		c.mu.Lock()
+		c.fixed = &nonce
+		c.mu.Unlock()
+		auth.Nonce = new(big.Int).SetUint64(nonce)
+	}
+
+	signer := auth.Signer
+	if unsigned {
+		signer = func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		}
+	}
+	auth.Signer = func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		signed, err := signer(addr, tx)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		c.tx = signed
+		c.mu.Unlock()
+		return signed, nil
+	}
+	auth.NoSend = true
+	return nil
+}
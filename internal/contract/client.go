@@ -8,10 +8,14 @@ import (
 	"eth-contract-service/provider/contract/erc1155"
 	"eth-contract-service/provider/contract/erc20"
 	"eth-contract-service/provider/contract/erc721"
+	"eth-contract-service/provider/contract/forwarder"
+	"eth-contract-service/provider/contract/safe"
 	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/go-kratos/kratos/v2/log"
 	pkgErrors "github.com/pkg/errors"
@@ -43,7 +47,7 @@ func NewClient(logger log.Logger) *Client {
 func (c *Client) GetERC20Token(contractAddr common.Address) (*erc20.ERC20Token, error) {
 	client := eth.GetClient()
 	if client == nil {
-		return nil, pkgErrors.Wrap(errors.ErrClientNotInitialized, "failed to get ethereum client")
+		return nil, nodeUnavailable()
 	}
 
 	token, err := erc20.NewERC20Token(contractAddr, client)
@@ -58,7 +62,7 @@ func (c *Client) GetERC20Token(contractAddr common.Address) (*erc20.ERC20Token,
 func (c *Client) GetERC20TokenOwnable(contractAddr common.Address) (*erc20.ERC20TokenOwnable, error) {
 	client := eth.GetClient()
 	if client == nil {
-		return nil, pkgErrors.Wrap(errors.ErrClientNotInitialized, "failed to get ethereum client")
+		return nil, nodeUnavailable()
 	}
 
 	token, err := erc20.NewERC20TokenOwnable(contractAddr, client)
@@ -69,6 +73,51 @@ func (c *Client) GetERC20TokenOwnable(contractAddr common.Address) (*erc20.ERC20
 	return token, nil
 }
 
+// GetERC20Permit creates an EIP-2612 permit contract instance
+func (c *Client) GetERC20Permit(contractAddr common.Address) (*erc20.ERC20Permit, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, nodeUnavailable()
+	}
+
+	token, err := erc20.NewERC20Permit(contractAddr, client)
+	if err != nil {
+		return nil, pkgErrors.Wrapf(err, "failed to create ERC20Permit instance for address %s", contractAddr.Hex())
+	}
+
+	return token, nil
+}
+
+// GetForwarder creates an ERC-2771 MinimalForwarder contract instance
+func (c *Client) GetForwarder(contractAddr common.Address) (*forwarder.MinimalForwarder, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, nodeUnavailable()
+	}
+
+	fwd, err := forwarder.NewMinimalForwarder(contractAddr, client)
+	if err != nil {
+		return nil, pkgErrors.Wrapf(err, "failed to create MinimalForwarder instance for address %s", contractAddr.Hex())
+	}
+
+	return fwd, nil
+}
+
+// GetSafe creates a Safe multisig wallet contract instance
+func (c *Client) GetSafe(safeAddr common.Address) (*safe.Safe, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, nodeUnavailable()
+	}
+
+	wallet, err := safe.NewSafe(safeAddr, client)
+	if err != nil {
+		return nil, pkgErrors.Wrapf(err, "failed to create Safe instance for address %s", safeAddr.Hex())
+	}
+
+	return wallet, nil
+}
+
 // GetERC20Contract creates an ERC20 contract instance based on the contract type
 func (c *Client) GetERC20Contract(contractAddr common.Address, contractType ContractType) (interface{}, error) {
 	switch contractType {
@@ -89,6 +138,12 @@ func (c *Client) CreateTransactOpts(ctx context.Context, privateKey []byte) (*bi
 		return nil, pkgErrors.Wrap(errors.ErrInvalidPrivateKey, err.Error())
 	}
 
+	return c.CreateSignerTransactOpts(ctx, keystore.NewKeySigner(key))
+}
+
+// CreateSignerTransactOpts creates transaction options that sign with a keystore signer,
+// which may keep its key outside of this process
+func (c *Client) CreateSignerTransactOpts(ctx context.Context, signer keystore.Signer) (*bind.TransactOpts, error) {
 	// Get chain ID
 	chainID := eth.GetChainID()
 	if chainID == nil {
@@ -96,14 +151,27 @@ func (c *Client) CreateTransactOpts(ctx context.Context, privateKey []byte) (*bi
 	}
 
 	// Create transaction options
-	auth, err := bind.NewKeyedTransactorWithChainID(key, chainID)
-	if err != nil {
-		return nil, pkgErrors.Wrap(err, "failed to create transactor")
+	from := signer.Address()
+	auth := &bind.TransactOpts{
+		From: from,
+		Signer: func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if addr != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return signer.SignTx(ctx, tx, chainID)
+		},
 	}
 
 	// Set context
 	auth.Context = ctx
 
+	// Defer broadcasting to the caller when running inside an asynchronous job
+	if capture := TxCaptureFromContext(ctx); capture != nil {
+		if err := capture.apply(ctx, auth); err != nil {
+			return nil, pkgErrors.Wrap(err, "failed to assign nonce")
+		}
+	}
+
 	return auth, nil
 }
 
@@ -120,7 +188,7 @@ func (c *Client) GetAddressFromPrivateKey(privateKey []byte) (common.Address, er
 func (c *Client) GetERC721Token(contractAddr common.Address) (*erc721.Erc721, error) {
 	client := eth.GetClient()
 	if client == nil {
-		return nil, pkgErrors.Wrap(errors.ErrClientNotInitialized, "failed to get ethereum client")
+		return nil, nodeUnavailable()
 	}
 
 	token, err := erc721.NewErc721(contractAddr, client)
@@ -135,7 +203,7 @@ func (c *Client) GetERC721Token(contractAddr common.Address) (*erc721.Erc721, er
 func (c *Client) GetERC1155Token(contractAddr common.Address) (*erc1155.Erc1155, error) {
 	client := eth.GetClient()
 	if client == nil {
-		return nil, pkgErrors.Wrap(errors.ErrClientNotInitialized, "failed to get ethereum client")
+		return nil, nodeUnavailable()
 	}
 
 	token, err := erc1155.NewErc1155(contractAddr, client)
@@ -146,12 +214,19 @@ func (c *Client) GetERC1155Token(contractAddr common.Address) (*erc1155.Erc1155,
 	return token, nil
 }
 
-// ValidateClient validates that the Ethereum client is initialized
+// ValidateClient validates that the Ethereum client is connected.
+// While it is reconnecting the error is Unavailable and suggests when to retry.
 func (c *Client) ValidateClient() error {
 	client := eth.GetClient()
 	if client == nil {
-		return errors.ErrClientNotInitialized
+		return nodeUnavailable()
 	}
 	return nil
 }
 
+// nodeUnavailable returns the error for requests made while the Ethereum client is
+// reconnecting
+func nodeUnavailable() error {
+	return errors.NodeUnavailable(eth.RetryAfter(), eth.LastError())
+}
+
@@ -0,0 +1,143 @@
+package contract
+
+import (
+	"math/big"
+
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	pkgErrors "github.com/pkg/errors"
+)
+
+// NativeTransfer is a transfer of the chain's native currency with its gas and fees resolved
+type NativeTransfer struct {
+	// To is the recipient
+	To common.Address
+	// Value is the amount in wei
+	Value *big.Int
+	// Gas is the gas limit
+	Gas uint64
+	// GasTipCap and GasFeeCap are the EIP-1559 fees, nil for legacy transactions
+	GasTipCap, GasFeeCap *big.Int
+	// GasPrice is the legacy gas price, nil for EIP-1559 transactions
+	GasPrice *big.Int
+}
+
+// MaxFee returns the most the sender pays for gas: the gas limit times the fee cap
+func (t *NativeTransfer) MaxFee() *big.Int {
+	price := t.GasFeeCap
+	if price == nil {
+		price = t.GasPrice
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(t.Gas), price)
+}
+
+// PrepareNativeTransfer estimates the gas and fees of a native transfer.
+// Gas limit and gas price set on the transaction options are kept, like the contract
+// bindings do; otherwise EIP-1559 fees with two base fees of headroom are used on chains
+// that support them.
+//
+// Parameters:
+//   - auth: Transaction options of the sender
+//   - to: Recipient address
+//   - value: Amount in wei
+//
+// Returns:
+//   - *NativeTransfer: The transfer with gas and fees resolved
+//   - error: Error if the node is unavailable or the estimation fails
+func (c *Client) PrepareNativeTransfer(auth *bind.TransactOpts, to common.Address, value *big.Int) (*NativeTransfer, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, nodeUnavailable()
+	}
+	ctx := auth.Context
+
+	t := &NativeTransfer{To: to, Value: value, Gas: auth.GasLimit}
+	if t.Gas == 0 {
+		gas, err := client.EstimateGas(ctx, ethereum.CallMsg{From: auth.From, To: &to, Value: value})
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, "failed to estimate gas")
+		}
+		t.Gas = gas
+	}
+
+	if auth.GasPrice != nil {
+		t.GasPrice = auth.GasPrice
+		return t, nil
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to get latest header")
+	}
+	if head.BaseFee == nil {
+		if t.GasPrice, err = client.SuggestGasPrice(ctx); err != nil {
+			return nil, pkgErrors.Wrap(err, "failed to get suggested gas price")
+		}
+		return t, nil
+	}
+	if t.GasTipCap, err = client.SuggestGasTipCap(ctx); err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to get suggested gas tip cap")
+	}
+	t.GasFeeCap = new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), t.GasTipCap)
+	return t, nil
+}
+
+// SendNativeTransfer signs a prepared native transfer and sends it.
+// The nonce set on the transaction options is used, otherwise the next nonce of the
+// sender is reserved. Inside an asynchronous job or Safe proposal the signed transaction
+// is only captured and broadcasting is left to the caller.
+//
+// Parameters:
+//   - auth: Transaction options of the sender
+//   - t: The prepared transfer
+//
+// Returns:
+//   - *types.Transaction: The signed transaction
+//   - error: Error if signing or sending fails
+func (c *Client) SendNativeTransfer(auth *bind.TransactOpts, t *NativeTransfer) (*types.Transaction, error) {
+	ctx := auth.Context
+	chainID := eth.GetChainID()
+	if chainID == nil {
+		return nil, pkgErrors.New("chain ID not configured")
+	}
+
+	reserved := auth.Nonce == nil
+	var nonce uint64
+	if reserved {
+		n, err := eth.NextNonce(ctx, auth.From)
+		if err != nil {
+			return nil, err
+		}
+		nonce = n
+	} else {
+		nonce = auth.Nonce.Uint64()
+	}
+
+	var data types.TxData
+	if t.GasFeeCap != nil {
+		data = &types.DynamicFeeTx{ChainID: chainID, Nonce: nonce, GasTipCap: t.GasTipCap, GasFeeCap: t.GasFeeCap,
+			Gas: t.Gas, To: &t.To, Value: t.Value}
+	} else {
+		data = &types.LegacyTx{Nonce: nonce, GasPrice: t.GasPrice, Gas: t.Gas, To: &t.To, Value: t.Value}
+	}
+
+	signed, err := auth.Signer(auth.From, types.NewTx(data))
+	if err != nil {
+		if reserved {
+			eth.ReleaseNonce(auth.From, nonce)
+		}
+		return nil, pkgErrors.Wrap(err, "failed to sign transaction")
+	}
+	if auth.NoSend {
+		return signed, nil
+	}
+	if err := eth.SendTransaction(ctx, signed); err != nil {
+		// The node may have seen the nonce, so it is read from the node again
+		eth.ResetNonce(auth.From)
+		return nil, err
+	}
+	return signed, nil
+}
@@ -0,0 +1,244 @@
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	appErrors "eth-contract-service/internal/errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// bigIntType is the Go type of integers wider than 64 bits
+var bigIntType = reflect.TypeOf(new(big.Int))
+
+// ParseArgs converts arguments given as JSON to the Go values expected by the ABI encoder.
+// args is a JSON array in input order or an object keyed by input name. Integers are JSON
+// numbers or strings in decimal or 0x hex, bytes are hex strings and tuples are arrays or
+// objects keyed by component name.
+//
+// Parameters:
+//   - inputs: The inputs of the constructor or method
+//   - args: The arguments as JSON (empty for no arguments)
+//
+// Returns:
+//   - []interface{}: The arguments in input order
+//   - error: Error if an argument is missing or does not fit its input type
+func ParseArgs(inputs abi.Arguments, args string) ([]interface{}, error) {
+	var raw interface{}
+	if strings.TrimSpace(args) != "" {
+		dec := json.NewDecoder(strings.NewReader(args))
+		dec.UseNumber()
+		if err := dec.Decode(&raw); err != nil {
+			return nil, appErrors.InvalidArgument("invalid constructor_args: %v", err)
+		}
+	}
+
+	var values []interface{}
+	switch v := raw.(type) {
+	case nil:
+		values = nil
+	case []interface{}:
+		values = v
+	case map[string]interface{}:
+		values = make([]interface{}, len(inputs))
+		for i, input := range inputs {
+			value, ok := v[input.Name]
+			if !ok {
+				return nil, appErrors.InvalidArgument("constructor_args is missing %s", argName(input, i))
+			}
+			values[i] = value
+		}
+		if len(v) > len(inputs) {
+			return nil, appErrors.InvalidArgument("constructor_args has unknown arguments")
+		}
+	default:
+		return nil, appErrors.InvalidArgument("constructor_args must be a JSON array or object")
+	}
+	if len(values) != len(inputs) {
+		return nil, appErrors.InvalidArgument("constructor_args has %d arguments, the constructor takes %d", len(values), len(inputs))
+	}
+
+	out := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		v, err := convertArg(input.Type, values[i], argName(input, i))
+		if err != nil {
+			return nil, appErrors.InvalidArgument("invalid constructor_args: %v", err)
+		}
+		out[i] = v.Interface()
+	}
+	return out, nil
+}
+
+// argName names an input in error messages
+func argName(input abi.Argument, i int) string {
+	if input.Name != "" {
+		return input.Name
+	}
+	return fmt.Sprintf("argument %d", i)
+}
+
+// convertArg converts a decoded JSON value to the Go type of an ABI type
+func convertArg(t abi.Type, v interface{}, path string) (reflect.Value, error) {
+	switch t.T {
+	case abi.AddressTy:
+		s, ok := v.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("%s: expected an address", path)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+
+	case abi.BoolTy:
+		b, ok := v.(bool)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%s: expected a boolean", path)
+		}
+		return reflect.ValueOf(b), nil
+
+	case abi.StringTy:
+		s, ok := v.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%s: expected a string", path)
+		}
+		return reflect.ValueOf(s), nil
+
+	case abi.BytesTy, abi.FixedBytesTy:
+		s, ok := v.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%s: expected hex encoded bytes", path)
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s: %v", path, err)
+		}
+		if t.T == abi.BytesTy {
+			return reflect.ValueOf(b), nil
+		}
+		if len(b) != t.Size {
+			return reflect.Value{}, fmt.Errorf("%s: expected %d bytes, got %d", path, t.Size, len(b))
+		}
+		out := reflect.New(t.GetType()).Elem()
+		reflect.Copy(out, reflect.ValueOf(b))
+		return out, nil
+
+	case abi.IntTy, abi.UintTy:
+		n, err := parseInteger(v)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s: %v", path, err)
+		}
+		if !fitsInteger(n, t) {
+			return reflect.Value{}, fmt.Errorf("%s: %s does not fit %s", path, n, t)
+		}
+		typ := t.GetType()
+		if typ == bigIntType {
+			return reflect.ValueOf(n), nil
+		}
+		out := reflect.New(typ).Elem()
+		if t.T == abi.IntTy {
+			out.SetInt(n.Int64())
+		} else {
+			out.SetUint(n.Uint64())
+		}
+		return out, nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		elems, ok := v.([]interface{})
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%s: expected an array", path)
+		}
+		var out reflect.Value
+		if t.T == abi.SliceTy {
+			out = reflect.MakeSlice(t.GetType(), len(elems), len(elems))
+		} else {
+			if len(elems) != t.Size {
+				return reflect.Value{}, fmt.Errorf("%s: expected %d elements, got %d", path, t.Size, len(elems))
+			}
+			out = reflect.New(t.GetType()).Elem()
+		}
+		for i, elem := range elems {
+			ev, err := convertArg(*t.Elem, elem, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out.Index(i).Set(ev)
+		}
+		return out, nil
+
+	case abi.TupleTy:
+		var fields []interface{}
+		switch tv := v.(type) {
+		case []interface{}:
+			fields = tv
+		case map[string]interface{}:
+			fields = make([]interface{}, len(t.TupleRawNames))
+			for i, name := range t.TupleRawNames {
+				field, ok := tv[name]
+				if !ok {
+					return reflect.Value{}, fmt.Errorf("%s: missing %s", path, name)
+				}
+				fields[i] = field
+			}
+		default:
+			return reflect.Value{}, fmt.Errorf("%s: expected an array or object", path)
+		}
+		if len(fields) != len(t.TupleElems) {
+			return reflect.Value{}, fmt.Errorf("%s: expected %d components, got %d", path, len(t.TupleElems), len(fields))
+		}
+		out := reflect.New(t.GetType()).Elem()
+		for i, elem := range t.TupleElems {
+			fv, err := convertArg(*elem, fields[i], path+"."+t.TupleRawNames[i])
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out.Field(i).Set(fv)
+		}
+		return out, nil
+	}
+	return reflect.Value{}, fmt.Errorf("%s: unsupported type %s", path, t)
+}
+
+// parseInteger parses a JSON number or a decimal or 0x hex string as an integer
+func parseInteger(v interface{}) (*big.Int, error) {
+	var s string
+	switch n := v.(type) {
+	case json.Number:
+		s = n.String()
+	case string:
+		s = strings.TrimSpace(n)
+	default:
+		return nil, fmt.Errorf("expected an integer")
+	}
+	base := 10
+	digits := s
+	neg := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		base, digits = 16, digits[2:]
+	}
+	n, ok := new(big.Int).SetString(digits, base)
+	if !ok || digits == "" || bytes.ContainsAny([]byte(digits), "+-") {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	if neg {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+// fitsInteger reports whether an integer lies within the range of an integer ABI type
+func fitsInteger(n *big.Int, t abi.Type) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	if n.Sign() < 0 {
+		return n.Cmp(new(big.Int).Neg(limit)) >= 0
+	}
+	return n.Cmp(limit) < 0
+}
@@ -0,0 +1,320 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/db"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxNameLength is the longest artifact name
+	maxNameLength = 128
+	// maxVersionLength is the longest artifact version
+	maxVersionLength = 64
+)
+
+// ArtifactInput is a compiled contract to register
+type ArtifactInput struct {
+	// Name and Version identify the artifact
+	Name, Version string
+	// ABI is the contract ABI as JSON; taken from the metadata when empty
+	ABI string
+	// Bytecode is the hex encoded creation bytecode
+	Bytecode string
+	// DeployedBytecode is the hex encoded runtime bytecode (optional, enables the code check)
+	DeployedBytecode string
+	// ImmutableReferences is the solc immutableReferences JSON of the runtime bytecode (optional)
+	ImmutableReferences string
+	// Metadata is the solc metadata JSON (optional, enables verification bundles)
+	Metadata string
+	// Sources holds source contents missing from the metadata by source path (optional)
+	Sources map[string]string
+}
+
+// ArtifactFilter restricts the artifacts returned by ListArtifacts
+type ArtifactFilter struct {
+	Name   string
+	Limit  int
+	Offset int
+}
+
+// solcMetadata is the part of the solc metadata used for registration and verification
+type solcMetadata struct {
+	Compiler struct {
+		Version string `json:"version"`
+	} `json:"compiler"`
+	Language string `json:"language"`
+	Output   struct {
+		ABI json.RawMessage `json:"abi"`
+	} `json:"output"`
+	Settings map[string]json.RawMessage `json:"settings"`
+	Sources  map[string]struct {
+		Keccak256 string  `json:"keccak256"`
+		Content   *string `json:"content"`
+	} `json:"sources"`
+}
+
+// codeRange is a range of the runtime bytecode filled in at deployment
+type codeRange struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// RegisterArtifact validates and stores an artifact. Registering the same content under a
+// name and version again returns the stored artifact; versions cannot be changed.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - in: The artifact to register
+//
+// Returns:
+//   - *Artifact: The registered artifact
+//   - bool: Whether the artifact was newly registered
+//   - error: Error if the artifact is invalid or the version exists with different content
+func RegisterArtifact(ctx context.Context, in *ArtifactInput) (*Artifact, bool, error) {
+	a, err := newArtifact(in)
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing, err := GetArtifact(ctx, a.Name, a.Version)
+	if err == nil {
+		if !sameContent(existing, a) {
+			return nil, false, appErrors.ErrArtifactExists
+		}
+		return existing, false, nil
+	}
+	if !errors.Is(err, appErrors.ErrArtifactNotFound) {
+		return nil, false, err
+	}
+
+	if err := db.Get().WithContext(ctx).Create(a).Error; err != nil {
+		// A concurrent registration of the same version wins the unique index
+		if existing, getErr := GetArtifact(ctx, a.Name, a.Version); getErr == nil {
+			if !sameContent(existing, a) {
+				return nil, false, appErrors.ErrArtifactExists
+			}
+			return existing, false, nil
+		}
+		return nil, false, errors.Wrap(err, "failed to register artifact")
+	}
+	return a, true, nil
+}
+
+// GetArtifact returns the artifact registered under a name and version.
+// An empty version selects the most recently registered version.
+func GetArtifact(ctx context.Context, name, version string) (*Artifact, error) {
+	q := db.Get().WithContext(ctx).Where("name = ?", name)
+	if version != "" {
+		q = q.Where("version = ?", version)
+	}
+	var a Artifact
+	if err := q.Order("id DESC").Limit(1).Find(&a).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to get artifact")
+	}
+	if a.ID == 0 {
+		return nil, appErrors.ErrArtifactNotFound
+	}
+	return &a, nil
+}
+
+// ListArtifacts returns artifacts matching the filter, newest first, and the total count
+func ListArtifacts(ctx context.Context, filter ArtifactFilter) ([]*Artifact, int64, error) {
+	q := db.Get().WithContext(ctx).Model(&Artifact{})
+	if filter.Name != "" {
+		q = q.Where("name = ?", filter.Name)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count artifacts")
+	}
+	var artifacts []*Artifact
+	if err := q.Order("id DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&artifacts).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list artifacts")
+	}
+	return artifacts, total, nil
+}
+
+// ParsedABI returns the parsed ABI of the artifact
+func (a *Artifact) ParsedABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(a.ABI))
+	if err != nil {
+		return abi.ABI{}, errors.Wrap(err, "failed to parse artifact ABI")
+	}
+	return parsed, nil
+}
+
+// newArtifact validates an artifact input and converts it to its stored form
+func newArtifact(in *ArtifactInput) (*Artifact, error) {
+	name, version := strings.TrimSpace(in.Name), strings.TrimSpace(in.Version)
+	if name == "" {
+		return nil, appErrors.InvalidArgument("name cannot be empty")
+	}
+	if len(name) > maxNameLength {
+		return nil, appErrors.InvalidArgument("name cannot exceed %d characters", maxNameLength)
+	}
+	if version == "" {
+		return nil, appErrors.InvalidArgument("version cannot be empty")
+	}
+	if len(version) > maxVersionLength {
+		return nil, appErrors.InvalidArgument("version cannot exceed %d characters", maxVersionLength)
+	}
+	a := &Artifact{Name: name, Version: version}
+
+	var meta *solcMetadata
+	if strings.TrimSpace(in.Metadata) != "" {
+		meta = new(solcMetadata)
+		if err := json.Unmarshal([]byte(in.Metadata), meta); err != nil {
+			return nil, appErrors.InvalidArgument("invalid metadata: %v", err)
+		}
+		var target map[string]string
+		if raw, ok := meta.Settings["compilationTarget"]; ok {
+			if err := json.Unmarshal(raw, &target); err != nil {
+				return nil, appErrors.InvalidArgument("invalid metadata: compilationTarget: %v", err)
+			}
+		}
+		if len(target) != 1 || meta.Compiler.Version == "" {
+			return nil, appErrors.InvalidArgument("invalid metadata: compiler version and a single compilation target are required")
+		}
+		for path, contract := range target {
+			a.SourcePath, a.ContractName = path, contract
+		}
+		a.CompilerVersion = "v" + strings.TrimPrefix(meta.Compiler.Version, "v")
+		metadata, err := compactJSON(in.Metadata)
+		if err != nil {
+			return nil, appErrors.InvalidArgument("invalid metadata: %v", err)
+		}
+		a.Metadata = metadata
+	}
+
+	// The ABI of the request takes precedence over the ABI in the metadata
+	abiJSON := strings.TrimSpace(in.ABI)
+	if abiJSON == "" && meta != nil {
+		abiJSON = string(meta.Output.ABI)
+	}
+	if abiJSON == "" {
+		return nil, appErrors.InvalidArgument("abi cannot be empty")
+	}
+	if _, err := abi.JSON(strings.NewReader(abiJSON)); err != nil {
+		return nil, appErrors.InvalidArgument("invalid abi: %v", err)
+	}
+	var err error
+	if a.ABI, err = compactJSON(abiJSON); err != nil {
+		return nil, appErrors.InvalidArgument("invalid abi: %v", err)
+	}
+
+	bytecode, err := decodeBytecode(in.Bytecode, "bytecode")
+	if err != nil {
+		return nil, err
+	}
+	if len(bytecode) == 0 {
+		return nil, appErrors.InvalidArgument("bytecode cannot be empty")
+	}
+	a.Bytecode = hexutil.Encode(bytecode)
+
+	deployed, err := decodeBytecode(in.DeployedBytecode, "deployed_bytecode")
+	if err != nil {
+		return nil, err
+	}
+	if len(deployed) > 0 {
+		a.DeployedBytecode = hexutil.Encode(deployed)
+	}
+
+	if strings.TrimSpace(in.ImmutableReferences) != "" {
+		if len(deployed) == 0 {
+			return nil, appErrors.InvalidArgument("immutable_references require deployed_bytecode")
+		}
+		ranges, err := parseImmutableReferences(in.ImmutableReferences)
+		if err != nil {
+			return nil, appErrors.InvalidArgument("invalid immutable_references: %v", err)
+		}
+		for _, r := range ranges {
+			if r.Start < 0 || r.Length <= 0 || r.Start+r.Length > len(deployed) {
+				return nil, appErrors.InvalidArgument("immutable_references range %d+%d lies outside deployed_bytecode", r.Start, r.Length)
+			}
+		}
+		if a.ImmutableReferences, err = compactJSON(in.ImmutableReferences); err != nil {
+			return nil, appErrors.InvalidArgument("invalid immutable_references: %v", err)
+		}
+	}
+
+	if len(in.Sources) > 0 {
+		if meta == nil {
+			return nil, appErrors.InvalidArgument("sources require metadata")
+		}
+		for path, content := range in.Sources {
+			src, ok := meta.Sources[path]
+			if !ok {
+				return nil, appErrors.InvalidArgument("source %s is not part of the metadata", path)
+			}
+			if src.Keccak256 != "" && !strings.EqualFold(src.Keccak256, crypto.Keccak256Hash([]byte(content)).Hex()) {
+				return nil, appErrors.InvalidArgument("source %s does not match its keccak256 in the metadata", path)
+			}
+		}
+		sources, err := json.Marshal(in.Sources)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode sources")
+		}
+		a.Sources = string(sources)
+	}
+	return a, nil
+}
+
+// sameContent reports whether two artifacts hold the same compiled contract
+func sameContent(a, b *Artifact) bool {
+	return a.ABI == b.ABI && a.Bytecode == b.Bytecode && a.DeployedBytecode == b.DeployedBytecode &&
+		a.ImmutableReferences == b.ImmutableReferences && a.Metadata == b.Metadata && a.Sources == b.Sources
+}
+
+// decodeBytecode decodes hex encoded bytecode with or without 0x prefix
+func decodeBytecode(s, fieldName string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	if strings.Contains(s, "__") {
+		return nil, appErrors.InvalidArgument("%s has unlinked library placeholders, link the libraries before registering", fieldName)
+	}
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		s = "0x" + s
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, appErrors.InvalidArgument("invalid %s: %v", fieldName, err)
+	}
+	return b, nil
+}
+
+// parseImmutableReferences parses solc immutableReferences into ranges ordered by start
+func parseImmutableReferences(s string) ([]codeRange, error) {
+	var refs map[string][]codeRange
+	if err := json.Unmarshal([]byte(s), &refs); err != nil {
+		return nil, err
+	}
+	var ranges []codeRange
+	for _, rs := range refs {
+		ranges = append(ranges, rs...)
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+	return ranges, nil
+}
+
+// compactJSON removes insignificant whitespace from a JSON document
+func compactJSON(s string) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(s)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
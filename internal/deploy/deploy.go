@@ -0,0 +1,196 @@
+// Package deploy deploys arbitrary contracts from registered compiler artifacts.
+// An artifact holds the ABI, creation and runtime bytecode and solc metadata of a
+// contract under a name and version. Deployments use CREATE or a CREATE2 factory, are
+// recorded until their receipt is in and the code at the contract address is checked
+// against the runtime bytecode, and yield standard JSON input bundles for verifying the
+// source on Etherscan-like explorers.
+package deploy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/provider/db"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultWaitTimeout is how long DeployContract waits for the receipt when none is configured
+	defaultWaitTimeout = 20 * time.Second
+)
+
+// DefaultCreate2Factory is the deterministic deployment proxy, which exists at the same
+// address on most chains and takes the salt followed by the init code as calldata
+var DefaultCreate2Factory = common.HexToAddress("0x4e59b44847b379578588920ca78fbf26c0b4956c")
+
+// Status represents the state of a deployment
+type Status string
+
+const (
+	// StatusPending means the deployment transaction has not been mined yet
+	StatusPending Status = "pending"
+	// StatusConfirmed means the contract was created and its code checked where possible
+	StatusConfirmed Status = "confirmed"
+	// StatusCodeMismatch means the code at the contract address differs from the runtime bytecode
+	StatusCodeMismatch Status = "code_mismatch"
+	// StatusFailed means the deployment transaction reverted or was dropped
+	StatusFailed Status = "failed"
+)
+
+// Settings holds the effective deploy settings
+type Settings struct {
+	// Create2Factory is the factory used for CREATE2 deployments
+	Create2Factory common.Address
+	// WaitTimeout is how long DeployContract waits for the receipt
+	WaitTimeout time.Duration
+}
+
+var (
+	// settings stores the effective deploy settings
+	settings = defaultSettings()
+	// settingsMu guards settings, which can be replaced by a configuration reload
+	settingsMu sync.RWMutex
+	// initOnce ensures the tables are migrated only once
+	initOnce sync.Once
+)
+
+// Artifact is a compiled contract registered under a name and version.
+// Bytecode fields are hex encoded with 0x prefix.
+type Artifact struct {
+	ID                  uint      `gorm:"primaryKey" json:"id"`
+	Name                string    `gorm:"size:128;uniqueIndex:idx_deploy_artifact" json:"name"`
+	Version             string    `gorm:"size:64;uniqueIndex:idx_deploy_artifact" json:"version"`
+	ContractName        string    `gorm:"size:128" json:"contract_name"` // contract name in the source, from the metadata
+	SourcePath          string    `gorm:"size:256" json:"source_path"`   // source unit of the contract, from the metadata
+	CompilerVersion     string    `gorm:"size:64" json:"compiler_version"`
+	ABI                 string    `gorm:"type:text" json:"abi"`
+	Bytecode            string    `gorm:"type:text" json:"bytecode"`
+	DeployedBytecode    string    `gorm:"type:text" json:"deployed_bytecode"`
+	ImmutableReferences string    `gorm:"type:text" json:"immutable_references"` // solc immutableReferences JSON
+	Metadata            string    `gorm:"type:text" json:"metadata"`             // solc metadata JSON
+	Sources             string    `gorm:"type:text" json:"sources"`              // JSON map of source path to content
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for artifacts
+func (Artifact) TableName() string {
+	return "deploy_artifacts"
+}
+
+// Deployment is a contract deployment from an artifact
+type Deployment struct {
+	ID              string    `gorm:"primaryKey;size:36" json:"id"`
+	ArtifactName    string    `gorm:"size:128;index:idx_deploy_deployment_artifact" json:"artifact_name"`
+	ArtifactVersion string    `gorm:"size:64;index:idx_deploy_deployment_artifact" json:"artifact_version"`
+	Address         string    `gorm:"size:42;index" json:"address"`
+	Deployer        string    `gorm:"size:42" json:"deployer"`
+	TxHash          string    `gorm:"size:66;index" json:"tx_hash"`      // empty until the hash of the sent transaction is known
+	ConstructorArgs string    `gorm:"type:text" json:"constructor_args"` // hex encoded ABI encoding of the constructor arguments
+	Salt            string    `gorm:"size:66" json:"salt"`               // CREATE2 salt, empty for CREATE
+	Factory         string    `gorm:"size:42" json:"factory"`            // CREATE2 factory, empty for CREATE
+	Status          Status    `gorm:"size:16;index" json:"status"`
+	CodeChecked     bool      `json:"code_checked"` // the code was compared with the runtime bytecode of the artifact
+	BlockNumber     uint64    `json:"block_number"`
+	GasUsed         uint64    `json:"gas_used"`
+	Error           string    `gorm:"type:text" json:"error"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for deployments
+func (Deployment) TableName() string {
+	return "deploy_deployments"
+}
+
+// Init applies the deploy settings and migrates the artifact and deployment tables.
+//
+// Parameters:
+//   - ctx: Context for the migration
+//   - cfg: Deploy configuration (optional)
+//   - logger: Logger instance for deploy logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid or the migration fails
+func Init(ctx context.Context, cfg *conf.Deploy, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		s, err := newSettings(cfg)
+		if err != nil {
+			initErr = err
+			return
+		}
+		setSettings(s)
+
+		if err := db.Get().WithContext(ctx).AutoMigrate(&Artifact{}, &Deployment{}); err != nil {
+			initErr = errors.Wrap(err, "failed to migrate deploy tables")
+			return
+		}
+
+		log.NewHelper(logger).Infof("deploy tables initialized: create2_factory=%s, wait_timeout=%v",
+			s.Create2Factory.Hex(), s.WaitTimeout)
+	})
+	return initErr
+}
+
+// Validate checks a deploy configuration without applying it
+func Validate(cfg *conf.Deploy) error {
+	_, err := newSettings(cfg)
+	return err
+}
+
+// Reload validates and applies a changed deploy configuration
+func Reload(cfg *conf.Deploy) error {
+	s, err := newSettings(cfg)
+	if err != nil {
+		return err
+	}
+	setSettings(s)
+	return nil
+}
+
+// GetSettings returns the effective deploy settings
+func GetSettings() Settings {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return settings
+}
+
+// setSettings replaces the effective deploy settings
+func setSettings(s Settings) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	settings = s
+}
+
+// defaultSettings returns the deploy settings used without configuration
+func defaultSettings() Settings {
+	return Settings{
+		Create2Factory: DefaultCreate2Factory,
+		WaitTimeout:    defaultWaitTimeout,
+	}
+}
+
+// newSettings validates a deploy configuration and returns its effective settings
+func newSettings(cfg *conf.Deploy) (Settings, error) {
+	s := defaultSettings()
+	if factory := cfg.GetCreate2Factory(); factory != "" {
+		if !common.IsHexAddress(factory) {
+			return Settings{}, errors.Errorf("deploy.create2_factory is not a valid address: %s", factory)
+		}
+		s.Create2Factory = common.HexToAddress(factory)
+	}
+	if d := cfg.GetWaitTimeout(); d != nil {
+		if d.AsDuration() < 0 {
+			return Settings{}, errors.New("deploy.wait_timeout cannot be negative")
+		}
+		if d.AsDuration() > 0 {
+			s.WaitTimeout = d.AsDuration()
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,300 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/internal/txmanager"
+	"eth-contract-service/provider/db"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// waitPollInterval is how often Wait checks a pending deployment
+const waitPollInterval = time.Second
+
+// Plan is the deployment of an artifact with its constructor arguments encoded
+type Plan struct {
+	// Artifact is the artifact to deploy
+	Artifact *Artifact
+	// ABI is the parsed ABI of the artifact
+	ABI abi.ABI
+	// Bytecode is the creation bytecode of the artifact
+	Bytecode []byte
+	// Args are the constructor arguments in input order
+	Args []interface{}
+	// EncodedArgs is the ABI encoding of the constructor arguments
+	EncodedArgs []byte
+	// InitCode is the creation bytecode followed by the encoded arguments
+	InitCode []byte
+}
+
+// DeploymentFilter restricts the deployments returned by ListDeployments
+type DeploymentFilter struct {
+	ArtifactName string
+	Address      string
+	Status       Status
+	Limit        int
+	Offset       int
+}
+
+// NewPlan resolves an artifact and encodes its constructor arguments.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - name: Artifact name
+//   - version: Artifact version (empty for the most recently registered version)
+//   - args: Constructor arguments as JSON, see ParseArgs
+//
+// Returns:
+//   - *Plan: The deployment plan
+//   - error: Error if the artifact does not exist or the arguments do not fit the constructor
+func NewPlan(ctx context.Context, name, version, args string) (*Plan, error) {
+	a, err := GetArtifact(ctx, name, version)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := a.ParsedABI()
+	if err != nil {
+		return nil, err
+	}
+	values, err := ParseArgs(parsed.Constructor.Inputs, args)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := parsed.Constructor.Inputs.Pack(values...)
+	if err != nil {
+		return nil, appErrors.InvalidArgument("invalid constructor_args: %v", err)
+	}
+	bytecode, err := hexutil.Decode(a.Bytecode)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode artifact bytecode")
+	}
+
+	return &Plan{
+		Artifact:    a,
+		ABI:         parsed,
+		Bytecode:    bytecode,
+		Args:        values,
+		EncodedArgs: encoded,
+		InitCode:    append(append([]byte{}, bytecode...), encoded...),
+	}, nil
+}
+
+// Create2Address returns the address the factory creates the contract at with the salt
+func (p *Plan) Create2Address(factory common.Address, salt common.Hash) common.Address {
+	return crypto.CreateAddress2(factory, salt, crypto.Keccak256(p.InitCode))
+}
+
+// Create2Calldata returns the factory calldata: the salt followed by the init code
+func (p *Plan) Create2Calldata(salt common.Hash) []byte {
+	return append(salt.Bytes(), p.InitCode...)
+}
+
+// ParseSalt parses a hex encoded CREATE2 salt of up to 32 bytes, left padded with zeros
+func ParseSalt(s string) (common.Hash, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return common.Hash{}, nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, appErrors.InvalidArgument("invalid salt: %v", err)
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, appErrors.InvalidArgument("salt cannot exceed %d bytes", common.HashLength)
+	}
+	return common.BytesToHash(b), nil
+}
+
+// Record stores a new pending deployment
+func Record(ctx context.Context, d *Deployment) error {
+	d.ID = uuid.NewString()
+	d.Status = StatusPending
+	if err := db.Get().WithContext(ctx).Create(d).Error; err != nil {
+		return errors.Wrap(err, "failed to record deployment")
+	}
+	return nil
+}
+
+// GetDeployment returns a deployment
+func GetDeployment(ctx context.Context, id string) (*Deployment, error) {
+	var d Deployment
+	if err := db.Get().WithContext(ctx).Where("id = ?", id).Limit(1).Find(&d).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to get deployment")
+	}
+	if d.ID == "" {
+		return nil, appErrors.ErrDeploymentNotFound
+	}
+	return &d, nil
+}
+
+// ListDeployments returns deployments matching the filter, newest first, and the total count
+func ListDeployments(ctx context.Context, filter DeploymentFilter) ([]*Deployment, int64, error) {
+	q := db.Get().WithContext(ctx).Model(&Deployment{})
+	if filter.ArtifactName != "" {
+		q = q.Where("artifact_name = ?", filter.ArtifactName)
+	}
+	if filter.Address != "" {
+		q = q.Where("address = ?", filter.Address)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count deployments")
+	}
+	var deployments []*Deployment
+	if err := q.Order("created_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&deployments).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list deployments")
+	}
+	return deployments, total, nil
+}
+
+// Refresh checks a pending deployment once and stores its outcome. The transaction is
+// resolved across fee replacements; deployments without a known transaction hash, such
+// as those signed externally, are confirmed once code shows up at the contract address.
+// Once created, the code at the contract address is compared with the runtime bytecode of
+// the artifact, ignoring the ranges holding immutables.
+//
+// Parameters:
+//   - ctx: Context for node queries and database operations
+//   - d: The deployment, updated in place
+//
+// Returns:
+//   - error: Error if the node cannot be queried or the outcome cannot be stored
+func Refresh(ctx context.Context, d *Deployment) error {
+	if d.Status != StatusPending {
+		return nil
+	}
+	client := eth.GetClient()
+	if client == nil {
+		return appErrors.ErrClientNotInitialized
+	}
+
+	mined := false
+	if d.TxHash != "" {
+		res, err := txmanager.Resolve(ctx, common.HexToHash(d.TxHash))
+		switch {
+		case errors.Is(err, appErrors.ErrTransactionNotFound):
+			// Not broadcast yet, e.g. by a job worker
+		case err != nil:
+			return err
+		case res.Status == txmanager.StatusPending:
+			return nil
+		case res.Status == txmanager.StatusConfirmed:
+			mined = true
+			d.TxHash = res.MinedHash.Hex()
+			d.BlockNumber = res.Receipt.BlockNumber.Uint64()
+			d.GasUsed = res.Receipt.GasUsed
+		default:
+			if res.Receipt != nil {
+				d.TxHash = res.MinedHash.Hex()
+				d.BlockNumber = res.Receipt.BlockNumber.Uint64()
+				d.GasUsed = res.Receipt.GasUsed
+			}
+			reason := "deployment transaction " + string(res.Status)
+			if res.Status == txmanager.StatusFailed {
+				reason = "deployment transaction reverted"
+			}
+			return finish(ctx, d, StatusFailed, reason)
+		}
+	}
+
+	code, err := client.CodeAt(ctx, common.HexToAddress(d.Address), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get contract code")
+	}
+	if len(code) == 0 {
+		if mined {
+			return finish(ctx, d, StatusFailed, "no code at the contract address after the deployment transaction was mined")
+		}
+		return nil
+	}
+
+	a, err := GetArtifact(ctx, d.ArtifactName, d.ArtifactVersion)
+	if err != nil {
+		return err
+	}
+	if a.DeployedBytecode == "" {
+		return finish(ctx, d, StatusConfirmed, "")
+	}
+	d.CodeChecked = true
+	match, err := codeMatches(a, code)
+	if err != nil {
+		return err
+	}
+	if !match {
+		return finish(ctx, d, StatusCodeMismatch, "code at the contract address differs from the deployed bytecode of the artifact")
+	}
+	return finish(ctx, d, StatusConfirmed, "")
+}
+
+// Wait refreshes a pending deployment until it is no longer pending or the context is done.
+// Node errors are retried, since receipts may be unavailable for a moment after mining; the
+// last one is returned if the deployment is still pending when the context is done.
+func Wait(ctx context.Context, d *Deployment) error {
+	ticker := time.NewTicker(waitPollInterval)
+	defer ticker.Stop()
+	var lastErr error
+	for {
+		lastErr = Refresh(ctx, d)
+		if d.Status != StatusPending {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return lastErr
+		case <-ticker.C:
+		}
+	}
+}
+
+// finish stores the outcome of a deployment
+func finish(ctx context.Context, d *Deployment, status Status, reason string) error {
+	d.Status = status
+	d.Error = reason
+	if err := db.Get().WithContext(ctx).Save(d).Error; err != nil {
+		return errors.Wrap(err, "failed to update deployment")
+	}
+	return nil
+}
+
+// codeMatches compares on-chain code with the runtime bytecode of an artifact. Bytes in
+// the immutable ranges are filled in by the constructor and not compared.
+func codeMatches(a *Artifact, code []byte) (bool, error) {
+	expected, err := hexutil.Decode(a.DeployedBytecode)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode artifact deployed bytecode")
+	}
+	if len(expected) != len(code) {
+		return false, nil
+	}
+	if a.ImmutableReferences == "" {
+		return bytes.Equal(expected, code), nil
+	}
+
+	var ranges []codeRange
+	if ranges, err = parseImmutableReferences(a.ImmutableReferences); err != nil {
+		return false, errors.Wrap(err, "failed to parse artifact immutable references")
+	}
+	offset := 0
+	for _, r := range ranges {
+		if r.Start > offset && !bytes.Equal(expected[offset:r.Start], code[offset:r.Start]) {
+			return false, nil
+		}
+		offset = max(offset, r.Start+r.Length)
+	}
+	return bytes.Equal(expected[offset:], code[offset:]), nil
+}
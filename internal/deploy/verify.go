@@ -0,0 +1,169 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/eth"
+
+	"github.com/pkg/errors"
+)
+
+// CodeFormatStandardJSON is the Etherscan code format of standard JSON input
+const CodeFormatStandardJSON = "solidity-standard-json-input"
+
+// outputSelection makes the standard JSON input compile to the artifacts solc emitted
+var outputSelection = map[string]interface{}{
+	"*": map[string]interface{}{
+		"*": []string{"abi", "evm.bytecode", "evm.deployedBytecode", "metadata"},
+	},
+}
+
+// Bundle holds the fields of a source verification request to an Etherscan-like explorer
+type Bundle struct {
+	// Address is the contract address
+	Address string
+	// ChainID is the chain the contract is deployed on
+	ChainID string
+	// CodeFormat is the format of StandardJSONInput
+	CodeFormat string
+	// ContractName is the fully qualified contract name, e.g. src/Token.sol:Token
+	ContractName string
+	// CompilerVersion is the solc version, e.g. v0.8.24+commit.e11b9ed9
+	CompilerVersion string
+	// ConstructorArguments is the hex encoded ABI encoding of the constructor arguments without 0x prefix
+	ConstructorArguments string
+	// StandardJSONInput is the solc standard JSON input that reproduces the bytecode
+	StandardJSONInput string
+	// OptimizationUsed and Runs are the optimizer settings
+	OptimizationUsed bool
+	Runs             uint64
+	// EVMVersion is the target EVM version (empty for the compiler default)
+	EVMVersion string
+}
+
+// VerificationBundle builds the source verification request of a confirmed deployment.
+// The standard JSON input is rebuilt from the solc metadata of the artifact; source
+// contents come from the metadata or from the sources registered with the artifact.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - d: A confirmed deployment
+//
+// Returns:
+//   - *Bundle: The verification request fields
+//   - error: Error if the deployment is not confirmed or the artifact lacks metadata or sources
+func VerificationBundle(ctx context.Context, d *Deployment) (*Bundle, error) {
+	if d.Status != StatusConfirmed {
+		return nil, appErrors.FailedPrecondition("deployment is %s, only confirmed deployments can be verified", d.Status)
+	}
+	a, err := GetArtifact(ctx, d.ArtifactName, d.ArtifactVersion)
+	if err != nil {
+		return nil, err
+	}
+	if a.Metadata == "" {
+		return nil, appErrors.FailedPrecondition("artifact %s@%s has no compiler metadata", a.Name, a.Version)
+	}
+	var meta solcMetadata
+	if err := json.Unmarshal([]byte(a.Metadata), &meta); err != nil {
+		return nil, errors.Wrap(err, "failed to parse artifact metadata")
+	}
+	if meta.Language != "Solidity" {
+		return nil, appErrors.FailedPrecondition("verification bundles are only built for Solidity, artifact language is %s", meta.Language)
+	}
+
+	registered := make(map[string]string)
+	if a.Sources != "" {
+		if err := json.Unmarshal([]byte(a.Sources), &registered); err != nil {
+			return nil, errors.Wrap(err, "failed to parse artifact sources")
+		}
+	}
+	sources := make(map[string]interface{}, len(meta.Sources))
+	for path, src := range meta.Sources {
+		content, ok := registered[path]
+		if src.Content != nil {
+			content, ok = *src.Content, true
+		}
+		if !ok {
+			return nil, appErrors.FailedPrecondition("source content of %s is missing, register the artifact with its sources", path)
+		}
+		sources[path] = map[string]string{"content": content}
+	}
+
+	settings, bundle, err := standardSettings(meta.Settings)
+	if err != nil {
+		return nil, err
+	}
+	input, err := json.Marshal(map[string]interface{}{
+		"language": meta.Language,
+		"sources":  sources,
+		"settings": settings,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode standard JSON input")
+	}
+
+	bundle.Address = d.Address
+	if chainID := eth.GetChainID(); chainID != nil {
+		bundle.ChainID = chainID.String()
+	}
+	bundle.CodeFormat = CodeFormatStandardJSON
+	bundle.ContractName = a.SourcePath + ":" + a.ContractName
+	bundle.CompilerVersion = a.CompilerVersion
+	bundle.ConstructorArguments = strings.TrimPrefix(d.ConstructorArgs, "0x")
+	bundle.StandardJSONInput = string(input)
+	return bundle, nil
+}
+
+// standardSettings converts the settings of solc metadata to standard JSON input settings
+// and returns them with the optimizer and EVM version fields of the bundle filled in.
+// The metadata names the compilation target, which standard JSON input does not take,
+// and keys libraries by fully qualified name instead of by source unit.
+func standardSettings(metaSettings map[string]json.RawMessage) (map[string]interface{}, *Bundle, error) {
+	bundle := &Bundle{}
+	settings := make(map[string]interface{}, len(metaSettings)+1)
+	for key, value := range metaSettings {
+		switch key {
+		case "compilationTarget":
+			continue
+		case "libraries":
+			var libs map[string]string
+			if err := json.Unmarshal(value, &libs); err != nil {
+				return nil, nil, errors.Wrap(err, "failed to parse metadata libraries")
+			}
+			units := make(map[string]map[string]string)
+			for name, addr := range libs {
+				unit, lib := "", name
+				if i := strings.LastIndex(name, ":"); i >= 0 {
+					unit, lib = name[:i], name[i+1:]
+				}
+				if units[unit] == nil {
+					units[unit] = make(map[string]string)
+				}
+				units[unit][lib] = addr
+			}
+			settings[key] = units
+		case "optimizer":
+			var optimizer struct {
+				Enabled bool   `json:"enabled"`
+				Runs    uint64 `json:"runs"`
+			}
+			if err := json.Unmarshal(value, &optimizer); err != nil {
+				return nil, nil, errors.Wrap(err, "failed to parse metadata optimizer settings")
+			}
+			bundle.OptimizationUsed, bundle.Runs = optimizer.Enabled, optimizer.Runs
+			settings[key] = value
+		case "evmVersion":
+			if err := json.Unmarshal(value, &bundle.EVMVersion); err != nil {
+				return nil, nil, errors.Wrap(err, "failed to parse metadata EVM version")
+			}
+			settings[key] = value
+		default:
+			settings[key] = value
+		}
+	}
+	settings["outputSelection"] = outputSelection
+	return settings, bundle, nil
+}
@@ -0,0 +1,288 @@
+// Package detect identifies the token standard and optional extensions of a contract.
+// It probes ERC165 interface IDs, the presence of ERC20 methods and the Ownable and
+// Pausable getters, and caches the result per address.
+package detect
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// Standard is a detected token standard
+type Standard string
+
+const (
+	// StandardERC20 is a fungible ERC20 token
+	StandardERC20 Standard = "erc20"
+	// StandardERC721 is an ERC721 non-fungible token
+	StandardERC721 Standard = "erc721"
+	// StandardERC1155 is an ERC1155 multi token
+	StandardERC1155 Standard = "erc1155"
+	// StandardUnknown is any other contract
+	StandardUnknown Standard = "unknown"
+)
+
+// revertErrorCode is the JSON-RPC error code of a reverted call
+const revertErrorCode = 3
+
+// executionErrors are node error messages of a call that failed while executing
+var executionErrors = []string{"revert", "invalid opcode", "invalid jump", "out of gas", "stack underflow", "stack limit"}
+
+// cacheTTL is how long a detection result is reused.
+// Capabilities rarely change, but the owner and proxy implementations can.
+const cacheTTL = 10 * time.Minute
+
+// Interface is an ERC165 interface ID probed during detection
+type Interface struct {
+	Name string
+	ID   [4]byte
+}
+
+// Interfaces are the ERC165 interfaces probed for every contract
+var Interfaces = []Interface{
+	{Name: "ERC721", ID: [4]byte{0x80, 0xac, 0x58, 0xcd}},
+	{Name: "ERC721Metadata", ID: [4]byte{0x5b, 0x5e, 0x13, 0x9f}},
+	{Name: "ERC721Enumerable", ID: [4]byte{0x78, 0x0e, 0x9d, 0x63}},
+	{Name: "ERC1155", ID: [4]byte{0xd9, 0xb6, 0x7a, 0x26}},
+	{Name: "ERC1155MetadataURI", ID: [4]byte{0x0e, 0x89, 0x34, 0x1c}},
+	{Name: "ERC2981", ID: [4]byte{0x2a, 0x55, 0x20, 0x5a}},
+}
+
+var (
+	// erc165ID is the ERC165 interface ID itself
+	erc165ID = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	// invalidID must be rejected by a conforming ERC165 implementation
+	invalidID = [4]byte{0xff, 0xff, 0xff, 0xff}
+
+	selectorSupportsInterface = selector("supportsInterface(bytes4)")
+	selectorTotalSupply       = selector("totalSupply()")
+	selectorBalanceOf         = selector("balanceOf(address)")
+	selectorDecimals          = selector("decimals()")
+	selectorOwner             = selector("owner()")
+	selectorPaused            = selector("paused()")
+)
+
+// Result describes the capabilities of a contract
+type Result struct {
+	// Address is the inspected address
+	Address common.Address
+	// IsContract reports whether code is deployed at the address
+	IsContract bool
+	// Standard is the detected token standard
+	Standard Standard
+	// ERC165 reports whether the contract implements ERC165
+	ERC165 bool
+	// Interfaces maps interface names from Interfaces to their support
+	Interfaces map[string]bool
+	// ERC20 reports whether totalSupply, balanceOf and decimals respond
+	ERC20 bool
+	// Ownable reports whether owner() responds
+	Ownable bool
+	// Pausable reports whether paused() responds
+	Pausable bool
+	// Owner is the current owner when Ownable
+	Owner common.Address
+	// DetectedAt is when the contract was probed
+	DetectedAt time.Time
+	// Cached reports whether the result was served from the cache
+	Cached bool
+}
+
+// Supports reports whether the named ERC165 interface is supported
+func (r *Result) Supports(name string) bool {
+	return r.Interfaces[name]
+}
+
+var (
+	// cache stores detection results per address
+	cache   = make(map[common.Address]Result)
+	cacheMu sync.Mutex
+)
+
+// Detect probes a contract and returns its capabilities.
+// Results are cached per address; refresh probes the contract again. A probe that
+// failed on a node error returns the error and is not cached.
+//
+// Parameters:
+//   - ctx: Context for the node queries
+//   - addr: Contract address
+//   - refresh: Ignore a cached result
+//
+// Returns:
+//   - *Result: The detected capabilities
+//   - error: Error if the node cannot be queried
+func Detect(ctx context.Context, addr common.Address, refresh bool) (*Result, error) {
+	if !refresh {
+		cacheMu.Lock()
+		res, ok := cache[addr]
+		cacheMu.Unlock()
+		if ok && time.Since(res.DetectedAt) < cacheTTL {
+			res.Cached = true
+			return &res, nil
+		}
+	}
+
+	res, err := probe(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheMu.Lock()
+	cache[addr] = *res
+	cacheMu.Unlock()
+	return res, nil
+}
+
+// probe queries the node for the capabilities of a contract
+func probe(ctx context.Context, addr common.Address) (*Result, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.New("Ethereum client not initialized")
+	}
+
+	res := &Result{
+		Address:    addr,
+		Standard:   StandardUnknown,
+		Interfaces: make(map[string]bool, len(Interfaces)),
+		DetectedAt: time.Now(),
+	}
+
+	code, err := client.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get contract code")
+	}
+	if len(code) == 0 {
+		return res, nil
+	}
+	res.IsContract = true
+
+	// ERC165 requires true for its own ID and false for 0xffffffff
+	if res.ERC165, err = supportsInterface(ctx, addr, erc165ID); err != nil {
+		return nil, err
+	}
+	if res.ERC165 {
+		invalid, err := supportsInterface(ctx, addr, invalidID)
+		if err != nil {
+			return nil, err
+		}
+		res.ERC165 = !invalid
+	}
+	if res.ERC165 {
+		for _, iface := range Interfaces {
+			if res.Interfaces[iface.Name], err = supportsInterface(ctx, addr, iface.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res.ERC20 = true
+	for _, m := range []struct {
+		sel  []byte
+		args [][]byte
+	}{
+		{sel: selectorTotalSupply},
+		{sel: selectorBalanceOf, args: [][]byte{common.Address{}.Bytes()}},
+		{sel: selectorDecimals},
+	} {
+		ok, err := responds(ctx, addr, m.sel, m.args...)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			res.ERC20 = false
+			break
+		}
+	}
+
+	out, ok, err := call(ctx, addr, selectorOwner)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		res.Ownable = true
+		res.Owner = common.BytesToAddress(out[12:32])
+	}
+	if res.Pausable, err = responds(ctx, addr, selectorPaused); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.Supports("ERC1155"):
+		res.Standard = StandardERC1155
+	case res.Supports("ERC721"):
+		res.Standard = StandardERC721
+	case res.ERC20:
+		res.Standard = StandardERC20
+	}
+	return res, nil
+}
+
+// supportsInterface calls supportsInterface(bytes4) and reports a true result
+func supportsInterface(ctx context.Context, addr common.Address, id [4]byte) (bool, error) {
+	out, ok, err := call(ctx, addr, selectorSupportsInterface, common.RightPadBytes(id[:], 32))
+	if err != nil || !ok {
+		return false, err
+	}
+	return new(big.Int).SetBytes(out[:32]).Cmp(big.NewInt(1)) == 0, nil
+}
+
+// responds reports whether a view method returns at least one word
+func responds(ctx context.Context, addr common.Address, sel []byte, args ...[]byte) (bool, error) {
+	_, ok, err := call(ctx, addr, sel, args...)
+	return ok, err
+}
+
+// call performs an eth_call. Reverts and short results count as unsupported; errors
+// other than a failed execution are returned, so that they are not taken for a missing method
+func call(ctx context.Context, addr common.Address, sel []byte, args ...[]byte) ([]byte, bool, error) {
+	data := append([]byte{}, sel...)
+	for _, arg := range args {
+		data = append(data, common.LeftPadBytes(arg, 32)...)
+	}
+	out, err := eth.GetClient().CallContract(ctx, ethereum.CallMsg{To: &addr, Data: data}, nil)
+	if err != nil {
+		if isExecutionError(err) {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrap(err, "failed to call contract")
+	}
+	if len(out) < 32 {
+		return nil, false, nil
+	}
+	return out, true, nil
+}
+
+// isExecutionError reports whether the node answered a call with a failed execution,
+// such as a revert or an invalid opcode, rather than failing to serve the request
+func isExecutionError(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.ErrorCode() == revertErrorCode {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Error())
+	for _, s := range executionErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// selector returns the 4-byte function selector of a signature
+func selector(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
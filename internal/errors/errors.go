@@ -3,10 +3,15 @@ package errors
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	pkgErrors "github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // Error codes for different error types
@@ -16,6 +21,22 @@ const (
 	CodeInternal        = codes.Internal
 	CodeUnauthenticated = codes.Unauthenticated
 	CodeUnavailable     = codes.Unavailable
+
+	CodeFailedPrecondition = codes.FailedPrecondition
+	CodePermissionDenied   = codes.PermissionDenied
+	CodeResourceExhausted  = codes.ResourceExhausted
+)
+
+const (
+	// ErrorDomain is the domain of the error details attached to retryable errors
+	ErrorDomain = "eth-contract-service"
+	// ReasonNodeUnavailable is the error reason while the Ethereum node is unreachable
+	ReasonNodeUnavailable = "ETHEREUM_NODE_UNAVAILABLE"
+	// MetadataRetryAfter is the error metadata key holding the retry delay in seconds
+	MetadataRetryAfter = "retry_after_seconds"
+
+	// defaultRetryAfter is the retry delay suggested while the Ethereum node is unreachable
+	defaultRetryAfter = 5 * time.Second
 )
 
 var (
@@ -25,8 +46,8 @@ var (
 	// ErrContractNotFound indicates that the contract address is not found or invalid
 	ErrContractNotFound = NewError(CodeNotFound, "contract not found")
 
-	// ErrClientNotInitialized indicates that the Ethereum client is not initialized
-	ErrClientNotInitialized = NewError(CodeInternal, "ethereum client not initialized")
+	// ErrClientNotInitialized indicates that the Ethereum client is not connected to the node
+	ErrClientNotInitialized = NodeUnavailable(defaultRetryAfter, nil)
 
 	// ErrChainIDNotConfigured indicates that the chain ID is not configured
 	ErrChainIDNotConfigured = NewError(CodeInternal, "chain ID not configured")
@@ -42,6 +63,114 @@ var (
 
 	// ErrInvalidAmount indicates that an amount is invalid
 	ErrInvalidAmount = NewError(CodeInvalidArgument, "invalid amount")
+
+	// ErrJobsDisabled indicates that asynchronous job processing is not enabled
+	ErrJobsDisabled = NewError(CodeUnavailable, "asynchronous jobs are not enabled")
+
+	// ErrJobNotFound indicates that the requested job does not exist
+	ErrJobNotFound = NewError(CodeNotFound, "job not found")
+
+	// ErrJobNotCancellable indicates that the job has already been picked up by a worker
+	ErrJobNotCancellable = NewError(CodeFailedPrecondition, "job can no longer be cancelled")
+
+	// ErrTransactionNotFound indicates that the transaction is unknown to the node and the service
+	ErrTransactionNotFound = NewError(CodeNotFound, "transaction not found")
+
+	// ErrTransactionMined indicates that the transaction nonce has already been used on chain
+	ErrTransactionMined = NewError(CodeFailedPrecondition, "transaction already mined")
+
+	// ErrFeeCapReached indicates that the bumped fees would exceed the configured fee caps
+	ErrFeeCapReached = NewError(CodeFailedPrecondition, "fee cap reached, transaction cannot be replaced")
+
+	// ErrBatchNotFound indicates that the batch to resume does not exist
+	ErrBatchNotFound = NewError(CodeNotFound, "batch not found")
+
+	// ErrSenderMismatch indicates that the private key does not belong to the transaction sender
+	ErrSenderMismatch = NewError(CodeInvalidArgument, "private key does not match transaction sender")
+
+	// ErrPermitDomainMismatch indicates that the computed EIP-712 domain does not match DOMAIN_SEPARATOR()
+	ErrPermitDomainMismatch = NewError(CodeFailedPrecondition, "EIP-712 domain does not match DOMAIN_SEPARATOR, specify the domain version")
+
+	// ErrPermitInvalidSignature indicates that a permit signature is malformed or not signed by the owner
+	ErrPermitInvalidSignature = NewError(CodeInvalidArgument, "invalid permit signature")
+
+	// ErrPermitExpired indicates that the permit deadline has passed
+	ErrPermitExpired = NewError(CodeInvalidArgument, "permit deadline has passed")
+
+	// ErrSigningNotAllowed indicates that a signing policy rejected the payload
+	ErrSigningNotAllowed = NewError(CodePermissionDenied, "signing not allowed by policy")
+
+	// ErrSignerNotFound indicates that the requested signer is not available in the keystore
+	ErrSignerNotFound = NewError(CodeFailedPrecondition, "signer not found")
+
+	// ErrInvalidSignature indicates that a signature is malformed or cannot be recovered
+	ErrInvalidSignature = NewError(CodeInvalidArgument, "invalid signature")
+
+	// ErrRelayerDisabled indicates that no ERC-2771 forwarder is configured
+	ErrRelayerDisabled = NewError(CodeUnavailable, "relayer is not enabled")
+
+	// ErrRelayQuotaExceeded indicates that the sender used up its daily relay quota
+	ErrRelayQuotaExceeded = NewError(CodeResourceExhausted, "daily relay quota exceeded")
+
+	// ErrRelayTargetNotAllowed indicates that the forward request calls a contract that is not allow-listed
+	ErrRelayTargetNotAllowed = NewError(CodePermissionDenied, "relay target not allowed")
+
+	// ErrRelayNonceMismatch indicates that the forward request nonce is not the next forwarder nonce of the sender
+	ErrRelayNonceMismatch = NewError(CodeFailedPrecondition, "forward request nonce does not match forwarder nonce")
+
+	// ErrRelayReverted indicates that the forwarded call reverts in simulation
+	ErrRelayReverted = NewError(CodeFailedPrecondition, "forwarded call reverted")
+
+	// ErrSafeProposalNotFound indicates that the Safe proposal does not exist
+	ErrSafeProposalNotFound = NewError(CodeNotFound, "safe proposal not found")
+
+	// ErrSafeProposalClosed indicates that the Safe proposal was already submitted, executed or rejected
+	ErrSafeProposalClosed = NewError(CodeFailedPrecondition, "safe proposal is no longer pending")
+
+	// ErrSafeHashMismatch indicates that the computed safeTxHash does not match getTransactionHash()
+	ErrSafeHashMismatch = NewError(CodeFailedPrecondition, "safeTxHash does not match the Safe contract, Safe v1.3.0 or later is required")
+
+	// ErrHDWalletDisabled indicates that no HD wallet is loaded
+	ErrHDWalletDisabled = NewError(CodeUnavailable, "HD wallet is not enabled")
+
+	// ErrKeysDisabled indicates that no managed keystore directory is configured
+	ErrKeysDisabled = NewError(CodeUnavailable, "key management is not enabled")
+
+	// ErrKeyNotFound indicates that the managed key does not exist
+	ErrKeyNotFound = NewError(CodeNotFound, "key not found")
+
+	// ErrKeyExists indicates that the key is already stored in the managed keystore
+	ErrKeyExists = NewError(CodeFailedPrecondition, "key already exists")
+
+	// ErrKeyDisabled indicates that the managed key was disabled and cannot sign
+	ErrKeyDisabled = NewError(CodeFailedPrecondition, "key is disabled")
+
+	// ErrKeyLocked indicates that the managed key must be unlocked before it can sign
+	ErrKeyLocked = NewError(CodeFailedPrecondition, "key is locked")
+
+	// ErrInvalidKeyPassword indicates that the key could not be decrypted with the given password
+	ErrInvalidKeyPassword = NewError(CodePermissionDenied, "invalid key password")
+
+	// ErrTreasuryDisabled indicates that signer balance monitoring is not enabled
+	ErrTreasuryDisabled = NewError(CodeUnavailable, "treasury monitor is not enabled")
+
+	// ErrInsufficientBalance indicates that a native balance does not cover the transaction fee
+	ErrInsufficientBalance = NewError(CodeFailedPrecondition, "balance does not cover the transaction fee")
+
+	// ErrAdminSigningDisabled indicates that use_admin was set while admin.allow_use_admin is off
+	ErrAdminSigningDisabled = NewError(CodePermissionDenied, "signing with the admin key is disabled")
+
+	// ErrAdminUnauthenticated indicates that a request signed with a server-held key carried no valid admin API token
+	ErrAdminUnauthenticated = NewError(CodeUnauthenticated, "a valid admin API token is required")
+
+	// ErrArtifactNotFound indicates that no contract artifact is registered under the name and version
+	ErrArtifactNotFound = NewError(CodeNotFound, "artifact not found")
+
+	// ErrArtifactExists indicates that the artifact version is already registered with different content
+	ErrArtifactExists = NewError(CodeFailedPrecondition, "artifact version already registered with different content")
+
+	// ErrDeploymentNotFound indicates that the deployment does not exist
+	ErrDeploymentNotFound = NewError(CodeNotFound, "deployment not found")
 )
 
 // AppError represents an application error with a gRPC status code
@@ -49,6 +178,10 @@ type AppError struct {
 	Code    codes.Code
 	Message string
 	Err     error
+	// Reason identifies a retryable error in the status details (optional)
+	Reason string
+	// RetryAfter is the delay after which the request may be retried (optional)
+	RetryAfter time.Duration
 }
 
 // Error implements the error interface
@@ -64,9 +197,28 @@ func (e *AppError) Unwrap() error {
 	return e.Err
 }
 
-// GRPCStatus returns the gRPC status for this error
+// GRPCStatus returns the gRPC status for this error.
+// A retryable error carries an ErrorInfo with the retry delay in its metadata, which
+// the HTTP transport exposes as reason and metadata, and a RetryInfo for gRPC clients.
 func (e *AppError) GRPCStatus() *status.Status {
-	return status.New(e.Code, e.Error())
+	st := status.New(e.Code, e.Error())
+	if e.Reason == "" && e.RetryAfter <= 0 {
+		return st
+	}
+
+	info := &errdetails.ErrorInfo{Reason: e.Reason, Domain: ErrorDomain}
+	details := []protoadapt.MessageV1{info}
+	if e.RetryAfter > 0 {
+		info.Metadata = map[string]string{
+			MetadataRetryAfter: strconv.FormatInt(int64(e.RetryAfter.Round(time.Second)/time.Second), 10),
+		}
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)})
+	}
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
 }
 
 // NewError creates a new application error
@@ -120,6 +272,36 @@ func InvalidArgument(format string, args ...interface{}) *AppError {
 	}
 }
 
+// NodeUnavailable returns an unavailable error for requests that need the Ethereum node
+// while it is unreachable.
+//
+// Parameters:
+//   - retryAfter: Delay until the next reconnection attempt
+//   - cause: Why the node is unreachable (optional)
+//
+// Returns:
+//   - *AppError: Unavailable error carrying the retry delay
+func NodeUnavailable(retryAfter time.Duration, cause error) *AppError {
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	return &AppError{
+		Code:       CodeUnavailable,
+		Message:    "ethereum node unavailable",
+		Err:        cause,
+		Reason:     ReasonNodeUnavailable,
+		RetryAfter: retryAfter,
+	}
+}
+
+// FailedPrecondition returns a failed precondition error
+func FailedPrecondition(format string, args ...interface{}) *AppError {
+	return &AppError{
+		Code:    CodeFailedPrecondition,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
 // InternalError returns an internal error
 func InternalError(format string, args ...interface{}) *AppError {
 	return &AppError{
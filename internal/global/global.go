@@ -5,11 +5,26 @@ package global
 import (
 	"context"
 
+	"eth-contract-service/internal/batch"
 	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/deploy"
+	"eth-contract-service/internal/health"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/keys"
+	"eth-contract-service/internal/metadata"
+	"eth-contract-service/internal/portfolio"
+	"eth-contract-service/internal/relayer"
+	"eth-contract-service/internal/safe"
+	"eth-contract-service/internal/signing"
+	"eth-contract-service/internal/treasury"
+	"eth-contract-service/internal/txmanager"
+	"eth-contract-service/internal/wallet"
 	"eth-contract-service/provider/cache"
 	"eth-contract-service/provider/db"
 	"eth-contract-service/provider/eth"
 	"eth-contract-service/provider/keystore"
+	"eth-contract-service/provider/metrics"
+	"eth-contract-service/provider/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -28,7 +43,24 @@ var (
 //
 // The function will panic if critical initialization steps fail:
 //   - Bootstrap configuration is nil
+//   - Tracing configuration is invalid or its exporter cannot be created
+//   - Metrics exporter or instruments cannot be created
 //   - Database initialization fails
+//   - Admin access configuration is invalid
+//   - A configured signer cannot be loaded
+//   - HD account table cannot be migrated
+//   - Managed key tables cannot be migrated
+//   - Transaction manager configuration is invalid
+//   - Batch configuration is invalid
+//   - Metadata configuration is invalid
+//   - Signing policy configuration is invalid
+//   - Relayer configuration is invalid
+//   - Safe proposal tables cannot be migrated
+//   - Job store initialization fails while asynchronous jobs are enabled
+//   - Treasury configuration is invalid or its top-up table cannot be migrated
+//   - Deploy configuration is invalid or its artifact and deployment tables cannot be migrated
+//   - Portfolio configuration is invalid
+//   - Health check configuration is invalid
 func Init(bc *conf.Bootstrap, logger log.Logger) {
 	if bc == nil {
 		panic("bootstrap config cannot be nil")
@@ -37,8 +69,19 @@ func Init(bc *conf.Bootstrap, logger log.Logger) {
 	Logger = log.NewHelper(logger)
 	Logger.Infof("logger initialized: %v", bc.Log)
 
+	// Initialize tracing and metrics first so that every provider reports to the exporters
+	err := tracing.Init(bc.GetTracing(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	err = metrics.Init(bc.GetMetrics(), logger)
+	if err != nil {
+		panic(err)
+	}
+
 	Logger.Infof("database initialized")
-	err := db.Init(context.Background(), bc.Data.Database, logger)
+	err = db.Init(context.Background(), bc.Data.Database, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -52,14 +95,27 @@ func Init(bc *conf.Bootstrap, logger log.Logger) {
 	if bc.Ethereum != nil {
 		err = eth.Init(context.Background(), bc.Ethereum, logger)
 		if err != nil {
-			Logger.Warnf("ethereum client initialization failed: %v", err)
+			// Requests needing the node answer Unavailable until the client reconnects
+			Logger.Warnf("ethereum client initialization failed, reconnecting in background: %v", err)
 		} else {
 			Logger.Infof("ethereum client initialized")
 		}
+		if metrics.Enabled() {
+			err = eth.InitMetrics(metrics.Meter(), metrics.GetSettings().ReceiptPollInterval, logger)
+			if err != nil {
+				panic(err)
+			}
+		}
 	} else {
 		Logger.Warnf("ethereum configuration not found, skipping initialization")
 	}
 
+	// Requests may only sign with the admin signer when explicitly allowed
+	err = keystore.InitAdminAccess(bc.GetAdmin())
+	if err != nil {
+		panic(err)
+	}
+
 	// Initialize admin keystore if configured
 	if bc.GetAdmin() != nil {
 		err = keystore.Init(context.Background(), bc.GetAdmin(), logger)
@@ -71,4 +127,105 @@ func Init(bc *conf.Bootstrap, logger log.Logger) {
 	} else {
 		Logger.Warnf("admin configuration not found, skipping keystore initialization")
 	}
+
+	// Initialize HD wallet if configured
+	if bc.GetHdWallet().GetPath() != "" {
+		err = keystore.InitHD(context.Background(), bc.GetHdWallet(), logger)
+		if err != nil {
+			Logger.Warnf("HD wallet initialization failed: %v", err)
+		}
+	}
+
+	// Initialize named signers, including remote and HSM signers
+	err = keystore.InitSigners(context.Background(), bc.GetSigners(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize derived HD account table
+	err = wallet.Init(context.Background(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize managed keystore directory and key:/role: signers
+	err = keys.Init(context.Background(), bc.GetKeys(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize transaction manager for pending transaction replacement
+	err = txmanager.Init(context.Background(), bc.GetTransactions(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize bulk operation tables and token ID sequences
+	err = batch.Init(context.Background(), bc.GetBatch(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize NFT metadata resolver
+	err = metadata.Init(bc.GetMetadata(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize off-chain signing policies
+	err = signing.Init(bc.GetSigning(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize ERC-2771 meta-transaction relayer
+	err = relayer.Init(bc.GetRelayer(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize Safe multisig proposals
+	err = safe.Init(context.Background(), bc.GetSafe(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize asynchronous job store if enabled
+	if bc.GetJobs().GetEnabled() {
+		err = job.Init(context.Background(), bc.GetJobs(), logger)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Initialize signer balance monitoring and gas top-ups
+	err = treasury.Init(context.Background(), bc.GetTreasury(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize the contract artifact registry and deployment records
+	err = deploy.Init(context.Background(), bc.GetDeploy(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize the token holdings lookup
+	err = portfolio.Init(bc.GetPortfolio(), logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize readiness checks for the configured dependencies.
+	// Ethereum and signer failures above only log warnings, so readiness reports them.
+	err = health.Init(bc.GetHealth(), logger)
+	if err != nil {
+		panic(err)
+	}
+	health.Register("database", true, health.Database)
+	health.Register("redis", bc.GetJobs().GetEnabled() && bc.GetJobs().GetBackend() == "redis", health.Redis)
+	if bc.Ethereum != nil {
+		health.Register("ethereum", true, health.Ethereum)
+	}
+	health.Register("signers", true, health.Signers(bc.GetAdmin() != nil))
 }
@@ -0,0 +1,137 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"eth-contract-service/provider/cache"
+	"eth-contract-service/provider/db"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/pkg/errors"
+)
+
+// Ethereum checks that the node is reachable, serves the configured chain, is not
+// syncing and has a recent latest block
+func Ethereum(ctx context.Context) *Result {
+	client := eth.GetClient()
+	if client == nil {
+		return down(map[string]any{"retry_after_seconds": int64(eth.RetryAfter().Seconds())},
+			errors.Wrap(eth.LastError(), "ethereum client reconnecting"))
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return down(nil, errors.Wrap(err, "node unreachable"))
+	}
+	details := map[string]any{"chain_id": chainID.String()}
+	if expected := eth.GetChainID(); expected != nil && chainID.Cmp(expected) != 0 {
+		return down(details, errors.Errorf("chain ID mismatch: expected %s, got %s", expected, chainID))
+	}
+
+	progress, err := client.SyncProgress(ctx)
+	if err != nil {
+		return down(details, errors.Wrap(err, "failed to get sync status"))
+	}
+	if progress != nil {
+		details["current_block"] = progress.CurrentBlock
+		details["highest_block"] = progress.HighestBlock
+		return down(details, errors.Errorf("node is syncing: block %d of %d",
+			progress.CurrentBlock, progress.HighestBlock))
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return down(details, errors.Wrap(err, "failed to get latest block"))
+	}
+	age := time.Since(time.Unix(int64(header.Time), 0))
+	details["block_number"] = header.Number.Uint64()
+	details["block_age_seconds"] = int64(age.Seconds())
+	if age > settings.MaxBlockAge {
+		return down(details, errors.Errorf("latest block is %v old, more than %v",
+			age.Round(time.Second), settings.MaxBlockAge))
+	}
+	return ok(details)
+}
+
+// Database pings the database
+func Database(ctx context.Context) *Result {
+	sqlDB, err := db.Get().DB()
+	if err != nil {
+		return down(nil, err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return down(nil, errors.Wrap(err, "database ping failed"))
+	}
+	stats := sqlDB.Stats()
+	return ok(map[string]any{
+		"open_connections": stats.OpenConnections,
+		"in_use":           stats.InUse,
+	})
+}
+
+// Redis pings Redis
+func Redis(ctx context.Context) *Result {
+	client := cache.GetRedisClient()
+	if client == nil {
+		return down(nil, errors.New("redis client not initialized"))
+	}
+	if err := client.Ping(ctx).Err(); err != nil {
+		return down(nil, errors.Wrap(err, "redis ping failed"))
+	}
+	return ok(nil)
+}
+
+// Signers returns a check that the admin signer is available when an admin keystore is
+// configured, and that every named signer holds at least health.min_signer_balance.
+//
+// Parameters:
+//   - adminConfigured: Whether requests may sign with the admin keystore
+//
+// Returns:
+//   - Check: The signer check; low balances degrade it, a missing admin key takes it down
+func Signers(adminConfigured bool) Check {
+	return func(ctx context.Context) *Result {
+		addrs := keystore.SignerAddresses()
+		details := make(map[string]any, len(addrs))
+		for name, addr := range addrs {
+			details[name] = map[string]any{"address": addr.Hex()}
+		}
+
+		if adminConfigured {
+			if _, err := keystore.GetSigner(keystore.SignerAdmin); err != nil {
+				return down(details, errors.Wrap(err, "admin signer unavailable"))
+			}
+		}
+
+		minBalance := settings.MinSignerBalance
+		if minBalance == nil || len(addrs) == 0 {
+			return ok(details)
+		}
+		client := eth.GetClient()
+		if client == nil {
+			return degraded(details, errors.Wrap(eth.LastError(), "balances unavailable"))
+		}
+
+		var low []string
+		for name, addr := range addrs {
+			balance, err := client.BalanceAt(ctx, addr, nil)
+			if err != nil {
+				return degraded(details, errors.Wrapf(err, "failed to get balance of signer %s", name))
+			}
+			details[name].(map[string]any)["balance"] = balance.String()
+			if balance.Cmp(minBalance) < 0 {
+				low = append(low, name)
+			}
+		}
+		if len(low) > 0 {
+			sort.Strings(low)
+			return degraded(details, errors.Errorf("signers below minimum balance of %s wei: %s",
+				minBalance, strings.Join(low, ", ")))
+		}
+		return ok(details)
+	}
+}
@@ -0,0 +1,241 @@
+// Package health runs the liveness and readiness checks of the service.
+// Readiness covers the Ethereum node, the database, Redis and the signers; each
+// dependency is reported separately so that a failing probe names its cause.
+package health
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Status is the state of a check or of the whole service
+type Status string
+
+const (
+	// StatusOK means the dependency works as expected
+	StatusOK Status = "ok"
+	// StatusDegraded means the dependency works with reduced capacity, or a
+	// non-critical dependency is down
+	StatusDegraded Status = "degraded"
+	// StatusDown means the dependency does not work
+	StatusDown Status = "down"
+)
+
+// Settings holds the effective health check settings
+type Settings struct {
+	// Timeout bounds each readiness check
+	Timeout time.Duration
+	// MaxBlockAge is the age of the latest block above which the node is lagging
+	MaxBlockAge time.Duration
+	// MinSignerBalance is the signer balance in wei below which signers are degraded (nil disables it)
+	MinSignerBalance *big.Int
+}
+
+// Result is the outcome of a single check
+type Result struct {
+	Status    Status         `json:"status"`
+	Critical  bool           `json:"critical"`
+	Error     string         `json:"error,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+	LatencyMs int64          `json:"latency_ms"`
+}
+
+// Report is the outcome of a liveness or readiness probe
+type Report struct {
+	Status        Status             `json:"status"`
+	Checks        map[string]*Result `json:"checks,omitempty"`
+	UptimeSeconds int64              `json:"uptime_seconds"`
+	CheckedAt     int64              `json:"checked_at"`
+}
+
+// Check inspects one dependency and returns its result.
+// Critical and LatencyMs are filled in by the caller.
+type Check func(ctx context.Context) *Result
+
+// check is a registered readiness check
+type check struct {
+	name     string
+	critical bool
+	run      Check
+}
+
+var (
+	// settings stores the effective health check settings
+	settings = Settings{
+		Timeout:     5 * time.Second,
+		MaxBlockAge: 2 * time.Minute,
+	}
+	// checks stores the registered readiness checks by name
+	checks = make(map[string]check)
+	// checksMu guards checks
+	checksMu sync.RWMutex
+	// startedAt is the process start time reported as uptime
+	startedAt = time.Now()
+	// initOnce ensures the settings are applied only once
+	initOnce sync.Once
+)
+
+// Init applies the health check settings.
+//
+// Parameters:
+//   - cfg: Health configuration (optional)
+//   - logger: Logger instance for health logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Init(cfg *conf.Health, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if d := cfg.GetTimeout(); d != nil && d.AsDuration() > 0 {
+			settings.Timeout = d.AsDuration()
+		}
+		if d := cfg.GetMaxBlockAge(); d != nil && d.AsDuration() > 0 {
+			settings.MaxBlockAge = d.AsDuration()
+		}
+		if v := cfg.GetMinSignerBalance(); v != "" {
+			balance, ok := new(big.Int).SetString(v, 10)
+			if !ok || balance.Sign() < 0 {
+				initErr = errors.Errorf("invalid health.min_signer_balance: %s", v)
+				return
+			}
+			settings.MinSignerBalance = balance
+		}
+
+		log.NewHelper(logger).Infof("health checks initialized: timeout=%v, max_block_age=%v, min_signer_balance=%v",
+			settings.Timeout, settings.MaxBlockAge, settings.MinSignerBalance)
+	})
+	return initErr
+}
+
+// GetSettings returns the effective health check settings
+func GetSettings() Settings {
+	return settings
+}
+
+// Register adds a readiness check, replacing any check with the same name.
+// A critical check that is down makes the service not ready; a non-critical one only
+// degrades it.
+//
+// Parameters:
+//   - name: Component name reported in the probe, e.g. ethereum
+//   - critical: Whether the service cannot serve requests without the component
+//   - run: The check
+func Register(name string, critical bool, run Check) {
+	checksMu.Lock()
+	defer checksMu.Unlock()
+	checks[name] = check{name: name, critical: critical, run: run}
+}
+
+// Components returns the names of the registered checks in order
+func Components() []string {
+	checksMu.RLock()
+	defer checksMu.RUnlock()
+
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Live reports whether the process is running.
+// It does not inspect dependencies, so that an outage of the node or the database
+// never gets the process restarted.
+func Live() *Report {
+	now := time.Now()
+	return &Report{
+		Status:        StatusOK,
+		UptimeSeconds: int64(now.Sub(startedAt).Seconds()),
+		CheckedAt:     now.Unix(),
+	}
+}
+
+// Ready runs the registered checks concurrently, or only the named ones, and reports
+// whether the service can serve requests.
+//
+// Parameters:
+//   - ctx: Context for the checks
+//   - names: Checks to run (optional, all when empty)
+//
+// Returns:
+//   - *Report: Down if a critical check is down, degraded if any other check is not ok
+func Ready(ctx context.Context, names ...string) *Report {
+	checksMu.RLock()
+	selected := make([]check, 0, len(checks))
+	if len(names) == 0 {
+		for _, c := range checks {
+			selected = append(selected, c)
+		}
+	} else {
+		for _, name := range names {
+			if c, ok := checks[name]; ok {
+				selected = append(selected, c)
+			}
+		}
+	}
+	checksMu.RUnlock()
+
+	results := make([]*Result, len(selected))
+	var wg sync.WaitGroup
+	for i, c := range selected {
+		wg.Add(1)
+		go func(i int, c check) {
+			defer wg.Done()
+			results[i] = runCheck(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	report := Live()
+	report.Checks = make(map[string]*Result, len(selected))
+	for i, c := range selected {
+		res := results[i]
+		report.Checks[c.name] = res
+		switch {
+		case res.Status == StatusDown && res.Critical:
+			report.Status = StatusDown
+		case res.Status != StatusOK && report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// runCheck runs a check within the configured timeout
+func runCheck(ctx context.Context, c check) *Result {
+	ctx, cancel := context.WithTimeout(ctx, settings.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	res := c.run(ctx)
+	if res == nil {
+		res = ok(nil)
+	}
+	res.Critical = c.critical
+	res.LatencyMs = time.Since(start).Milliseconds()
+	return res
+}
+
+// ok returns a passing result
+func ok(details map[string]any) *Result {
+	return &Result{Status: StatusOK, Details: details}
+}
+
+// degraded returns a result for a dependency working with reduced capacity
+func degraded(details map[string]any, err error) *Result {
+	return &Result{Status: StatusDegraded, Error: err.Error(), Details: details}
+}
+
+// down returns a failing result
+func down(details map[string]any, err error) *Result {
+	return &Result{Status: StatusDown, Error: err.Error(), Details: details}
+}
@@ -0,0 +1,148 @@
+// Package job provides the asynchronous job queue for write operations.
+// Write requests submitted with async=true are persisted as jobs and executed by a
+// worker pool that signs, assigns nonces, broadcasts, rebroadcasts and tracks receipts.
+package job
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/provider/cache"
+	"eth-contract-service/provider/db"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Status represents the lifecycle state of a job
+type Status string
+
+const (
+	// StatusQueued means the job is waiting for a worker
+	StatusQueued Status = "queued"
+	// StatusProcessing means a worker is signing the transaction
+	StatusProcessing Status = "processing"
+	// StatusSubmitted means the transaction has been broadcast and awaits a receipt
+	StatusSubmitted Status = "submitted"
+	// StatusConfirmed means the transaction was mined successfully
+	StatusConfirmed Status = "confirmed"
+	// StatusFailed means the job failed or the transaction reverted
+	StatusFailed Status = "failed"
+	// StatusCancelled means the job was cancelled before it was signed
+	StatusCancelled Status = "cancelled"
+)
+
+// Job is a persisted asynchronous write operation
+type Job struct {
+	ID          string     `gorm:"primaryKey;size:36" json:"id"`
+	Operation   string     `gorm:"size:128;index" json:"operation"`
+	Status      Status     `gorm:"size:16;index" json:"status"`
+	Payload     []byte     `json:"payload"`                 // sealed request message
+	Result      string     `gorm:"type:text" json:"result"` // JSON encoded response message
+	Error       string     `gorm:"type:text" json:"error"`  // last error message
+	FromAddress string     `gorm:"size:42;index" json:"from_address"`
+	Nonce       uint64     `json:"nonce"`
+	TxHash      string     `gorm:"size:66;index" json:"tx_hash"`
+	RawTx       []byte     `json:"raw_tx"` // RLP encoded signed transaction
+	BlockNumber uint64     `json:"block_number"`
+	GasUsed     uint64     `json:"gas_used"`
+	Attempts    int32      `json:"attempts"`
+	SubmittedAt *time.Time `json:"submitted_at"`
+	BroadcastAt *time.Time `json:"broadcast_at"`
+	CreatedAt   time.Time  `gorm:"index" json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName returns the table name for jobs
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// Final reports whether the job reached a terminal state
+func (j *Job) Final() bool {
+	switch j.Status {
+	case StatusConfirmed, StatusFailed, StatusCancelled:
+		return true
+	}
+	return false
+}
+
+var (
+	// store is the global job store instance
+	store Store
+	// sealer encrypts job payloads at rest
+	sealer *payloadSealer
+	// config stores the job queue configuration
+	config *conf.Jobs
+	// initOnce ensures the job store is initialized only once
+	initOnce sync.Once
+)
+
+// Init initializes the job store from the provided configuration.
+// It does nothing when asynchronous jobs are disabled.
+//
+// Parameters:
+//   - ctx: Context for the initialization operation
+//   - cfg: Job queue configuration
+//   - logger: Logger instance for job logging
+//
+// Returns:
+//   - error: Error if the store cannot be initialized
+func Init(ctx context.Context, cfg *conf.Jobs, logger log.Logger) error {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	var initErr error
+	initOnce.Do(func() {
+		key, err := hex.DecodeString(strings.TrimPrefix(cfg.EncryptionKey, "0x"))
+		if err != nil || len(key) != 32 {
+			initErr = errors.New("jobs.encryption_key must be a hex encoded 32-byte key")
+			return
+		}
+		sealer, err = newPayloadSealer(key)
+		if err != nil {
+			initErr = errors.Wrap(err, "failed to create payload sealer")
+			return
+		}
+
+		switch cfg.Backend {
+		case "redis":
+			client := cache.GetRedisClient()
+			if client == nil {
+				initErr = errors.New("redis job backend requires an initialized redis client")
+				return
+			}
+			store = newRedisStore(client)
+		case "", "db":
+			if err := db.Get().WithContext(ctx).AutoMigrate(&Job{}); err != nil {
+				initErr = errors.Wrap(err, "failed to migrate jobs table")
+				return
+			}
+			store = newDBStore(db.Get())
+		default:
+			initErr = errors.Errorf("unsupported job backend: %s", cfg.Backend)
+			return
+		}
+
+		config = cfg
+		log.NewHelper(logger).Infof("job store initialized: backend=%s", cfg.Backend)
+	})
+
+	return initErr
+}
+
+// GetStore returns the global job store.
+// Returns nil if asynchronous jobs are disabled or not initialized.
+func GetStore() Store {
+	return store
+}
+
+// Enabled reports whether asynchronous jobs are available
+func Enabled() bool {
+	return store != nil
+}
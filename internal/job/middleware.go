@@ -0,0 +1,168 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"eth-contract-service/internal/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// asyncRequest is implemented by write requests that carry an async flag
+type asyncRequest interface {
+	GetAsync() bool
+}
+
+// Middleware returns a server middleware that turns write requests with async=true into
+// queued jobs. The handler is not invoked; instead an empty response carrying the job ID
+// is returned immediately and a worker executes the operation later.
+func Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			ar, ok := req.(asyncRequest)
+			if !ok || !ar.GetAsync() {
+				return handler(ctx, req)
+			}
+
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			operation := tr.Operation()
+			h := lookup(operation)
+			if h == nil {
+				return nil, errors.ToGRPCError(errors.InvalidArgument("operation %s does not support async execution", operation))
+			}
+
+			j, err := Enqueue(ctx, operation, req.(proto.Message))
+			if err != nil {
+				return nil, errors.ToGRPCError(err)
+			}
+
+			resp := h.newResponse()
+			setJobID(resp, j.ID)
+			return resp, nil
+		}
+	}
+}
+
+// Enqueue persists a new queued job for the given operation and request.
+//
+// Parameters:
+//   - ctx: Context for the store operation
+//   - operation: Full gRPC method name of a registered operation
+//   - req: The request message to execute
+//
+// Returns:
+//   - *Job: The queued job
+//   - error: Error if jobs are disabled, the request is not admitted or the job cannot be stored
+func Enqueue(ctx context.Context, operation string, req proto.Message) (*Job, error) {
+	if !Enabled() {
+		return nil, errors.ErrJobsDisabled
+	}
+	h := lookup(operation)
+	if h == nil {
+		return nil, errors.InvalidArgument("operation %s does not support async execution", operation)
+	}
+
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.CodeInternal, "failed to encode request")
+	}
+	payload, err := sealer.Seal(data)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.CodeInternal, "failed to seal request")
+	}
+
+	id := uuid.NewString()
+	var undo func()
+	if h.admit != nil {
+		if undo, err = h.admit(ctx, id, req); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	j := &Job{
+		ID:        id,
+		Operation: operation,
+		Status:    StatusQueued,
+		Payload:   payload,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := store.Create(ctx, j); err != nil {
+		if undo != nil {
+			undo()
+		}
+		return nil, errors.WrapError(err, errors.CodeInternal, "failed to enqueue job")
+	}
+	return j, nil
+}
+
+// Track records a transaction signed and broadcast outside of the worker pool as a
+// submitted job, so that the tracker settles it by receipt and rebroadcasts it like the
+// transactions of other jobs. Its fees are not bumped because the service has no signer.
+//
+// Parameters:
+//   - ctx: Context for the store operation
+//   - operation: Full gRPC method name of the operation that broadcast the transaction
+//   - from: Sender of the transaction
+//   - tx: The broadcast transaction
+//   - resp: Response of the operation, stored as the job result
+//
+// Returns:
+//   - *Job: The submitted job
+//   - error: Error if jobs are disabled or the job cannot be stored
+func Track(ctx context.Context, operation string, from common.Address, tx *types.Transaction, resp proto.Message) (*Job, error) {
+	if !Enabled() {
+		return nil, errors.ErrJobsDisabled
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, errors.WrapError(err, errors.CodeInternal, "failed to encode transaction")
+	}
+	result, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.CodeInternal, "failed to encode result")
+	}
+
+	now := time.Now()
+	j := &Job{
+		ID:          uuid.NewString(),
+		Operation:   operation,
+		Status:      StatusSubmitted,
+		Result:      string(result),
+		FromAddress: from.Hex(),
+		Nonce:       tx.Nonce(),
+		TxHash:      tx.Hash().Hex(),
+		RawTx:       raw,
+		SubmittedAt: &now,
+		BroadcastAt: &now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := store.Create(ctx, j); err != nil {
+		return nil, errors.WrapError(err, errors.CodeInternal, "failed to record job")
+	}
+	return j, nil
+}
+
+// setJobID sets the job_id field of a response message if it has one
+func setJobID(m proto.Message, id string) {
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("job_id")
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return
+	}
+	msg.Set(fd, protoreflect.ValueOfString(id))
+}
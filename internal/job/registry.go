@@ -0,0 +1,161 @@
+package job
+
+import (
+	"context"
+	"sync"
+
+	"eth-contract-service/provider/keystore"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Handler executes a write operation for a job
+type Handler struct {
+	// newRequest returns an empty request message for decoding payloads
+	newRequest func() proto.Message
+	// newResponse returns an empty response message for the async acknowledgement
+	newResponse func() proto.Message
+	// call invokes the service method
+	call func(ctx context.Context, req proto.Message) (proto.Message, error)
+	// signer resolves the sender for operations whose request carries no key
+	signer func() (keystore.Signer, error)
+	// admit checks the request of a new job before it is stored
+	admit func(ctx context.Context, id string, req proto.Message) (func(), error)
+}
+
+type jobIDKey struct{}
+
+var (
+	// handlers maps operation names to handlers
+	handlers = make(map[string]*Handler)
+	// callMiddleware wraps the service methods invoked by Call
+	callMiddleware []middleware.Middleware
+	// handlersMu guards handlers and callMiddleware
+	handlersMu sync.RWMutex
+)
+
+// Register makes a service method available for asynchronous execution.
+// The operation is the full gRPC method name, as exposed by the generated
+// Operation constants (e.g. /api.erc20.v1.ERC20/MintERC20).
+func Register[Req, Resp proto.Message](operation string, fn func(context.Context, Req) (Resp, error)) {
+	var req Req
+	var resp Resp
+	reqType := req.ProtoReflect().Type()
+	respType := resp.ProtoReflect().Type()
+
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	handlers[operation] = &Handler{
+		newRequest:  func() proto.Message { return reqType.New().Interface() },
+		newResponse: func() proto.Message { return respType.New().Interface() },
+		call: func(ctx context.Context, m proto.Message) (proto.Message, error) {
+			return fn(ctx, m.(Req))
+		},
+	}
+}
+
+// RegisterSigner sets how the sender of a registered operation is resolved when the
+// request carries neither private_key nor use_admin, e.g. for relayed transactions.
+// The signer is needed to replace pending transactions of the job.
+func RegisterSigner(operation string, fn func() (keystore.Signer, error)) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	if h, ok := handlers[operation]; ok {
+		h.signer = fn
+	}
+}
+
+// RegisterAdmission sets a check that runs once when a job of a registered operation is
+// enqueued, e.g. to take a per-sender quota keyed by the job ID rather than on every
+// attempt. A rejected request is not enqueued. The returned undo is called when the
+// admitted job cannot be stored.
+func RegisterAdmission[Req proto.Message](operation string, fn func(ctx context.Context, id string, req Req) (undo func(), err error)) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	if h, ok := handlers[operation]; ok {
+		h.admit = func(ctx context.Context, id string, m proto.Message) (func(), error) {
+			return fn(ctx, id, m.(Req))
+		}
+	}
+}
+
+// IDFromContext returns the ID of the job a service method is executed for, if any
+func IDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(jobIDKey{}).(string)
+	return id, ok
+}
+
+// withID returns a context carrying the ID of the job being executed
+func withID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, jobIDKey{}, id)
+}
+
+// Registered reports whether an operation is registered for asynchronous execution.
+// Registered operations produce exactly one transaction per call.
+func Registered(operation string) bool {
+	return lookup(operation) != nil
+}
+
+// NewRequest returns an empty request message of a registered operation, or false when
+// the operation is not registered
+func NewRequest(operation string) (proto.Message, bool) {
+	h := lookup(operation)
+	if h == nil {
+		return nil, false
+	}
+	return h.newRequest(), true
+}
+
+// Use adds middleware that Call applies around service methods, such as the
+// authorization checks the transport applies to the same operation.
+func Use(ms ...middleware.Middleware) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	callMiddleware = append(callMiddleware, ms...)
+}
+
+// Call invokes the service method of a registered operation through the middleware added
+// with Use, e.g. with a transaction capture in ctx to build the transaction without
+// sending it. The middleware sees the operation instead of the one of the outer request.
+func Call(ctx context.Context, operation string, req proto.Message) (proto.Message, error) {
+	h := lookup(operation)
+	if h == nil {
+		return nil, errors.Errorf("operation %s is not registered", operation)
+	}
+	handlersMu.RLock()
+	ms := callMiddleware
+	handlersMu.RUnlock()
+
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		ctx = transport.NewServerContext(ctx, operationTransport{Transporter: tr, operation: operation})
+	}
+	resp, err := middleware.Chain(ms...)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return h.call(ctx, req.(proto.Message))
+	})(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(proto.Message), nil
+}
+
+// operationTransport reports the operation invoked by Call in place of the operation of
+// the outer request
+type operationTransport struct {
+	transport.Transporter
+	operation string
+}
+
+// Operation returns the operation invoked by Call
+func (t operationTransport) Operation() string {
+	return t.operation
+}
+
+// lookup returns the handler registered for the operation, or nil
+func lookup(operation string) *Handler {
+	handlersMu.RLock()
+	defer handlersMu.RUnlock()
+	return handlers[operation]
+}
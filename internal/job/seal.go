@@ -0,0 +1,50 @@
+package job
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// payloadSealer encrypts job payloads with AES-GCM.
+// Write requests carry private keys, so payloads are never stored in plain text.
+type payloadSealer struct {
+	aead cipher.AEAD
+}
+
+func newPayloadSealer(key []byte) (*payloadSealer, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &payloadSealer{aead: aead}, nil
+}
+
+// Seal encrypts the plaintext and prepends the random nonce
+func (s *payloadSealer) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open decrypts a payload produced by Seal
+func (s *payloadSealer) Open(sealed []byte) ([]byte, error) {
+	size := s.aead.NonceSize()
+	if len(sealed) < size {
+		return nil, errors.New("sealed payload too short")
+	}
+	plaintext, err := s.aead.Open(nil, sealed[:size], sealed[size:], nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt payload")
+	}
+	return plaintext, nil
+}
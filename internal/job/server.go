@@ -0,0 +1,558 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/txmanager"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+	"eth-contract-service/provider/tracing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ transport.Server = (*Server)(nil)
+
+// trackBatchSize is the number of submitted jobs checked per tracker tick
+const trackBatchSize = 100
+
+// Server runs the job worker pool and the receipt tracker.
+// It implements transport.Server so that its lifecycle is managed by the kratos app.
+type Server struct {
+	logger *log.Helper
+
+	workers             int
+	pollInterval        time.Duration
+	rebroadcastInterval time.Duration
+	receiptTimeout      time.Duration
+	maxAttempts         int32
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewServer creates a job server from the provided configuration.
+// Defaults are applied for unset values.
+//
+// Parameters:
+//   - cfg: Job queue configuration (may be nil when jobs are disabled)
+//   - logger: Logger instance for worker logging
+//
+// Returns:
+//   - *Server: A job server ready to be started
+func NewServer(cfg *conf.Jobs, logger log.Logger) *Server {
+	s := &Server{
+		logger:              log.NewHelper(logger),
+		workers:             4,
+		pollInterval:        time.Second,
+		rebroadcastInterval: time.Minute,
+		receiptTimeout:      30 * time.Minute,
+		maxAttempts:         3,
+	}
+	if cfg == nil {
+		return s
+	}
+	if cfg.Workers > 0 {
+		s.workers = int(cfg.Workers)
+	}
+	if cfg.PollInterval != nil {
+		s.pollInterval = cfg.PollInterval.AsDuration()
+	}
+	if cfg.RebroadcastInterval != nil {
+		s.rebroadcastInterval = cfg.RebroadcastInterval.AsDuration()
+	}
+	if cfg.ReceiptTimeout != nil {
+		s.receiptTimeout = cfg.ReceiptTimeout.AsDuration()
+	}
+	if cfg.MaxAttempts > 0 {
+		s.maxAttempts = cfg.MaxAttempts
+	}
+	return s
+}
+
+// Start launches the workers and the receipt tracker.
+// It returns immediately; the goroutines run until Stop is called.
+func (s *Server) Start(ctx context.Context) error {
+	if !Enabled() {
+		s.logger.Infof("asynchronous jobs disabled, job workers not started")
+		return nil
+	}
+
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.recover(ctx)
+
+	for i := 0; i < s.workers; i++ {
+		s.wg.Add(1)
+		go s.work(ctx)
+	}
+	s.wg.Add(1)
+	go s.track(ctx)
+
+	s.logger.Infof("job workers started: workers=%d, poll_interval=%v", s.workers, s.pollInterval)
+	return nil
+}
+
+// Stop stops the workers and waits for in-flight jobs to finish.
+func (s *Server) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.logger.Infof("job workers stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// work claims and executes queued jobs until the context is cancelled
+func (s *Server) work(ctx context.Context) {
+	defer s.wg.Done()
+
+	for {
+		jobs, err := store.Claim(ctx, 1)
+		if err != nil && ctx.Err() == nil {
+			s.logger.Errorf("failed to claim job: %v", err)
+		}
+		for _, j := range jobs {
+			s.execute(ctx, j)
+		}
+		if len(jobs) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// execute signs and broadcasts the transaction for a claimed job
+func (s *Server) execute(ctx context.Context, j *Job) {
+	ctx, span := tracing.Start(ctx, "job.Execute", trace.WithAttributes(
+		attribute.String("job.id", j.ID), attribute.String("job.operation", j.Operation)))
+	defer span.End()
+
+	j.Attempts++
+
+	h := lookup(j.Operation)
+	if h == nil {
+		s.fail(ctx, j, errors.Errorf("operation %s is not registered", j.Operation))
+		return
+	}
+
+	data, err := sealer.Open(j.Payload)
+	if err != nil {
+		s.fail(ctx, j, err)
+		return
+	}
+	req := h.newRequest()
+	if err := proto.Unmarshal(data, req); err != nil {
+		s.fail(ctx, j, errors.Wrap(err, "failed to decode request"))
+		return
+	}
+
+	// Run the service method with broadcasting deferred to the worker
+	capture := contract.NewTxCapture(eth.NextNonce)
+	resp, err := h.call(contract.WithTxCapture(withID(ctx, j.ID), capture), req)
+	tx := capture.Transaction()
+	if err != nil || tx == nil {
+		if nonce, ok := capture.Nonce(); ok {
+			eth.ReturnNonce(capture.From(), nonce, err)
+		}
+		if err == nil {
+			err = errors.New("operation did not produce a transaction")
+		}
+		s.retryOrFail(ctx, j, err)
+		return
+	}
+
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		eth.ReleaseNonce(capture.From(), tx.Nonce())
+		s.fail(ctx, j, errors.Wrap(err, "failed to encode transaction"))
+		return
+	}
+	result, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		s.logger.Warnf("failed to encode job result: id=%s, error=%v", j.ID, err)
+	}
+
+	// Persist the signed transaction before broadcasting so that it survives a restart
+	j.FromAddress = capture.From().Hex()
+	j.Nonce = tx.Nonce()
+	j.TxHash = tx.Hash().Hex()
+	j.RawTx = raw
+	j.Result = string(result)
+	j.Error = ""
+	if err := store.Save(ctx, j); err != nil {
+		// Nothing was broadcast; the job stays in processing until recover requeues it
+		eth.ReleaseNonce(capture.From(), tx.Nonce())
+		s.logger.Errorf("failed to save signed job: id=%s, error=%v", j.ID, err)
+		return
+	}
+
+	s.broadcast(ctx, j, tx)
+}
+
+// broadcast sends the signed transaction and marks the job as submitted
+func (s *Server) broadcast(ctx context.Context, j *Job, tx *types.Transaction) {
+	from := common.HexToAddress(j.FromAddress)
+	if err := eth.SendTransaction(ctx, tx); err != nil && !eth.IsKnownTransaction(err) && !s.mined(ctx, j, err) {
+		// The nonce is handed out again unless the node reports it as used already
+		eth.ReturnNonce(from, j.Nonce, err)
+		j.TxHash, j.RawTx, j.Result = "", nil, ""
+		s.retryOrFail(ctx, j, errors.Wrap(err, "failed to broadcast transaction"))
+		return
+	}
+
+	now := time.Now()
+	j.Status = StatusSubmitted
+	j.SubmittedAt = &now
+	j.BroadcastAt = &now
+	if err := store.Save(ctx, j); err != nil {
+		// The signed transaction was saved before broadcasting, so recover sends it again
+		// and marks the job submitted
+		s.logger.Errorf("failed to save submitted job: id=%s, error=%v", j.ID, err)
+		return
+	}
+
+	s.logger.Infof("job submitted: id=%s, operation=%s, from=%s, nonce=%d, tx=%s",
+		j.ID, j.Operation, j.FromAddress, j.Nonce, j.TxHash)
+}
+
+// mined reports whether a broadcast error is the node rejecting the nonce because the job
+// transaction, or one of its replacements, was already mined. This happens when recover
+// broadcasts a job whose submitted state could not be saved.
+func (s *Server) mined(ctx context.Context, j *Job, err error) bool {
+	if !eth.IsNonceTooLow(err) {
+		return false
+	}
+	res, err := txmanager.Resolve(ctx, common.HexToHash(j.TxHash))
+	return err == nil && res.Receipt != nil
+}
+
+// track polls receipts for submitted jobs and rebroadcasts stale transactions.
+// Every rebroadcast interval it also recovers jobs whose worker stopped making progress.
+func (s *Server) track(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	recovered := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if time.Since(recovered) > s.rebroadcastInterval {
+			s.recover(ctx)
+			recovered = time.Now()
+		}
+
+		jobs, err := store.ListByStatus(ctx, StatusSubmitted, trackBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Errorf("failed to list submitted jobs: %v", err)
+			}
+			continue
+		}
+		for _, j := range jobs {
+			s.checkReceipt(ctx, j)
+		}
+	}
+}
+
+// checkReceipt updates a submitted job from the receipt of whichever transaction of its
+// replacement chain was mined
+func (s *Server) checkReceipt(ctx context.Context, j *Job) {
+	ctx, span := tracing.Start(ctx, "job.CheckReceipt", trace.WithAttributes(
+		attribute.String("job.id", j.ID), attribute.String("tx.hash", j.TxHash)))
+	defer span.End()
+
+	res, err := txmanager.Resolve(ctx, common.HexToHash(j.TxHash))
+	if err != nil && status.Code(err) != codes.NotFound {
+		s.logger.Warnf("failed to resolve transaction: id=%s, tx=%s, error=%v", j.ID, j.TxHash, err)
+		return
+	}
+
+	if res == nil || res.Status == txmanager.StatusPending {
+		if res != nil {
+			s.adoptLatest(j, res.Chain)
+		}
+		now := time.Now()
+		if j.Error == "" && j.SubmittedAt != nil && now.Sub(*j.SubmittedAt) > s.receiptTimeout {
+			s.overdue(ctx, j)
+		}
+		switch {
+		case res != nil && s.shouldBump(j, res.Chain, now):
+			s.speedUp(ctx, j)
+		case j.BroadcastAt == nil || now.Sub(*j.BroadcastAt) > s.rebroadcastInterval:
+			s.rebroadcast(ctx, j)
+		}
+		return
+	}
+
+	if res.Status == txmanager.StatusDropped {
+		s.fail(ctx, j, errors.Errorf("nonce %d was used by another transaction", j.Nonce))
+		return
+	}
+
+	j.TxHash = res.MinedHash.Hex()
+	j.BlockNumber = res.Receipt.BlockNumber.Uint64()
+	j.GasUsed = res.Receipt.GasUsed
+	switch res.Status {
+	case txmanager.StatusConfirmed:
+		j.Status = StatusConfirmed
+		j.Error = ""
+	case txmanager.StatusCancelled:
+		j.Status = StatusFailed
+		j.Error = "transaction cancelled"
+	default:
+		j.Status = StatusFailed
+		j.Error = "transaction reverted"
+	}
+	if err := store.Save(ctx, j); err != nil {
+		s.logger.Errorf("failed to save job receipt: id=%s, error=%v", j.ID, err)
+		return
+	}
+
+	s.logger.Infof("job finished: id=%s, status=%s, tx=%s, block_number=%d, gas_used=%d",
+		j.ID, j.Status, j.TxHash, j.BlockNumber, j.GasUsed)
+}
+
+// overdue flags a submitted job whose transaction has no receipt after the receipt timeout.
+// The transaction may still be mined, so the job stays submitted until a receipt or another
+// transaction using its nonce settles it.
+func (s *Server) overdue(ctx context.Context, j *Job) {
+	j.Error = errors.Errorf("no receipt after %v", s.receiptTimeout).Error()
+	if err := store.Save(ctx, j); err != nil {
+		s.logger.Errorf("failed to save job: id=%s, error=%v", j.ID, err)
+		return
+	}
+	s.logger.Warnf("job transaction overdue: id=%s, tx=%s, error=%s", j.ID, j.TxHash, j.Error)
+}
+
+// adoptLatest points the job at the newest replacement of its transaction, so that
+// rebroadcasts send the transaction with the highest fees
+func (s *Server) adoptLatest(j *Job, chain *txmanager.Chain) {
+	latest := chain.Latest()
+	if latest == nil || latest.TxHash == j.TxHash {
+		return
+	}
+	j.TxHash = latest.TxHash
+	j.RawTx = latest.RawTx
+}
+
+// shouldBump reports whether the job transaction has waited long enough for an automatic fee bump
+func (s *Server) shouldBump(j *Job, chain *txmanager.Chain, now time.Time) bool {
+	settings := txmanager.GetSettings()
+	if !settings.AutoBump || chain.Count(txmanager.KindAutoSpeedUp) >= settings.MaxAutoBumps {
+		return false
+	}
+
+	sentAt := j.SubmittedAt
+	if latest := chain.Latest(); latest != nil {
+		sentAt = &latest.CreatedAt
+	}
+	return sentAt != nil && now.Sub(*sentAt) > settings.BumpAfter
+}
+
+// speedUp replaces the pending job transaction with one paying higher fees
+func (s *Server) speedUp(ctx context.Context, j *Job) {
+	signer, err := s.signer(j)
+	if err != nil {
+		s.logger.Warnf("cannot bump fees: id=%s, error=%v", j.ID, err)
+		s.rebroadcast(ctx, j)
+		return
+	}
+
+	result, err := txmanager.Replace(ctx, common.HexToHash(j.TxHash), signer, txmanager.KindAutoSpeedUp, 0)
+	if err != nil {
+		s.logger.Warnf("failed to bump fees: id=%s, tx=%s, error=%v", j.ID, j.TxHash, err)
+		if status.Code(err) == codes.FailedPrecondition {
+			s.rebroadcast(ctx, j)
+		}
+		return
+	}
+
+	raw, err := result.Tx.MarshalBinary()
+	if err != nil {
+		s.logger.Errorf("failed to encode replacement: id=%s, error=%v", j.ID, err)
+		return
+	}
+	now := time.Now()
+	j.TxHash = result.Tx.Hash().Hex()
+	j.RawTx = raw
+	j.BroadcastAt = &now
+	if err := store.Save(ctx, j); err != nil {
+		s.logger.Errorf("failed to save job: id=%s, error=%v", j.ID, err)
+		return
+	}
+
+	s.logger.Infof("transaction fees bumped: id=%s, replaced=%s, tx=%s", j.ID, result.Replaced.Hex(), j.TxHash)
+}
+
+// signer recovers the sender of the sealed job request
+func (s *Server) signer(j *Job) (keystore.Signer, error) {
+	h := lookup(j.Operation)
+	if h == nil {
+		return nil, errors.Errorf("operation %s is not registered", j.Operation)
+	}
+	if h.signer != nil {
+		return h.signer()
+	}
+	data, err := sealer.Open(j.Payload)
+	if err != nil {
+		return nil, err
+	}
+	req := h.newRequest()
+	if err := proto.Unmarshal(data, req); err != nil {
+		return nil, errors.Wrap(err, "failed to decode request")
+	}
+
+	msg := req.ProtoReflect()
+
+	// Administration requests may be signed with the admin signer instead
+	if fd := msg.Descriptor().Fields().ByName("use_admin"); fd != nil && fd.Kind() == protoreflect.BoolKind && msg.Get(fd).Bool() {
+		return keystore.GetSigner(keystore.SignerAdmin)
+	}
+
+	// Requests may name an HD wallet account as their signer instead
+	if fd := msg.Descriptor().Fields().ByName("signer"); fd != nil && fd.Kind() == protoreflect.StringKind && msg.Get(fd).String() != "" {
+		return keystore.GetSigner(msg.Get(fd).String())
+	}
+
+	fd := msg.Descriptor().Fields().ByName("private_key")
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return nil, errors.New("request has no private key")
+	}
+	keyBytes, err := validator.ValidatePrivateKey(msg.Get(fd).String())
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.ToECDSA(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return keystore.NewKeySigner(key), nil
+}
+
+// rebroadcast re-sends the stored raw transaction of a submitted job
+func (s *Server) rebroadcast(ctx context.Context, j *Job) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(j.RawTx); err != nil {
+		s.fail(ctx, j, errors.Wrap(err, "failed to decode stored transaction"))
+		return
+	}
+
+	if err := eth.SendTransaction(ctx, tx); err != nil && !eth.IsKnownTransaction(err) {
+		s.logger.Warnf("failed to rebroadcast transaction: id=%s, tx=%s, error=%v", j.ID, j.TxHash, err)
+	} else {
+		s.logger.Infof("transaction rebroadcast: id=%s, tx=%s", j.ID, j.TxHash)
+	}
+
+	now := time.Now()
+	j.BroadcastAt = &now
+	if err := store.Save(ctx, j); err != nil {
+		s.logger.Errorf("failed to save job: id=%s, error=%v", j.ID, err)
+	}
+}
+
+// recover resumes jobs left in processing by a previous run or by a worker that could
+// not save its progress, and requeues queued jobs lost by an interrupted claim.
+// Jobs with a signed transaction are broadcast; others are queued again.
+func (s *Server) recover(ctx context.Context) {
+	if n, err := store.Recover(ctx); err != nil {
+		s.logger.Errorf("failed to recover queued jobs: %v", err)
+	} else if n > 0 {
+		s.logger.Warnf("requeued %d jobs lost from the queue", n)
+	}
+
+	jobs, err := store.ListByStatus(ctx, StatusProcessing, trackBatchSize)
+	if err != nil {
+		s.logger.Errorf("failed to list interrupted jobs: %v", err)
+		return
+	}
+
+	for _, j := range jobs {
+		// Leave jobs alone that another instance may still be working on
+		if time.Since(j.UpdatedAt) < s.rebroadcastInterval {
+			continue
+		}
+		if len(j.RawTx) > 0 {
+			tx := new(types.Transaction)
+			if err := tx.UnmarshalBinary(j.RawTx); err == nil {
+				s.broadcast(ctx, j, tx)
+				continue
+			}
+		}
+		j.Status = StatusQueued
+		if err := store.Save(ctx, j); err != nil {
+			s.logger.Errorf("failed to requeue job: id=%s, error=%v", j.ID, err)
+		}
+	}
+}
+
+// retryOrFail queues the job again unless the error is permanent or attempts are exhausted
+func (s *Server) retryOrFail(ctx context.Context, j *Job, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.PermissionDenied:
+		s.fail(ctx, j, err)
+		return
+	}
+	if j.Attempts >= s.maxAttempts {
+		s.fail(ctx, j, err)
+		return
+	}
+
+	j.Status = StatusQueued
+	j.Error = err.Error()
+	if saveErr := store.Save(ctx, j); saveErr != nil {
+		s.logger.Errorf("failed to requeue job: id=%s, error=%v", j.ID, saveErr)
+		return
+	}
+	s.logger.Warnf("job attempt failed, requeued: id=%s, attempt=%d, error=%v", j.ID, j.Attempts, err)
+}
+
+// fail marks the job as failed
+func (s *Server) fail(ctx context.Context, j *Job, err error) {
+	j.Status = StatusFailed
+	j.Error = err.Error()
+	if saveErr := store.Save(ctx, j); saveErr != nil {
+		s.logger.Errorf("failed to save failed job: id=%s, error=%v", j.ID, saveErr)
+		return
+	}
+	s.logger.Errorf("job failed: id=%s, operation=%s, error=%v", j.ID, j.Operation, err)
+}
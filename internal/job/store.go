@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned by a Store when the job does not exist
+var ErrNotFound = errors.New("job not found")
+
+// ErrConflict is returned by a Store when a conditional update did not apply
+var ErrConflict = errors.New("job status changed concurrently")
+
+// ListFilter narrows the result of Store.List
+type ListFilter struct {
+	Status    Status
+	Operation string
+	Offset    int
+	Limit     int
+}
+
+// Store persists jobs. Implementations must make Claim and Transition safe for
+// concurrent use by several workers and service instances.
+type Store interface {
+	// Create persists a new job
+	Create(ctx context.Context, j *Job) error
+	// Get returns the job with the given ID or ErrNotFound
+	Get(ctx context.Context, id string) (*Job, error)
+	// List returns jobs matching the filter, newest first, and the total match count
+	List(ctx context.Context, filter ListFilter) ([]*Job, int64, error)
+	// Claim moves up to limit queued jobs to processing and returns them, oldest first
+	Claim(ctx context.Context, limit int) ([]*Job, error)
+	// Save writes all fields of an existing job
+	Save(ctx context.Context, j *Job) error
+	// Transition atomically changes the job status from one state to another and
+	// returns the updated job, or ErrConflict if the job is not in the expected state
+	Transition(ctx context.Context, id string, from, to Status) (*Job, error)
+	// ListByStatus returns up to limit jobs in the given status, oldest first
+	ListByStatus(ctx context.Context, status Status, limit int) ([]*Job, error)
+	// Recover makes queued jobs that an interrupted claim left unreachable claimable
+	// again and returns how many were requeued
+	Recover(ctx context.Context) (int, error)
+}
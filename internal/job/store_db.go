@@ -0,0 +1,124 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	pkgErrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// dbStore is a Store backed by the service database
+type dbStore struct {
+	db *gorm.DB
+}
+
+func newDBStore(db *gorm.DB) *dbStore {
+	return &dbStore{db: db}
+}
+
+func (s *dbStore) Create(ctx context.Context, j *Job) error {
+	return pkgErrors.Wrap(s.db.WithContext(ctx).Create(j).Error, "failed to create job")
+}
+
+func (s *dbStore) Get(ctx context.Context, id string) (*Job, error) {
+	var j Job
+	err := s.db.WithContext(ctx).Where("id = ?", id).First(&j).Error
+	if pkgErrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to get job")
+	}
+	return &j, nil
+}
+
+func (s *dbStore) List(ctx context.Context, filter ListFilter) ([]*Job, int64, error) {
+	query := s.db.WithContext(ctx).Model(&Job{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Operation != "" {
+		query = query.Where("operation = ?", filter.Operation)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pkgErrors.Wrap(err, "failed to count jobs")
+	}
+
+	var jobs []*Job
+	err := query.Order("created_at DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&jobs).Error
+	if err != nil {
+		return nil, 0, pkgErrors.Wrap(err, "failed to list jobs")
+	}
+	return jobs, total, nil
+}
+
+func (s *dbStore) Claim(ctx context.Context, limit int) ([]*Job, error) {
+	var candidates []*Job
+	err := s.db.WithContext(ctx).
+		Where("status = ?", StatusQueued).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to load queued jobs")
+	}
+
+	claimed := make([]*Job, 0, len(candidates))
+	for _, j := range candidates {
+		// The conditional update makes sure only one worker wins each job
+		res := s.db.WithContext(ctx).Model(&Job{}).
+			Where("id = ? AND status = ?", j.ID, StatusQueued).
+			Updates(map[string]interface{}{"status": StatusProcessing, "updated_at": time.Now()})
+		if res.Error != nil {
+			return claimed, pkgErrors.Wrap(res.Error, "failed to claim job")
+		}
+		if res.RowsAffected == 1 {
+			j.Status = StatusProcessing
+			claimed = append(claimed, j)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *dbStore) Save(ctx context.Context, j *Job) error {
+	return pkgErrors.Wrap(s.db.WithContext(ctx).Save(j).Error, "failed to save job")
+}
+
+func (s *dbStore) Transition(ctx context.Context, id string, from, to Status) (*Job, error) {
+	res := s.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(map[string]interface{}{"status": to, "updated_at": time.Now()})
+	if res.Error != nil {
+		return nil, pkgErrors.Wrap(res.Error, "failed to update job status")
+	}
+
+	j, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if res.RowsAffected == 0 {
+		return j, ErrConflict
+	}
+	return j, nil
+}
+
+func (s *dbStore) ListByStatus(ctx context.Context, status Status, limit int) ([]*Job, error) {
+	var jobs []*Job
+	err := s.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to list jobs")
+	}
+	return jobs, nil
+}
+
+// Recover is a no-op: jobs are claimed by their status, so a queued job is never lost
+func (s *dbStore) Recover(ctx context.Context) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,288 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	pkgErrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisQueueKey  = "jobs:queue"
+	redisClaimKey  = "jobs:claiming"
+	redisIndexKey  = "jobs:index"
+	redisDataKey   = "jobs:data:"
+	redisStatusKey = "jobs:status:"
+)
+
+// redisStore is a Store backed by Redis.
+// Jobs are stored as JSON documents; a list holds queued job IDs and sorted sets
+// (scored by creation time) index jobs overall and per status. Claimed IDs are moved to
+// a second list until their status has changed, so that a claim interrupted between the
+// two steps can be put back in the queue by Recover.
+type redisStore struct {
+	client *redis.Client
+}
+
+func newRedisStore(client *redis.Client) *redisStore {
+	return &redisStore{client: client}
+}
+
+func (s *redisStore) Create(ctx context.Context, j *Job) error {
+	data, err := json.Marshal(j)
+	if err != nil {
+		return pkgErrors.Wrap(err, "failed to encode job")
+	}
+
+	score := float64(j.CreatedAt.UnixNano())
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, redisDataKey+j.ID, data, 0)
+		pipe.ZAdd(ctx, redisIndexKey, redis.Z{Score: score, Member: j.ID})
+		pipe.ZAdd(ctx, redisStatusKey+string(j.Status), redis.Z{Score: score, Member: j.ID})
+		if j.Status == StatusQueued {
+			pipe.LPush(ctx, redisQueueKey, j.ID)
+		}
+		return nil
+	})
+	return pkgErrors.Wrap(err, "failed to create job")
+}
+
+func (s *redisStore) Get(ctx context.Context, id string) (*Job, error) {
+	return s.get(ctx, s.client, id)
+}
+
+func (s *redisStore) get(ctx context.Context, c redis.Cmdable, id string) (*Job, error) {
+	data, err := c.Get(ctx, redisDataKey+id).Bytes()
+	if err == redis.Nil {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to get job")
+	}
+
+	var j Job
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to decode job")
+	}
+	return &j, nil
+}
+
+func (s *redisStore) List(ctx context.Context, filter ListFilter) ([]*Job, int64, error) {
+	key := redisIndexKey
+	if filter.Status != "" {
+		key = redisStatusKey + string(filter.Status)
+	}
+
+	// Without an operation filter the sorted set can be paged directly
+	if filter.Operation == "" {
+		total, err := s.client.ZCard(ctx, key).Result()
+		if err != nil {
+			return nil, 0, pkgErrors.Wrap(err, "failed to count jobs")
+		}
+		ids, err := s.client.ZRevRange(ctx, key, int64(filter.Offset), int64(filter.Offset+filter.Limit-1)).Result()
+		if err != nil {
+			return nil, 0, pkgErrors.Wrap(err, "failed to list jobs")
+		}
+		jobs, err := s.load(ctx, ids)
+		return jobs, total, err
+	}
+
+	ids, err := s.client.ZRevRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, 0, pkgErrors.Wrap(err, "failed to list jobs")
+	}
+	all, err := s.load(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matched := make([]*Job, 0, len(all))
+	for _, j := range all {
+		if j.Operation == filter.Operation {
+			matched = append(matched, j)
+		}
+	}
+	total := int64(len(matched))
+	if filter.Offset >= len(matched) {
+		return nil, total, nil
+	}
+	end := filter.Offset + filter.Limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	return matched[filter.Offset:end], total, nil
+}
+
+func (s *redisStore) Claim(ctx context.Context, limit int) ([]*Job, error) {
+	claimed := make([]*Job, 0, limit)
+	for len(claimed) < limit {
+		id, err := s.client.LMove(ctx, redisQueueKey, redisClaimKey, "RIGHT", "LEFT").Result()
+		if err == redis.Nil {
+			break
+		}
+		if err != nil {
+			return claimed, pkgErrors.Wrap(err, "failed to pop job")
+		}
+
+		// Cancelled jobs stay in the queue list and are skipped here.
+		// The ID stays in the claim list when the transition fails, for Recover to requeue.
+		j, err := s.Transition(ctx, id, StatusQueued, StatusProcessing)
+		if err != nil && err != ErrConflict && err != ErrNotFound {
+			return claimed, err
+		}
+		// A failed removal leaves an ID that Recover drops because the job is no longer queued
+		s.client.LRem(ctx, redisClaimKey, 1, id)
+		if err != nil {
+			continue
+		}
+		claimed = append(claimed, j)
+	}
+	return claimed, nil
+}
+
+func (s *redisStore) Recover(ctx context.Context) (int, error) {
+	queue, err := s.client.LRange(ctx, redisQueueKey, 0, -1).Result()
+	if err != nil {
+		return 0, pkgErrors.Wrap(err, "failed to list queue")
+	}
+	inQueue := make(map[string]bool, len(queue))
+	for _, id := range queue {
+		inQueue[id] = true
+	}
+
+	// Claims interrupted before the job left the queued state
+	claiming, err := s.client.LRange(ctx, redisClaimKey, 0, -1).Result()
+	if err != nil {
+		return 0, pkgErrors.Wrap(err, "failed to list claimed jobs")
+	}
+	requeued := 0
+	for _, id := range claiming {
+		j, err := s.Get(ctx, id)
+		if err != nil && err != ErrNotFound {
+			return requeued, err
+		}
+		if j != nil && j.Status == StatusQueued && !inQueue[id] {
+			if err := s.client.LPush(ctx, redisQueueKey, id).Err(); err != nil {
+				return requeued, pkgErrors.Wrap(err, "failed to requeue job")
+			}
+			inQueue[id] = true
+			requeued++
+		}
+		if err := s.client.LRem(ctx, redisClaimKey, 1, id).Err(); err != nil {
+			return requeued, pkgErrors.Wrap(err, "failed to remove claimed job")
+		}
+	}
+
+	// Queued jobs whose ID was lost from the queue list
+	queued, err := s.client.ZRange(ctx, redisStatusKey+string(StatusQueued), 0, -1).Result()
+	if err != nil {
+		return requeued, pkgErrors.Wrap(err, "failed to list queued jobs")
+	}
+	for _, id := range queued {
+		if inQueue[id] {
+			continue
+		}
+		if err := s.client.LPush(ctx, redisQueueKey, id).Err(); err != nil {
+			return requeued, pkgErrors.Wrap(err, "failed to requeue job")
+		}
+		inQueue[id] = true
+		requeued++
+	}
+	return requeued, nil
+}
+
+func (s *redisStore) Save(ctx context.Context, j *Job) error {
+	return s.update(ctx, j.ID, func(current *Job) error {
+		*current = *j
+		return nil
+	})
+}
+
+func (s *redisStore) Transition(ctx context.Context, id string, from, to Status) (*Job, error) {
+	var result *Job
+	err := s.update(ctx, id, func(current *Job) error {
+		if current.Status != from {
+			result = current
+			return ErrConflict
+		}
+		current.Status = to
+		result = current
+		return nil
+	})
+	return result, err
+}
+
+func (s *redisStore) ListByStatus(ctx context.Context, status Status, limit int) ([]*Job, error) {
+	ids, err := s.client.ZRange(ctx, redisStatusKey+string(status), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to list jobs")
+	}
+	return s.load(ctx, ids)
+}
+
+// update applies fn to the stored job inside an optimistic transaction and keeps the
+// status index in sync. Errors returned by fn abort the update and are passed through.
+// The transaction is retried a few times when the job is modified concurrently.
+func (s *redisStore) update(ctx context.Context, id string, fn func(*Job) error) error {
+	var err error
+	for attempt := 0; attempt < 5; attempt++ {
+		err = s.updateOnce(ctx, id, fn)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+	return ErrConflict
+}
+
+func (s *redisStore) updateOnce(ctx context.Context, id string, fn func(*Job) error) error {
+	key := redisDataKey + id
+	return s.client.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := s.get(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		previous := current.Status
+		if err := fn(current); err != nil {
+			return err
+		}
+		current.UpdatedAt = time.Now()
+
+		data, err := json.Marshal(current)
+		if err != nil {
+			return pkgErrors.Wrap(err, "failed to encode job")
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, 0)
+			if previous != current.Status {
+				pipe.ZRem(ctx, redisStatusKey+string(previous), id)
+				pipe.ZAdd(ctx, redisStatusKey+string(current.Status), redis.Z{
+					Score:  float64(current.CreatedAt.UnixNano()),
+					Member: id,
+				})
+				if current.Status == StatusQueued {
+					pipe.LPush(ctx, redisQueueKey, id)
+				}
+			}
+			return nil
+		})
+		return err
+	}, key)
+}
+
+func (s *redisStore) load(ctx context.Context, ids []string) ([]*Job, error) {
+	jobs := make([]*Job, 0, len(ids))
+	for _, id := range ids {
+		j, err := s.Get(ctx, id)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,392 @@
+// Package keys manages the encrypted keystore v3 files of a keystore directory.
+// Keys are generated or imported into the directory, carry labels and roles in the
+// database and sign through go-ethereum's keystore once unlocked, so other packages
+// can reference them as key:<address> or role:<name> signers.
+package keys
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"os"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/db"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethKeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignerKeyPrefix prefixes signer names that reference a managed key, e.g. key:0xabc...
+	SignerKeyPrefix = "key:"
+	// SignerRolePrefix prefixes signer names that reference the key holding a role, e.g. role:erc721-minter
+	SignerRolePrefix = "role:"
+)
+
+// Settings holds the effective key management settings
+type Settings struct {
+	// Dir is the keystore directory of managed keys
+	Dir string
+	// ScryptN is the scrypt N used to encrypt keys
+	ScryptN int
+	// ScryptP is the scrypt P used to encrypt keys
+	ScryptP int
+}
+
+var (
+	// settings stores the effective key management settings
+	settings Settings
+	// store is the keystore of the managed directory, nil when key management is disabled
+	store *ethKeystore.KeyStore
+	// initOnce ensures the keystore is opened only once
+	initOnce sync.Once
+)
+
+// Key is the metadata of a managed key; the key material stays in the keystore directory
+type Key struct {
+	Address   string    `gorm:"primaryKey;size:42" json:"address"`
+	Label     string    `gorm:"size:128;index" json:"label"`
+	Disabled  bool      `gorm:"index" json:"disabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for managed keys
+func (Key) TableName() string {
+	return "managed_keys"
+}
+
+// Role assigns a named role to a managed key
+type Role struct {
+	Name      string    `gorm:"primaryKey;size:64" json:"name"`
+	Address   string    `gorm:"size:42;index" json:"address"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for key roles
+func (Role) TableName() string {
+	return "key_roles"
+}
+
+// ListFilter restricts the keys returned by List
+type ListFilter struct {
+	Label           string
+	IncludeDisabled bool
+	Limit           int
+	Offset          int
+}
+
+// Init opens the managed keystore directory, migrates the key tables and registers the
+// key: and role: signer prefixes. Key management stays disabled without a directory.
+//
+// Parameters:
+//   - ctx: Context for the migration
+//   - cfg: Key management configuration (optional)
+//   - logger: Logger instance for key management logging
+//
+// Returns:
+//   - error: Error if the migration fails
+func Init(ctx context.Context, cfg *conf.Keys, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if cfg.GetDir() == "" {
+			return
+		}
+		settings.Dir = cfg.GetDir()
+		settings.ScryptN = int(cfg.GetScryptN())
+		if settings.ScryptN <= 0 {
+			settings.ScryptN = ethKeystore.StandardScryptN
+		}
+		settings.ScryptP = int(cfg.GetScryptP())
+		if settings.ScryptP <= 0 {
+			settings.ScryptP = ethKeystore.StandardScryptP
+		}
+
+		gdb := db.Get().WithContext(ctx)
+		if err := gdb.AutoMigrate(&Key{}, &Role{}, &Rotation{}, &RotationTransfer{}); err != nil {
+			initErr = errors.Wrap(err, "failed to migrate key tables")
+			return
+		}
+		store = ethKeystore.NewKeyStore(settings.Dir, settings.ScryptN, settings.ScryptP)
+
+		// Keys copied into the directory by hand are listed without a label
+		for _, account := range store.Accounts() {
+			if err := gdb.Where("address = ?", account.Address.Hex()).FirstOrCreate(&Key{
+				Address:   account.Address.Hex(),
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			}).Error; err != nil {
+				initErr = errors.Wrap(err, "failed to record existing keys")
+				return
+			}
+		}
+
+		keystore.RegisterResolver(SignerKeyPrefix, resolveKey)
+		keystore.RegisterResolver(SignerRolePrefix, resolveRole)
+
+		log.NewHelper(logger).Infof("key management initialized: dir=%s, keys=%d, scrypt_n=%d, scrypt_p=%d",
+			settings.Dir, len(store.Accounts()), settings.ScryptN, settings.ScryptP)
+	})
+	return initErr
+}
+
+// GetSettings returns the effective key management settings
+func GetSettings() Settings {
+	return settings
+}
+
+// Enabled reports whether a managed keystore directory is configured
+func Enabled() bool {
+	return store != nil
+}
+
+// Generate creates a new key encrypted with password and records it.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - password: Password protecting the key file
+//   - label: Label of the key (optional)
+//
+// Returns:
+//   - *Key: The recorded key
+//   - error: Error if key management is disabled or the key cannot be stored
+func Generate(ctx context.Context, password, label string) (*Key, error) {
+	if store == nil {
+		return nil, appErrors.ErrKeysDisabled
+	}
+	account, err := store.NewAccount(password)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+	return record(ctx, account.Address, label)
+}
+
+// Import stores a raw private key encrypted with password and records it.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - key: Private key to import
+//   - password: Password protecting the key file
+//   - label: Label of the key (optional)
+//
+// Returns:
+//   - *Key: The recorded key
+//   - error: Error if the key already exists or cannot be stored
+func Import(ctx context.Context, key *ecdsa.PrivateKey, password, label string) (*Key, error) {
+	if store == nil {
+		return nil, appErrors.ErrKeysDisabled
+	}
+	account, err := store.ImportECDSA(key, password)
+	if err != nil {
+		return nil, importError(err)
+	}
+	return record(ctx, account.Address, label)
+}
+
+// ImportJSON stores a keystore v3 file re-encrypted with newPassword and records it.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - keyJSON: Keystore v3 JSON
+//   - password: Password of the keystore JSON
+//   - newPassword: Password protecting the stored key file
+//   - label: Label of the key (optional)
+//
+// Returns:
+//   - *Key: The recorded key
+//   - error: Error if the password is wrong, the key already exists or cannot be stored
+func ImportJSON(ctx context.Context, keyJSON []byte, password, newPassword, label string) (*Key, error) {
+	if store == nil {
+		return nil, appErrors.ErrKeysDisabled
+	}
+	account, err := store.Import(keyJSON, password, newPassword)
+	if err != nil {
+		return nil, importError(err)
+	}
+	return record(ctx, account.Address, label)
+}
+
+// Export returns the keystore v3 JSON of a key re-encrypted with newPassword.
+// The key material never leaves the process unencrypted.
+func Export(ctx context.Context, addr common.Address, password, newPassword string) ([]byte, error) {
+	account, err := find(addr)
+	if err != nil {
+		return nil, err
+	}
+	keyJSON, err := store.Export(account, password, newPassword)
+	if err != nil {
+		return nil, passwordError(err, "failed to export key")
+	}
+	return keyJSON, nil
+}
+
+// ChangePassword re-encrypts a key file with newPassword
+func ChangePassword(ctx context.Context, addr common.Address, password, newPassword string) error {
+	account, err := find(addr)
+	if err != nil {
+		return err
+	}
+	if err := store.Update(account, password, newPassword); err != nil {
+		return passwordError(err, "failed to change key password")
+	}
+	return nil
+}
+
+// Unlock decrypts a key for signing for the given duration; zero keeps it unlocked until
+// it is locked again or the process exits. Disabled keys cannot be unlocked.
+func Unlock(ctx context.Context, addr common.Address, password string, duration time.Duration) error {
+	key, err := Get(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if key.Disabled {
+		return appErrors.ErrKeyDisabled
+	}
+	account, err := find(addr)
+	if err != nil {
+		return err
+	}
+	if err := store.TimedUnlock(account, password, duration); err != nil {
+		return passwordError(err, "failed to unlock key")
+	}
+	return nil
+}
+
+// Lock removes the decrypted key from memory
+func Lock(addr common.Address) error {
+	if store == nil {
+		return appErrors.ErrKeysDisabled
+	}
+	if _, err := find(addr); err != nil {
+		return err
+	}
+	return store.Lock(addr)
+}
+
+// Get returns the metadata of a managed key
+func Get(ctx context.Context, addr common.Address) (*Key, error) {
+	if store == nil {
+		return nil, appErrors.ErrKeysDisabled
+	}
+	var key Key
+	res := db.Get().WithContext(ctx).Where("address = ?", addr.Hex()).Limit(1).Find(&key)
+	if res.Error != nil {
+		return nil, errors.Wrap(res.Error, "failed to get key")
+	}
+	if res.RowsAffected == 0 {
+		return nil, appErrors.ErrKeyNotFound
+	}
+	return &key, nil
+}
+
+// List returns managed keys matching the filter, oldest first, and the total count
+func List(ctx context.Context, filter ListFilter) ([]*Key, int64, error) {
+	if store == nil {
+		return nil, 0, appErrors.ErrKeysDisabled
+	}
+	q := db.Get().WithContext(ctx).Model(&Key{})
+	if filter.Label != "" {
+		q = q.Where("label = ?", filter.Label)
+	}
+	if !filter.IncludeDisabled {
+		q = q.Where("disabled = ?", false)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count keys")
+	}
+	var keys []*Key
+	if err := q.Order("created_at ASC").Limit(filter.Limit).Offset(filter.Offset).Find(&keys).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list keys")
+	}
+	return keys, total, nil
+}
+
+// SetDisabled marks a key disabled or enabled again after checking its password.
+// Disabling also locks the key so that it stops signing immediately.
+func SetDisabled(ctx context.Context, addr common.Address, password string, disabled bool) (*Key, error) {
+	key, err := Get(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	account, err := find(addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyPassword(account, password); err != nil {
+		return nil, err
+	}
+	key.Disabled = disabled
+	key.UpdatedAt = time.Now()
+	if err := db.Get().WithContext(ctx).Save(key).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to update key")
+	}
+	if disabled {
+		_ = store.Lock(addr)
+	}
+	return key, nil
+}
+
+// verifyPassword checks the password of a key file without unlocking the key
+func verifyPassword(account accounts.Account, password string) error {
+	keyJSON, err := os.ReadFile(account.URL.Path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read key file")
+	}
+	if _, err := ethKeystore.DecryptKey(keyJSON, password); err != nil {
+		return passwordError(err, "failed to verify key password")
+	}
+	return nil
+}
+
+// record stores the metadata of a key added to the directory
+func record(ctx context.Context, addr common.Address, label string) (*Key, error) {
+	now := time.Now()
+	key := &Key{
+		Address:   addr.Hex(),
+		Label:     label,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := db.Get().WithContext(ctx).Create(key).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to record key")
+	}
+	return key, nil
+}
+
+// find returns the keystore account of a managed key
+func find(addr common.Address) (accounts.Account, error) {
+	if store == nil {
+		return accounts.Account{}, appErrors.ErrKeysDisabled
+	}
+	account, err := store.Find(accounts.Account{Address: addr})
+	if err != nil {
+		return accounts.Account{}, appErrors.ErrKeyNotFound
+	}
+	return account, nil
+}
+
+// importError maps keystore import errors to application errors
+func importError(err error) error {
+	if errors.Is(err, ethKeystore.ErrAccountAlreadyExists) {
+		return appErrors.ErrKeyExists
+	}
+	return passwordError(err, "failed to import key")
+}
+
+// passwordError maps keystore decryption errors to application errors
+func passwordError(err error, msg string) error {
+	if errors.Is(err, ethKeystore.ErrDecrypt) {
+		return appErrors.ErrInvalidKeyPassword
+	}
+	return errors.Wrap(err, msg)
+}
@@ -0,0 +1,129 @@
+package keys
+
+import (
+	"context"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/db"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// RotationStatus represents the state of a role rotation
+type RotationStatus string
+
+const (
+	// RotationInProgress means ownership transfers are still being sent
+	RotationInProgress RotationStatus = "in_progress"
+	// RotationCompleted means the role was moved to the new key
+	RotationCompleted RotationStatus = "completed"
+)
+
+// Rotation records the progress of moving a role to another key, so that a rotation
+// interrupted by a failed ownership transfer can be resumed
+type Rotation struct {
+	ID          string         `gorm:"primaryKey;size:36" json:"id"`
+	Role        string         `gorm:"size:64;index" json:"role"`
+	FromAddress string         `gorm:"size:42" json:"from_address"`
+	ToAddress   string         `gorm:"size:42" json:"to_address"`
+	Status      RotationStatus `gorm:"size:16;index" json:"status"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// TableName returns the table name for role rotations
+func (Rotation) TableName() string {
+	return "role_rotations"
+}
+
+// RotationTransfer is the ownership transfer of one contract within a rotation
+type RotationTransfer struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	RotationID      string    `gorm:"size:36;uniqueIndex:idx_rotation_contract" json:"rotation_id"`
+	ContractAddress string    `gorm:"size:42;uniqueIndex:idx_rotation_contract" json:"contract_address"`
+	TxHash          string    `gorm:"size:66" json:"tx_hash"`
+	Error           string    `gorm:"type:text" json:"error"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for rotation ownership transfers
+func (RotationTransfer) TableName() string {
+	return "role_rotation_transfers"
+}
+
+// StartRotation returns the unfinished rotation of a role to the key, or records a new
+// one. A role with an unfinished rotation to another key cannot be rotated.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - name: Role name
+//   - from: Address of the current holder (empty when the role is new)
+//   - to: Address of the key taking the role
+//
+// Returns:
+//   - *Rotation: The rotation to continue
+//   - map[common.Address]*RotationTransfer: Transfers recorded so far by contract
+//   - error: Error if another rotation of the role is unfinished
+func StartRotation(ctx context.Context, name, from string, to common.Address) (*Rotation, map[common.Address]*RotationTransfer, error) {
+	if store == nil {
+		return nil, nil, appErrors.ErrKeysDisabled
+	}
+	gdb := db.Get().WithContext(ctx)
+
+	var rotation Rotation
+	res := gdb.Where("role = ? AND status = ?", name, RotationInProgress).Limit(1).Find(&rotation)
+	if res.Error != nil {
+		return nil, nil, errors.Wrap(res.Error, "failed to get role rotation")
+	}
+	if res.RowsAffected == 0 {
+		now := time.Now()
+		rotation = Rotation{
+			ID:          uuid.NewString(),
+			Role:        name,
+			FromAddress: from,
+			ToAddress:   to.Hex(),
+			Status:      RotationInProgress,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if err := gdb.Create(&rotation).Error; err != nil {
+			return nil, nil, errors.Wrap(err, "failed to record role rotation")
+		}
+		return &rotation, map[common.Address]*RotationTransfer{}, nil
+	}
+	if rotation.ToAddress != to.Hex() {
+		return nil, nil, appErrors.FailedPrecondition("role %s has an unfinished rotation to %s", name, rotation.ToAddress)
+	}
+
+	var transfers []*RotationTransfer
+	if err := gdb.Where("rotation_id = ?", rotation.ID).Find(&transfers).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get role rotation transfers")
+	}
+	byContract := make(map[common.Address]*RotationTransfer, len(transfers))
+	for _, t := range transfers {
+		byContract[common.HexToAddress(t.ContractAddress)] = t
+	}
+	return &rotation, byContract, nil
+}
+
+// SaveTransfer records the result of an ownership transfer of a rotation
+func SaveTransfer(ctx context.Context, transfer *RotationTransfer) error {
+	transfer.UpdatedAt = time.Now()
+	if err := db.Get().WithContext(ctx).Save(transfer).Error; err != nil {
+		return errors.Wrapf(err, "failed to record ownership transfer of %s", transfer.ContractAddress)
+	}
+	return nil
+}
+
+// CompleteRotation marks a rotation completed once the role was moved
+func CompleteRotation(ctx context.Context, rotation *Rotation) error {
+	rotation.Status = RotationCompleted
+	rotation.UpdatedAt = time.Now()
+	if err := db.Get().WithContext(ctx).Save(rotation).Error; err != nil {
+		return errors.Wrap(err, "failed to complete role rotation")
+	}
+	return nil
+}
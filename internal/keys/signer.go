@@ -0,0 +1,184 @@
+package keys
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/db"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethKeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+)
+
+// AssignRole moves a role to a managed key and returns the key that held it before,
+// empty when the role is new. Disabled keys cannot take a role.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - name: Role name, e.g. erc721-minter
+//   - addr: Address of the managed key taking the role
+//
+// Returns:
+//   - string: Address of the previous holder
+//   - error: Error if the key is unknown or disabled
+func AssignRole(ctx context.Context, name string, addr common.Address) (string, error) {
+	key, err := Get(ctx, addr)
+	if err != nil {
+		return "", err
+	}
+	if key.Disabled {
+		return "", appErrors.ErrKeyDisabled
+	}
+
+	previous, err := GetRole(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	role := &Role{Name: name, Address: key.Address, CreatedAt: now, UpdatedAt: now}
+	if previous != nil {
+		role.CreatedAt = previous.CreatedAt
+	}
+	if err := db.Get().WithContext(ctx).Save(role).Error; err != nil {
+		return "", errors.Wrap(err, "failed to assign role")
+	}
+	if previous == nil {
+		return "", nil
+	}
+	return previous.Address, nil
+}
+
+// GetRole returns a role assignment, nil when the role is not assigned
+func GetRole(ctx context.Context, name string) (*Role, error) {
+	if store == nil {
+		return nil, appErrors.ErrKeysDisabled
+	}
+	var role Role
+	res := db.Get().WithContext(ctx).Where("name = ?", name).Limit(1).Find(&role)
+	if res.Error != nil {
+		return nil, errors.Wrap(res.Error, "failed to get role")
+	}
+	if res.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &role, nil
+}
+
+// Roles returns all role assignments ordered by name
+func Roles(ctx context.Context) ([]*Role, error) {
+	if store == nil {
+		return nil, appErrors.ErrKeysDisabled
+	}
+	var roles []*Role
+	if err := db.Get().WithContext(ctx).Order("name ASC").Find(&roles).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to list roles")
+	}
+	return roles, nil
+}
+
+// Signer returns a signer for a managed key. The key must be enabled and unlocked
+// when signing.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - addr: Address of the managed key
+//
+// Returns:
+//   - keystore.Signer: The signer
+//   - error: Error if the key is unknown or disabled
+func Signer(ctx context.Context, addr common.Address) (keystore.Signer, error) {
+	key, err := Get(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if key.Disabled {
+		return nil, appErrors.ErrKeyDisabled
+	}
+	account, err := find(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &managedSigner{account: account}, nil
+}
+
+// resolveKey resolves key:<address> signer names
+func resolveKey(name string) (keystore.Signer, error) {
+	addr := strings.TrimPrefix(name, SignerKeyPrefix)
+	if !common.IsHexAddress(addr) {
+		return nil, errors.Errorf("invalid key signer: %s", name)
+	}
+	return Signer(context.Background(), common.HexToAddress(addr))
+}
+
+// resolveRole resolves role:<name> signer names to the key holding the role
+func resolveRole(name string) (keystore.Signer, error) {
+	ctx := context.Background()
+	role, err := GetRole(ctx, strings.TrimPrefix(name, SignerRolePrefix))
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, errors.Errorf("role is not assigned: %s", name)
+	}
+	return Signer(ctx, common.HexToAddress(role.Address))
+}
+
+// managedSigner signs with a key of the managed keystore.
+// The keystore only signs while the key is unlocked.
+type managedSigner struct {
+	account accounts.Account
+}
+
+// Address returns the account address of the signer
+func (s *managedSigner) Address() common.Address {
+	return s.account.Address
+}
+
+// SignTx signs a transaction for the given chain
+func (s *managedSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := store.SignTx(s.account, tx, chainID)
+	if err != nil {
+		return nil, signError(err)
+	}
+	return signed, nil
+}
+
+// SignTypedData signs an EIP-712 typed data document
+func (s *managedSigner) SignTypedData(ctx context.Context, td apitypes.TypedData) ([]byte, error) {
+	digest, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash typed data")
+	}
+	return s.signDigest(digest)
+}
+
+// SignText signs an EIP-191 personal message
+func (s *managedSigner) SignText(ctx context.Context, message []byte) ([]byte, error) {
+	return s.signDigest(accounts.TextHash(message))
+}
+
+// signDigest signs a digest and returns the signature with v in {27, 28}
+func (s *managedSigner) signDigest(digest []byte) ([]byte, error) {
+	sig, err := store.SignHash(s.account, digest)
+	if err != nil {
+		return nil, signError(err)
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+// signError maps keystore signing errors to application errors
+func signError(err error) error {
+	if errors.Is(err, ethKeystore.ErrLocked) {
+		return appErrors.ErrKeyLocked
+	}
+	return errors.Wrap(err, "failed to sign with managed key")
+}
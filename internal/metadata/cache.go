@@ -0,0 +1,90 @@
+package metadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"eth-contract-service/provider/cache"
+)
+
+// redisKeyPrefix prefixes metadata cache keys in Redis
+const redisKeyPrefix = "metadata:"
+
+// memoryEntry is a metadata result cached in process memory
+type memoryEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+var (
+	// memory caches results when Redis is not available
+	memory   = make(map[string]memoryEntry)
+	memoryMu sync.Mutex
+)
+
+// cacheGet returns the cached result of a URI, or nil on a miss.
+// Redis is used when it is initialized, otherwise an in-process cache.
+func cacheGet(ctx context.Context, uri string) *Result {
+	key := cacheKey(uri)
+
+	var data []byte
+	if client := cache.GetRedisClient(); client != nil {
+		b, err := client.Get(ctx, key).Bytes()
+		if err != nil {
+			return nil
+		}
+		data = b
+	} else {
+		memoryMu.Lock()
+		entry, ok := memory[key]
+		if ok && time.Now().After(entry.expiresAt) {
+			delete(memory, key)
+			ok = false
+		}
+		memoryMu.Unlock()
+		if !ok {
+			return nil
+		}
+		data = entry.data
+	}
+
+	var res Result
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil
+	}
+	return &res
+}
+
+// cacheSet stores the result of a URI; failures only cost a refetch and are ignored
+func cacheSet(ctx context.Context, uri string, res *Result) {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return
+	}
+	key := cacheKey(uri)
+
+	if client := cache.GetRedisClient(); client != nil {
+		client.Set(ctx, key, data, settings.CacheTTL)
+		return
+	}
+
+	now := time.Now()
+	memoryMu.Lock()
+	defer memoryMu.Unlock()
+	for k, entry := range memory {
+		if now.After(entry.expiresAt) {
+			delete(memory, k)
+		}
+	}
+	memory[key] = memoryEntry{data: data, expiresAt: now.Add(settings.CacheTTL)}
+}
+
+// cacheKey hashes the URI so that long data: URIs do not become long keys
+func cacheKey(uri string) string {
+	sum := sha256.Sum256([]byte(uri))
+	return redisKeyPrefix + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,176 @@
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxRedirects is the number of redirects a metadata fetch follows
+const maxRedirects = 5
+
+var (
+	// httpClient fetches URIs taken from contracts, directly or through the configured
+	// gateways; timeouts are applied per request. It only connects to public addresses,
+	// checked after DNS resolution and on every redirect, so that token URIs cannot reach
+	// the service's own network.
+	httpClient = &http.Client{
+		Transport: &http.Transport{
+			DialContext:         (&net.Dialer{Timeout: 10 * time.Second, Control: publicOnly}).DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: checkRedirect,
+	}
+	// blockedPrefixes are non-public ranges not covered by the net.IP predicates
+	blockedPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+		netip.MustParsePrefix("64:ff9b::/96"),
+	}
+)
+
+// GatewayURL rewrites ipfs:// and ar:// URIs to the configured HTTP gateways.
+// Other URIs are returned unchanged.
+func GatewayURL(uri string) string {
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		path := strings.TrimPrefix(uri, "ipfs://")
+		path = strings.TrimPrefix(path, "ipfs/") // ipfs://ipfs/<cid> is common in the wild
+		return settings.IPFSGateway + path
+	case strings.HasPrefix(uri, "ar://"):
+		return settings.ArweaveGateway + strings.TrimPrefix(uri, "ar://")
+	default:
+		return uri
+	}
+}
+
+// fetch returns the document a token URI points to
+func fetch(ctx context.Context, uri string) ([]byte, error) {
+	if strings.HasPrefix(uri, "data:") {
+		return decodeDataURI(uri)
+	}
+
+	target := GatewayURL(uri)
+	if !isHTTP(target) {
+		return nil, errors.Errorf("unsupported metadata URI scheme: %s", uri)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, settings.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid metadata URI")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch metadata from %s", target)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch metadata from %s: status %d", target, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, settings.MaxSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read metadata from %s", target)
+	}
+	if int64(len(body)) > settings.MaxSize {
+		return nil, errors.Errorf("metadata from %s exceeds %d bytes", target, settings.MaxSize)
+	}
+	return body, nil
+}
+
+// checkRedirect limits the redirects of a fetch and keeps them on http(s).
+// The address of every hop is checked again when it is dialed.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return errors.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if !isHTTP(req.URL.String()) {
+		return errors.Errorf("unsupported redirect URI scheme: %s", req.URL.Scheme)
+	}
+	return nil
+}
+
+// publicOnly is a dialer control hook that refuses connections to loopback, private,
+// link-local, multicast and unspecified addresses
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return errors.Wrapf(err, "invalid metadata host address %s", host)
+	}
+	if !isPublic(addr.Unmap()) {
+		return errors.Errorf("metadata host address %s is not public", host)
+	}
+	return nil
+}
+
+// isPublic reports whether addr is a globally routable unicast address
+func isPublic(addr netip.Addr) bool {
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return false
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeDataURI decodes an RFC 2397 data URI (base64 or percent-encoded)
+func decodeDataURI(uri string) ([]byte, error) {
+	header, payload, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok {
+		return nil, errors.New("invalid data URI: missing comma")
+	}
+
+	var body []byte
+	if strings.HasSuffix(header, ";base64") {
+		// Reject oversized payloads before allocating their decoded form
+		if int64(len(payload)) > int64(base64.StdEncoding.EncodedLen(int(settings.MaxSize))) {
+			return nil, errors.Errorf("data URI exceeds %d bytes", settings.MaxSize)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid base64 data URI")
+		}
+		body = decoded
+	} else {
+		decoded, err := url.PathUnescape(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid data URI")
+		}
+		body = []byte(decoded)
+	}
+
+	if int64(len(body)) > settings.MaxSize {
+		return nil, errors.Errorf("data URI exceeds %d bytes", settings.MaxSize)
+	}
+	return body, nil
+}
+
+// isHTTP reports whether the URI uses http or https
+func isHTTP(uri string) bool {
+	return strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://")
+}
@@ -0,0 +1,256 @@
+// Package metadata resolves NFT metadata from tokenURI / uri values.
+// It expands the EIP-1155 {id} placeholder, fetches http(s), ipfs://, ar:// and data: URIs,
+// normalizes the JSON document and caches the result.
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Settings holds the effective metadata resolution settings
+type Settings struct {
+	// IPFSGateway is the gateway prefix used for ipfs:// URIs
+	IPFSGateway string
+	// ArweaveGateway is the gateway prefix used for ar:// URIs
+	ArweaveGateway string
+	// Timeout bounds a single fetch
+	Timeout time.Duration
+	// CacheTTL is how long resolved metadata is cached
+	CacheTTL time.Duration
+	// MaxSize is the maximum metadata document size in bytes
+	MaxSize int64
+}
+
+var (
+	// settings stores the effective metadata resolution settings
+	settings = Settings{
+		IPFSGateway:    "https://ipfs.io/ipfs/",
+		ArweaveGateway: "https://arweave.net/",
+		Timeout:        10 * time.Second,
+		CacheTTL:       time.Hour,
+		MaxSize:        1 << 20,
+	}
+	// initOnce ensures the settings are applied only once
+	initOnce sync.Once
+)
+
+// Attribute is a normalized metadata attribute
+type Attribute struct {
+	TraitType   string `json:"trait_type"`
+	Value       string `json:"value"`
+	DisplayType string `json:"display_type,omitempty"`
+}
+
+// Metadata is a normalized NFT metadata document
+type Metadata struct {
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Image        string      `json:"image"`
+	AnimationURL string      `json:"animation_url"`
+	ExternalURL  string      `json:"external_url"`
+	Attributes   []Attribute `json:"attributes"`
+	Raw          string      `json:"raw"` // original JSON document
+}
+
+// Result is the outcome of resolving a token URI
+type Result struct {
+	// URI is the token URI after {id} expansion
+	URI string `json:"uri"`
+	// Metadata is the normalized metadata document
+	Metadata *Metadata `json:"metadata"`
+	// FetchedAt is when the document was fetched
+	FetchedAt time.Time `json:"fetched_at"`
+	// Cached reports whether the result was served from the cache
+	Cached bool `json:"-"`
+}
+
+// Init applies the metadata resolution settings.
+//
+// Parameters:
+//   - cfg: Metadata configuration (optional)
+//   - logger: Logger instance for metadata logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Init(cfg *conf.Metadata, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if cfg != nil {
+			if cfg.IpfsGateway != "" {
+				if !isHTTP(cfg.IpfsGateway) {
+					initErr = errors.Errorf("invalid metadata.ipfs_gateway: %s", cfg.IpfsGateway)
+					return
+				}
+				settings.IPFSGateway = withSlash(cfg.IpfsGateway)
+			}
+			if cfg.ArweaveGateway != "" {
+				if !isHTTP(cfg.ArweaveGateway) {
+					initErr = errors.Errorf("invalid metadata.arweave_gateway: %s", cfg.ArweaveGateway)
+					return
+				}
+				settings.ArweaveGateway = withSlash(cfg.ArweaveGateway)
+			}
+			if cfg.Timeout != nil && cfg.Timeout.AsDuration() > 0 {
+				settings.Timeout = cfg.Timeout.AsDuration()
+			}
+			if cfg.CacheTtl != nil && cfg.CacheTtl.AsDuration() > 0 {
+				settings.CacheTTL = cfg.CacheTtl.AsDuration()
+			}
+			if cfg.MaxSize > 0 {
+				settings.MaxSize = cfg.MaxSize
+			}
+		}
+
+		log.NewHelper(logger).Infof("metadata resolver initialized: ipfs_gateway=%s, arweave_gateway=%s, cache_ttl=%v",
+			settings.IPFSGateway, settings.ArweaveGateway, settings.CacheTTL)
+	})
+
+	return initErr
+}
+
+// GetSettings returns the effective metadata resolution settings.
+func GetSettings() Settings {
+	return settings
+}
+
+// ExpandID substitutes the EIP-1155 {id} placeholder with the token ID as
+// lowercase hex, zero-padded to 64 characters.
+func ExpandID(uri string, tokenID *big.Int) string {
+	if !strings.Contains(uri, "{id}") {
+		return uri
+	}
+	return strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", tokenID))
+}
+
+// Resolve fetches and normalizes the metadata document of a token URI.
+// Results are cached by URI; refresh bypasses the cache and stores the fresh result.
+//
+// Parameters:
+//   - ctx: Context for the fetch and cache operations
+//   - uri: Token URI as returned by tokenURI / uri (may contain {id})
+//   - tokenID: Token ID used to expand {id}
+//   - refresh: Fetch again even if a cached result exists
+//
+// Returns:
+//   - *Result: The resolved metadata
+//   - error: Error if the URI is unsupported or the document cannot be fetched or parsed
+func Resolve(ctx context.Context, uri string, tokenID *big.Int, refresh bool) (*Result, error) {
+	uri = strings.TrimSpace(ExpandID(uri, tokenID))
+	if uri == "" {
+		return nil, errors.New("token URI is empty")
+	}
+
+	if !refresh {
+		if res := cacheGet(ctx, uri); res != nil {
+			res.Cached = true
+			return res, nil
+		}
+	}
+
+	body, err := fetch(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	md, err := Normalize(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{URI: uri, Metadata: md, FetchedAt: time.Now()}
+	cacheSet(ctx, uri, res)
+	return res, nil
+}
+
+// Normalize parses a metadata JSON document into the normalized form.
+// Common variants are accepted: image_url / image_data for image, animation for
+// animation_url, and numeric or boolean attribute values.
+func Normalize(body []byte) (*Metadata, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid metadata JSON")
+	}
+
+	md := &Metadata{
+		Name:         str(doc["name"]),
+		Description:  str(doc["description"]),
+		Image:        first(doc, "image", "image_url", "image_data"),
+		AnimationURL: first(doc, "animation_url", "animation"),
+		ExternalURL:  first(doc, "external_url", "external_link"),
+		Attributes:   []Attribute{},
+		Raw:          string(body),
+	}
+	md.Image = GatewayURL(md.Image)
+	md.AnimationURL = GatewayURL(md.AnimationURL)
+
+	switch attrs := doc["attributes"].(type) {
+	case []interface{}:
+		for _, a := range attrs {
+			m, ok := a.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			md.Attributes = append(md.Attributes, Attribute{
+				TraitType:   first(m, "trait_type", "key", "name"),
+				Value:       str(m["value"]),
+				DisplayType: str(m["display_type"]),
+			})
+		}
+	case map[string]interface{}:
+		// Some collections use an object of trait -> value
+		keys := make([]string, 0, len(attrs))
+		for k := range attrs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			md.Attributes = append(md.Attributes, Attribute{TraitType: k, Value: str(attrs[k])})
+		}
+	}
+
+	return md, nil
+}
+
+// first returns the first non-empty string value of the given keys
+func first(m map[string]interface{}, keys ...string) string {
+	for _, k := range keys {
+		if v := str(m[k]); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// str converts a JSON scalar to a string
+func str(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		return ""
+	}
+}
+
+// withSlash ensures a gateway prefix ends with a slash
+func withSlash(s string) string {
+	if strings.HasSuffix(s, "/") {
+		return s
+	}
+	return s + "/"
+}
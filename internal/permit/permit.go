@@ -0,0 +1,173 @@
+// Package permit builds, signs and verifies EIP-2612 permit messages.
+package permit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/provider/contract/erc20"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	pkgErrors "github.com/pkg/errors"
+)
+
+// DefaultVersion is the EIP-712 domain version used by OpenZeppelin ERC20Permit
+const DefaultVersion = "1"
+
+// Permit is an EIP-2612 permit message
+type Permit struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+// permitTypes are the EIP-712 types of the Permit message
+var permitTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Permit": {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// Domain resolves the EIP-712 domain of a permit token.
+// The domain is read from eip712Domain() (EIP-5267) when available, otherwise it is built
+// from name() and the given version. The result is checked against DOMAIN_SEPARATOR().
+//
+// Parameters:
+//   - ctx: Context for the contract calls
+//   - token: Permit contract binding
+//   - contractAddr: Token contract address
+//   - version: Domain version override (empty uses eip712Domain() or DefaultVersion)
+//
+// Returns:
+//   - apitypes.TypedDataDomain: The verified domain
+//   - error: ErrPermitDomainMismatch if the domain does not match the contract
+func Domain(ctx context.Context, token *erc20.ERC20Permit, contractAddr common.Address, version string) (apitypes.TypedDataDomain, error) {
+	chainID := eth.GetChainID()
+	if chainID == nil {
+		return apitypes.TypedDataDomain{}, errors.ErrChainIDNotConfigured
+	}
+	opts := eth.NewCallOpts(ctx, nil)
+
+	domain := apitypes.TypedDataDomain{
+		ChainId:           (*math.HexOrDecimal256)(chainID),
+		VerifyingContract: contractAddr.Hex(),
+	}
+	if d, err := token.Eip712Domain(opts); err == nil {
+		domain.Name = d.Name
+		domain.Version = d.Version
+	} else {
+		name, err := token.Name(opts)
+		if err != nil {
+			return apitypes.TypedDataDomain{}, pkgErrors.Wrap(err, "failed to get token name")
+		}
+		domain.Name = name
+		domain.Version = DefaultVersion
+	}
+	if version != "" {
+		domain.Version = version
+	}
+
+	expected, err := token.DOMAINSEPARATOR(opts)
+	if err != nil {
+		return apitypes.TypedDataDomain{}, errors.WrapError(err, errors.CodeFailedPrecondition, "token does not support EIP-2612 permit")
+	}
+	td := apitypes.TypedData{Types: permitTypes, Domain: domain}
+	separator, err := td.HashStruct("EIP712Domain", domain.Map())
+	if err != nil {
+		return apitypes.TypedDataDomain{}, pkgErrors.Wrap(err, "failed to hash EIP-712 domain")
+	}
+	if common.BytesToHash(separator) != common.Hash(expected) {
+		return apitypes.TypedDataDomain{}, errors.ErrPermitDomainMismatch
+	}
+	return domain, nil
+}
+
+// Hash returns the EIP-712 digest of a permit
+func Hash(domain apitypes.TypedDataDomain, p *Permit) (common.Hash, error) {
+	td := apitypes.TypedData{
+		Types:       permitTypes,
+		PrimaryType: "Permit",
+		Domain:      domain,
+		Message: apitypes.TypedDataMessage{
+			"owner":    p.Owner.Hex(),
+			"spender":  p.Spender.Hex(),
+			"value":    (*math.HexOrDecimal256)(p.Value),
+			"nonce":    (*math.HexOrDecimal256)(p.Nonce),
+			"deadline": (*math.HexOrDecimal256)(p.Deadline),
+		},
+	}
+	digest, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		return common.Hash{}, pkgErrors.Wrap(err, "failed to hash permit")
+	}
+	return common.BytesToHash(digest), nil
+}
+
+// Sign signs a permit and returns the 65-byte signature with v in {27, 28}
+func Sign(key *ecdsa.PrivateKey, domain apitypes.TypedDataDomain, p *Permit) ([]byte, error) {
+	digest, err := Hash(domain, p)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to sign permit")
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+// Verify checks that the signature was produced by the permit owner
+func Verify(domain apitypes.TypedDataDomain, p *Permit, sig []byte) error {
+	digest, err := Hash(domain, p)
+	if err != nil {
+		return err
+	}
+	signer, err := keystore.RecoverSigner(digest, sig)
+	if err != nil || signer != p.Owner {
+		return errors.ErrPermitInvalidSignature
+	}
+	return nil
+}
+
+// Split splits a 65-byte signature into the v, r and s arguments of permit()
+func Split(sig []byte) (uint8, [32]byte, [32]byte) {
+	var r, s [32]byte
+	copy(r[:], sig[:32])
+	copy(s[:], sig[32:64])
+	v := sig[64]
+	if v < 27 {
+		v += 27
+	}
+	return v, r, s
+}
+
+// Join builds a 65-byte signature from its v, r and s components
+func Join(v uint8, r, s []byte) ([]byte, error) {
+	if len(r) != 32 || len(s) != 32 {
+		return nil, errors.ErrPermitInvalidSignature
+	}
+	sig := make([]byte, 0, 65)
+	sig = append(sig, r...)
+	sig = append(sig, s...)
+	return append(sig, v), nil
+}
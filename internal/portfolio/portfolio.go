@@ -0,0 +1,555 @@
+// Package portfolio reads the native balance and the token holdings of an address across
+// many contracts. Contracts are classified with the detect package, and the token reads
+// are sent as JSON-RPC batches pinned to a single block.
+package portfolio
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/detect"
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxContracts is the number of contracts a portfolio may cover
+	MaxContracts = 100
+	// maxTokenIDs is the number of token IDs listed per contract
+	maxTokenIDs = 1000
+)
+
+// Settings holds the effective portfolio settings
+type Settings struct {
+	// FromBlock is the first block scanned for received token IDs
+	FromBlock uint64
+	// StartBlocks are the deployment blocks per contract address or ethereum.contracts name
+	StartBlocks map[string]uint64
+	// LogRange is the number of blocks per eth_getLogs request
+	LogRange uint64
+}
+
+var (
+	// settings stores the effective portfolio settings
+	settings = Settings{LogRange: 10000}
+	// initOnce ensures the settings are applied only once
+	initOnce sync.Once
+)
+
+// tokenABI holds the token methods and events read for a portfolio.
+// The standard bindings lack the ERC721Enumerable methods, and reading both standards
+// through one ABI keeps the batch encoding in one place.
+const tokenABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"type":"uint8"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"type":"address"}],"outputs":[{"type":"uint256"}]},
+	{"type":"function","name":"tokenOfOwnerByIndex","stateMutability":"view","inputs":[{"type":"address"},{"type":"uint256"}],"outputs":[{"type":"uint256"}]},
+	{"type":"function","name":"ownerOf","stateMutability":"view","inputs":[{"type":"uint256"}],"outputs":[{"type":"address"}]},
+	{"type":"function","name":"balanceOfBatch","stateMutability":"view","inputs":[{"type":"address[]"},{"type":"uint256[]"}],"outputs":[{"type":"uint256[]"}]},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"TransferSingle","inputs":[{"name":"operator","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"id","type":"uint256"},{"name":"value","type":"uint256"}]},
+	{"type":"event","name":"TransferBatch","inputs":[{"name":"operator","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"ids","type":"uint256[]"},{"name":"values","type":"uint256[]"}]}
+]`
+
+// parsedABI is the parsed tokenABI
+var parsedABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(tokenABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// ERC20Holding is the balance of an ERC20 token
+type ERC20Holding struct {
+	Contract common.Address
+	Name     string
+	Symbol   string
+	Decimals uint8
+	// Balance is the balance in base units, nil when it could not be read
+	Balance *big.Int
+	// Error describes why the holding could not be read
+	Error string
+}
+
+// ERC721Holding is the tokens held of an ERC721 collection
+type ERC721Holding struct {
+	Contract common.Address
+	Name     string
+	Symbol   string
+	// Balance is the number of tokens held, nil when it could not be read
+	Balance *big.Int
+	// TokenIDs are the held token IDs in ascending order
+	TokenIDs []*big.Int
+	// Truncated reports whether more tokens are held than listed
+	Truncated bool
+	// Error describes why the holding or its token IDs could not be read
+	Error string
+}
+
+// TokenBalance is the balance of an ERC1155 token ID
+type TokenBalance struct {
+	ID      *big.Int
+	Balance *big.Int
+}
+
+// ERC1155Holding is the tokens held of an ERC1155 contract
+type ERC1155Holding struct {
+	Contract common.Address
+	// Tokens are the token IDs with a balance in ascending order
+	Tokens []TokenBalance
+	// Truncated reports whether more token IDs were received than checked
+	Truncated bool
+	// Error describes why the holding could not be read
+	Error string
+}
+
+// Portfolio is the native balance and the token holdings of an address at a block
+type Portfolio struct {
+	Address       common.Address
+	BlockNumber   *big.Int
+	BlockHash     common.Hash
+	NativeBalance *big.Int
+	ERC20         []*ERC20Holding
+	ERC721        []*ERC721Holding
+	ERC1155       []*ERC1155Holding
+	// Unsupported are the contracts that are not ERC20, ERC721 or ERC1155 tokens
+	Unsupported []common.Address
+}
+
+// Init applies the portfolio settings.
+//
+// Parameters:
+//   - cfg: Portfolio configuration (optional)
+//   - logger: Logger instance for portfolio logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Init(cfg *conf.Portfolio, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if cfg != nil {
+			settings.FromBlock = cfg.FromBlock
+			if cfg.LogRange > 0 {
+				settings.LogRange = cfg.LogRange
+			}
+			settings.StartBlocks = make(map[string]uint64, len(cfg.StartBlocks))
+			for key, block := range cfg.StartBlocks {
+				if key == "" {
+					initErr = errors.New("invalid portfolio.start_blocks: empty contract")
+					return
+				}
+				// Addresses are stored checksummed, names as configured
+				if common.IsHexAddress(key) {
+					key = common.HexToAddress(key).Hex()
+				}
+				settings.StartBlocks[key] = block
+			}
+		}
+
+		log.NewHelper(logger).Infof("portfolio initialized: from_block=%d, start_blocks=%d, log_range=%d",
+			settings.FromBlock, len(settings.StartBlocks), settings.LogRange)
+	})
+	return initErr
+}
+
+// GetSettings returns the effective portfolio settings.
+func GetSettings() Settings {
+	return settings
+}
+
+// startBlock returns the first block scanned for the transfers of a contract
+func startBlock(contract common.Address) uint64 {
+	if block, ok := settings.StartBlocks[contract.Hex()]; ok {
+		return block
+	}
+	for name, block := range settings.StartBlocks {
+		if !common.IsHexAddress(name) && eth.GetContractAddress(name) == contract {
+			return block
+		}
+	}
+	return settings.FromBlock
+}
+
+// ConfiguredContracts returns the distinct contracts of ethereum.contracts ordered by name
+func ConfiguredContracts() []common.Address {
+	cfg := eth.GetConfig()
+	names := make([]string, 0, len(cfg.GetContracts()))
+	for name := range cfg.GetContracts() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var contracts []common.Address
+	for _, name := range names {
+		addr := eth.GetContractAddress(name)
+		if addr != (common.Address{}) && !slices.Contains(contracts, addr) {
+			contracts = append(contracts, addr)
+		}
+	}
+	return contracts
+}
+
+// Get reads the portfolio of an address.
+// ERC721 token IDs are enumerated with ERC721Enumerable where supported; otherwise they,
+// like ERC1155 token IDs, are found from the transfers to the address and checked
+// against the current owner or balance.
+//
+// Parameters:
+//   - ctx: Context for the node queries
+//   - owner: Address whose holdings are read
+//   - contracts: Token contracts to read
+//
+// Returns:
+//   - *Portfolio: The holdings at the latest block
+//   - error: Error if the node cannot be queried
+func Get(ctx context.Context, owner common.Address, contracts []common.Address) (*Portfolio, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, appErrors.NodeUnavailable(eth.RetryAfter(), eth.LastError())
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest header")
+	}
+	p := &Portfolio{Address: owner, BlockNumber: head.Number, BlockHash: head.Hash()}
+	if p.NativeBalance, err = client.BalanceAt(ctx, owner, head.Number); err != nil {
+		return nil, errors.Wrap(err, "failed to get balance")
+	}
+
+	var enumerable []*ERC721Holding
+	for _, addr := range contracts {
+		res, err := detect.Detect(ctx, addr, false)
+		if err != nil {
+			return nil, err
+		}
+		switch res.Standard {
+		case detect.StandardERC20:
+			p.ERC20 = append(p.ERC20, &ERC20Holding{Contract: addr})
+		case detect.StandardERC721:
+			h := &ERC721Holding{Contract: addr}
+			p.ERC721 = append(p.ERC721, h)
+			if res.Supports("ERC721Enumerable") {
+				enumerable = append(enumerable, h)
+			}
+		case detect.StandardERC1155:
+			p.ERC1155 = append(p.ERC1155, &ERC1155Holding{Contract: addr})
+		default:
+			p.Unsupported = append(p.Unsupported, addr)
+		}
+	}
+
+	r := &reader{ctx: ctx, owner: owner, block: head.Number}
+	if err := r.readBalances(p); err != nil {
+		return nil, err
+	}
+	if err := r.readTokenIDs(p, enumerable); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// reader batches the contract calls of a portfolio at one block
+type reader struct {
+	ctx   context.Context
+	owner common.Address
+	block *big.Int
+	calls []*eth.Call
+	// decoders handle the results of calls in the same order
+	decoders []func(*eth.Call)
+}
+
+// add queues a call whose result is passed to decode
+func (r *reader) add(to common.Address, method string, decode func(*eth.Call), args ...any) {
+	data, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		// The ABI and the arguments are fixed by this package
+		panic(err)
+	}
+	r.calls = append(r.calls, &eth.Call{To: to, Data: data})
+	r.decoders = append(r.decoders, decode)
+}
+
+// flush sends the queued calls and decodes their results
+func (r *reader) flush() error {
+	calls, decoders := r.calls, r.decoders
+	r.calls, r.decoders = nil, nil
+	if len(calls) == 0 {
+		return nil
+	}
+	if err := eth.BatchCallContract(r.ctx, calls, r.block); err != nil {
+		return err
+	}
+	for i, call := range calls {
+		decoders[i](call)
+	}
+	return nil
+}
+
+// readBalances reads the ERC20 and ERC721 balances with their names and symbols
+func (r *reader) readBalances(p *Portfolio) error {
+	for _, h := range p.ERC20 {
+		r.add(h.Contract, "name", func(c *eth.Call) { h.Name = unpackString(c, "name") })
+		r.add(h.Contract, "symbol", func(c *eth.Call) { h.Symbol = unpackString(c, "symbol") })
+		r.add(h.Contract, "decimals", func(c *eth.Call) {
+			if v, err := unpack[uint8](c, "decimals"); err == nil {
+				h.Decimals = v
+			} else {
+				h.Error = err.Error()
+			}
+		})
+		r.add(h.Contract, "balanceOf", func(c *eth.Call) {
+			if v, err := unpack[*big.Int](c, "balanceOf"); err == nil {
+				h.Balance = v
+			} else {
+				h.Error = err.Error()
+			}
+		}, r.owner)
+	}
+	for _, h := range p.ERC721 {
+		r.add(h.Contract, "name", func(c *eth.Call) { h.Name = unpackString(c, "name") })
+		r.add(h.Contract, "symbol", func(c *eth.Call) { h.Symbol = unpackString(c, "symbol") })
+		r.add(h.Contract, "balanceOf", func(c *eth.Call) {
+			if v, err := unpack[*big.Int](c, "balanceOf"); err == nil {
+				h.Balance = v
+			} else {
+				h.Error = err.Error()
+			}
+		}, r.owner)
+	}
+	return r.flush()
+}
+
+// readTokenIDs lists the ERC721 token IDs and the ERC1155 balances held
+func (r *reader) readTokenIDs(p *Portfolio, enumerable []*ERC721Holding) error {
+	for _, h := range enumerable {
+		if h.Balance == nil {
+			continue
+		}
+		n := h.Balance.Int64()
+		if !h.Balance.IsInt64() || n > maxTokenIDs {
+			n, h.Truncated = maxTokenIDs, true
+		}
+		h.TokenIDs = make([]*big.Int, n)
+		for i := range h.TokenIDs {
+			r.add(h.Contract, "tokenOfOwnerByIndex", func(c *eth.Call) {
+				if v, err := unpack[*big.Int](c, "tokenOfOwnerByIndex"); err == nil {
+					h.TokenIDs[i] = v
+				} else {
+					h.Error = err.Error()
+				}
+			}, r.owner, big.NewInt(int64(i)))
+		}
+	}
+
+	// Token IDs of the other contracts are found from the transfers to the owner
+	var scanned []*ERC721Holding
+	for _, h := range p.ERC721 {
+		if !slices.Contains(enumerable, h) && h.Balance != nil && h.Balance.Sign() > 0 {
+			scanned = append(scanned, h)
+		}
+	}
+	received721, err721 := r.received(contractsOf(scanned, func(h *ERC721Holding) common.Address { return h.Contract }), "Transfer")
+	received1155, err1155 := r.received(contractsOf(p.ERC1155, func(h *ERC1155Holding) common.Address { return h.Contract }), "TransferSingle", "TransferBatch")
+
+	found := make(map[*ERC721Holding][]*big.Int)
+	for _, h := range scanned {
+		if err721 != nil {
+			h.Error = err721.Error()
+			continue
+		}
+		ids := received721[h.Contract]
+		if len(ids) > maxTokenIDs {
+			ids, h.Truncated = ids[:maxTokenIDs], true
+		}
+		for _, id := range ids {
+			r.add(h.Contract, "ownerOf", func(c *eth.Call) {
+				// Burned tokens revert and transferred ones have another owner
+				if v, err := unpack[common.Address](c, "ownerOf"); err == nil && v == r.owner {
+					found[h] = append(found[h], id)
+				}
+			}, id)
+		}
+	}
+	for _, h := range p.ERC1155 {
+		if err1155 != nil {
+			h.Error = err1155.Error()
+			continue
+		}
+		ids := received1155[h.Contract]
+		if len(ids) == 0 {
+			continue
+		}
+		if len(ids) > maxTokenIDs {
+			ids, h.Truncated = ids[:maxTokenIDs], true
+		}
+		owners := make([]common.Address, len(ids))
+		for i := range owners {
+			owners[i] = r.owner
+		}
+		r.add(h.Contract, "balanceOfBatch", func(c *eth.Call) {
+			balances, err := unpack[[]*big.Int](c, "balanceOfBatch")
+			if err == nil && len(balances) != len(ids) {
+				err = errors.New("failed to decode balanceOfBatch")
+			}
+			if err != nil {
+				h.Error = err.Error()
+				return
+			}
+			for i, b := range balances {
+				if b.Sign() > 0 {
+					h.Tokens = append(h.Tokens, TokenBalance{ID: ids[i], Balance: b})
+				}
+			}
+		}, owners, ids)
+	}
+	if err := r.flush(); err != nil {
+		return err
+	}
+
+	for _, h := range scanned {
+		if ids, ok := found[h]; ok {
+			h.TokenIDs = ids
+		}
+	}
+	for _, h := range p.ERC721 {
+		h.TokenIDs = slices.DeleteFunc(h.TokenIDs, func(id *big.Int) bool { return id == nil })
+		sortIDs(h.TokenIDs)
+	}
+	for _, h := range p.ERC1155 {
+		sort.Slice(h.Tokens, func(i, j int) bool { return h.Tokens[i].ID.Cmp(h.Tokens[j].ID) < 0 })
+	}
+	return nil
+}
+
+// received returns the distinct token IDs transferred to the owner per contract, from
+// the events with the given names up to the portfolio block. The scan starts at the
+// earliest start block of the contracts and queries at most LogRange blocks at a time.
+func (r *reader) received(contracts []common.Address, events ...string) (map[common.Address][]*big.Int, error) {
+	if len(contracts) == 0 {
+		return nil, nil
+	}
+	client := eth.GetClient()
+	if client == nil {
+		return nil, appErrors.NodeUnavailable(eth.RetryAfter(), eth.LastError())
+	}
+
+	topics := make([]common.Hash, len(events))
+	for i, name := range events {
+		topics[i] = parsedABI.Events[name].ID
+	}
+	// The recipient is the second indexed argument of Transfer and the third of the
+	// ERC1155 transfer events
+	recipient := []common.Hash{common.BytesToHash(r.owner.Bytes())}
+	query := ethereum.FilterQuery{Addresses: contracts, Topics: [][]common.Hash{topics, nil, recipient}}
+	if events[0] != "Transfer" {
+		query.Topics = [][]common.Hash{topics, nil, nil, recipient}
+	}
+
+	from := startBlock(contracts[0])
+	for _, contract := range contracts[1:] {
+		from = min(from, startBlock(contract))
+	}
+	to := r.block.Uint64()
+	var logs []types.Log
+	for start := from; start <= to; start += settings.LogRange {
+		end := min(start+settings.LogRange-1, to)
+		query.FromBlock = new(big.Int).SetUint64(start)
+		query.ToBlock = new(big.Int).SetUint64(end)
+		chunk, err := client.FilterLogs(r.ctx, query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to find received token IDs in blocks %d-%d", start, end)
+		}
+		logs = append(logs, chunk...)
+	}
+
+	ids := make(map[common.Address][]*big.Int)
+	seen := make(map[common.Address]map[string]bool)
+	add := func(contract common.Address, id *big.Int) {
+		if seen[contract] == nil {
+			seen[contract] = make(map[string]bool)
+		}
+		if !seen[contract][id.String()] {
+			seen[contract][id.String()] = true
+			ids[contract] = append(ids[contract], id)
+		}
+	}
+	for _, l := range logs {
+		switch {
+		case l.Topics[0] == parsedABI.Events["Transfer"].ID && len(l.Topics) == 4:
+			add(l.Address, new(big.Int).SetBytes(l.Topics[3].Bytes()))
+		case l.Topics[0] == parsedABI.Events["TransferSingle"].ID:
+			if out, err := parsedABI.Unpack("TransferSingle", l.Data); err == nil {
+				add(l.Address, out[0].(*big.Int))
+			}
+		case l.Topics[0] == parsedABI.Events["TransferBatch"].ID:
+			if out, err := parsedABI.Unpack("TransferBatch", l.Data); err == nil {
+				for _, id := range out[0].([]*big.Int) {
+					add(l.Address, id)
+				}
+			}
+		}
+	}
+	for _, list := range ids {
+		sortIDs(list)
+	}
+	return ids, nil
+}
+
+// unpack decodes the single return value of a call
+func unpack[T any](c *eth.Call, method string) (T, error) {
+	var zero T
+	if c.Err != nil {
+		return zero, errors.Wrapf(c.Err, "failed to call %s", method)
+	}
+	out, err := parsedABI.Unpack(method, c.Result)
+	if err != nil || len(out) != 1 {
+		return zero, errors.Errorf("failed to decode %s", method)
+	}
+	v, ok := out[0].(T)
+	if !ok {
+		return zero, errors.Errorf("failed to decode %s", method)
+	}
+	return v, nil
+}
+
+// unpackString decodes a string return value. Some early tokens return bytes32 instead,
+// which is decoded as a zero-padded string; failed calls return an empty string.
+func unpackString(c *eth.Call, method string) string {
+	if v, err := unpack[string](c, method); err == nil {
+		return v
+	}
+	if c.Err == nil && len(c.Result) == 32 {
+		return string(bytes.TrimRight(c.Result, "\x00"))
+	}
+	return ""
+}
+
+// contractsOf returns the contract addresses of holdings
+func contractsOf[H any](holdings []H, contract func(H) common.Address) []common.Address {
+	addrs := make([]common.Address, len(holdings))
+	for i, h := range holdings {
+		addrs[i] = contract(h)
+	}
+	return addrs
+}
+
+// sortIDs sorts token IDs in ascending order
+func sortIDs(ids []*big.Int) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+}
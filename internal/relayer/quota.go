@@ -0,0 +1,197 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/cache"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// quotaKeyPrefix prefixes relay quota counters in Redis
+	quotaKeyPrefix = "relayer:quota:"
+	// jobKeyPrefix prefixes the markers of jobs that took a relay in Redis
+	jobKeyPrefix = "relayer:job:"
+	// quotaTTL keeps a daily counter a little longer than its day
+	quotaTTL = 25 * time.Hour
+)
+
+var (
+	// usage counts relayed requests per quota key when Redis is not available
+	usage = make(map[string]int64)
+	// jobs records when a job took a relay when Redis is not available
+	jobs    = make(map[string]time.Time)
+	usageMu sync.Mutex
+)
+
+// Take consumes one relay from the daily quota of a sender.
+// Redis is used when it is initialized, otherwise an in-process counter.
+//
+// Parameters:
+//   - ctx: Context for the Redis operations
+//   - from: Sender of the forward request
+//
+// Returns:
+//   - int64: Remaining relays for the day (-1 when unlimited)
+//   - error: ErrRelayQuotaExceeded when the quota is used up
+func Take(ctx context.Context, from common.Address) (int64, error) {
+	quota := GetSettings().DailyQuota
+	if quota == 0 {
+		return -1, nil
+	}
+	key := quotaKey(from, time.Now())
+
+	var used int64
+	if client := cache.GetRedisClient(); client != nil {
+		n, err := client.Incr(ctx, key).Result()
+		if err != nil {
+			return 0, appErrors.WrapError(err, appErrors.CodeInternal, "failed to update relay quota")
+		}
+		if n == 1 {
+			client.Expire(ctx, key, quotaTTL)
+		}
+		used = n
+	} else {
+		usageMu.Lock()
+		pruneUsage(key)
+		usage[key]++
+		used = usage[key]
+		usageMu.Unlock()
+	}
+
+	if used > quota {
+		Refund(ctx, from)
+		return 0, appErrors.ErrRelayQuotaExceeded
+	}
+	return quota - used, nil
+}
+
+// TakeForJob consumes one relay from the daily quota of a sender for an asynchronous job.
+// The relay is taken once per job ID: later calls for the job, such as retried attempts,
+// return the remaining relays without taking another one.
+//
+// Parameters:
+//   - ctx: Context for the Redis operations
+//   - id: ID of the job
+//   - from: Sender of the forward request
+//
+// Returns:
+//   - int64: Remaining relays for the day (-1 when unlimited)
+//   - error: ErrRelayQuotaExceeded when the quota is used up
+func TakeForJob(ctx context.Context, id string, from common.Address) (int64, error) {
+	if GetSettings().DailyQuota == 0 {
+		return -1, nil
+	}
+
+	if client := cache.GetRedisClient(); client != nil {
+		ok, err := client.SetNX(ctx, jobKeyPrefix+id, from.Hex(), quotaTTL).Result()
+		if err != nil {
+			return 0, appErrors.WrapError(err, appErrors.CodeInternal, "failed to update relay quota")
+		}
+		if !ok {
+			return Remaining(ctx, from), nil
+		}
+	} else {
+		now := time.Now()
+		usageMu.Lock()
+		_, taken := jobs[id]
+		if !taken {
+			for k, t := range jobs {
+				if now.Sub(t) > quotaTTL {
+					delete(jobs, k)
+				}
+			}
+			jobs[id] = now
+		}
+		usageMu.Unlock()
+		if taken {
+			return Remaining(ctx, from), nil
+		}
+	}
+
+	remaining, err := Take(ctx, from)
+	if err != nil {
+		forgetJob(ctx, id)
+	}
+	return remaining, err
+}
+
+// RefundJob returns the relay taken by TakeForJob, e.g. when the job could not be stored
+func RefundJob(ctx context.Context, id string, from common.Address) {
+	if GetSettings().DailyQuota == 0 {
+		return
+	}
+	forgetJob(ctx, id)
+	Refund(ctx, from)
+}
+
+// forgetJob drops the marker of a job that took a relay
+func forgetJob(ctx context.Context, id string) {
+	if client := cache.GetRedisClient(); client != nil {
+		client.Del(ctx, jobKeyPrefix+id)
+		return
+	}
+	usageMu.Lock()
+	delete(jobs, id)
+	usageMu.Unlock()
+}
+
+// Refund returns a relay taken by Take, e.g. when the transaction could not be sent
+func Refund(ctx context.Context, from common.Address) {
+	quota := GetSettings().DailyQuota
+	if quota == 0 {
+		return
+	}
+	key := quotaKey(from, time.Now())
+
+	if client := cache.GetRedisClient(); client != nil {
+		client.Decr(ctx, key)
+		return
+	}
+	usageMu.Lock()
+	if usage[key] > 0 {
+		usage[key]--
+	}
+	usageMu.Unlock()
+}
+
+// Remaining returns the relays left for a sender today (-1 when unlimited)
+func Remaining(ctx context.Context, from common.Address) int64 {
+	quota := GetSettings().DailyQuota
+	if quota == 0 {
+		return -1
+	}
+	key := quotaKey(from, time.Now())
+
+	var used int64
+	if client := cache.GetRedisClient(); client != nil {
+		used, _ = client.Get(ctx, key).Int64()
+	} else {
+		usageMu.Lock()
+		used = usage[key]
+		usageMu.Unlock()
+	}
+	if used >= quota {
+		return 0
+	}
+	return quota - used
+}
+
+// quotaKey returns the counter key of a sender for the UTC day of t
+func quotaKey(from common.Address, t time.Time) string {
+	return quotaKeyPrefix + t.UTC().Format("20060102") + ":" + from.Hex()
+}
+
+// pruneUsage drops in-process counters of previous days; the caller holds usageMu
+func pruneUsage(current string) {
+	day := current[:len(quotaKeyPrefix)+8]
+	for k := range usage {
+		if k[:len(quotaKeyPrefix)+8] != day {
+			delete(usage, k)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+// Package relayer verifies ERC-2771 forward requests for the MinimalForwarder and
+// enforces the per-sender relay quota.
+package relayer
+
+import (
+	"math/big"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/internal/signing"
+	"eth-contract-service/provider/contract/forwarder"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Settings holds the effective relayer settings
+type Settings struct {
+	// Forwarder is the MinimalForwarder contract address; zero disables the relayer
+	Forwarder common.Address
+	// DomainName is the EIP-712 domain name of the forwarder
+	DomainName string
+	// DomainVersion is the EIP-712 domain version of the forwarder
+	DomainVersion string
+	// Signer is the keystore signer that pays for relayed transactions
+	Signer string
+	// DailyQuota is the number of relayed requests per sender and UTC day (0 means unlimited)
+	DailyQuota int64
+	// MaxGas is the maximum gas of a forward request
+	MaxGas uint64
+	// AllowedTargets are the contracts that may be called
+	AllowedTargets map[common.Address]bool
+	// AllowAnyTarget lets forward requests call any contract, ignoring AllowedTargets
+	AllowAnyTarget bool
+}
+
+var (
+	// settings stores the effective relayer settings
+	settings = defaultSettings()
+	// settingsMu guards settings, which can be replaced by a configuration reload
+	settingsMu sync.RWMutex
+	// initOnce ensures the settings are applied only once
+	initOnce sync.Once
+)
+
+// forwardRequestTypes are the EIP-712 types of the MinimalForwarder ForwardRequest
+var forwardRequestTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"ForwardRequest": {
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "gas", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+	},
+}
+
+// Init applies the relayer settings.
+//
+// Parameters:
+//   - cfg: Relayer configuration (optional, the relayer stays disabled without a forwarder)
+//   - logger: Logger instance for relayer logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Init(cfg *conf.Relayer, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		s, err := newSettings(cfg)
+		if err != nil {
+			initErr = err
+			return
+		}
+		setSettings(s)
+
+		if s.Forwarder == (common.Address{}) {
+			log.NewHelper(logger).Infof("relayer disabled: no forwarder configured")
+			return
+		}
+		log.NewHelper(logger).Infof("relayer initialized: forwarder=%s, domain=%s/%s, daily_quota=%d, max_gas=%d, targets=%d, any_target=%t",
+			s.Forwarder.Hex(), s.DomainName, s.DomainVersion, s.DailyQuota, s.MaxGas, len(s.AllowedTargets), s.AllowAnyTarget)
+	})
+	return initErr
+}
+
+// Validate checks a relayer configuration without applying it
+func Validate(cfg *conf.Relayer) error {
+	_, err := newSettings(cfg)
+	return err
+}
+
+// Reload validates and applies a changed relayer configuration, e.g. a new daily quota
+// or target allow-list. The settings are only replaced when the whole section is valid.
+//
+// Parameters:
+//   - cfg: Relayer configuration (optional)
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Reload(cfg *conf.Relayer) error {
+	s, err := newSettings(cfg)
+	if err != nil {
+		return err
+	}
+	setSettings(s)
+	return nil
+}
+
+// GetSettings returns the effective relayer settings
+func GetSettings() Settings {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return settings
+}
+
+// setSettings replaces the effective relayer settings
+func setSettings(s Settings) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	settings = s
+}
+
+// defaultSettings returns the relayer settings used without configuration
+func defaultSettings() Settings {
+	return Settings{
+		DomainName:     "MinimalForwarder",
+		DomainVersion:  "0.0.1",
+		MaxGas:         1000000,
+		AllowedTargets: make(map[common.Address]bool),
+	}
+}
+
+// newSettings builds relayer settings from the configuration
+func newSettings(cfg *conf.Relayer) (Settings, error) {
+	s := defaultSettings()
+	if cfg == nil || cfg.Forwarder == "" {
+		return s, nil
+	}
+	if !common.IsHexAddress(cfg.Forwarder) {
+		return Settings{}, errors.Errorf("invalid relayer.forwarder: %s", cfg.Forwarder)
+	}
+	s.Forwarder = common.HexToAddress(cfg.Forwarder)
+	if cfg.DomainName != "" {
+		s.DomainName = cfg.DomainName
+	}
+	if cfg.DomainVersion != "" {
+		s.DomainVersion = cfg.DomainVersion
+	}
+	s.Signer = cfg.Signer
+	if cfg.DailyQuota < 0 {
+		return Settings{}, errors.Errorf("invalid relayer.daily_quota: %d", cfg.DailyQuota)
+	}
+	s.DailyQuota = int64(cfg.DailyQuota)
+	if cfg.MaxGas > 0 {
+		s.MaxGas = cfg.MaxGas
+	}
+	for _, addr := range cfg.AllowedTargets {
+		if !common.IsHexAddress(addr) {
+			return Settings{}, errors.Errorf("invalid relayer.allowed_targets entry: %s", addr)
+		}
+		s.AllowedTargets[common.HexToAddress(addr)] = true
+	}
+	s.AllowAnyTarget = cfg.AllowAnyTarget
+	return s, nil
+}
+
+// Enabled reports whether a forwarder is configured
+func Enabled() bool {
+	return GetSettings().Forwarder != (common.Address{})
+}
+
+// Domain returns the EIP-712 domain of the configured forwarder
+func Domain() (apitypes.TypedDataDomain, error) {
+	chainID := eth.GetChainID()
+	if chainID == nil {
+		return apitypes.TypedDataDomain{}, appErrors.ErrChainIDNotConfigured
+	}
+	settings := GetSettings()
+	return apitypes.TypedDataDomain{
+		Name:              settings.DomainName,
+		Version:           settings.DomainVersion,
+		ChainId:           (*math.HexOrDecimal256)(new(big.Int).Set(chainID)),
+		VerifyingContract: settings.Forwarder.Hex(),
+	}, nil
+}
+
+// Check applies the gas limit and target allow-list to a forward request.
+// No target may be called while the allow-list is empty, unless any target is allowed.
+func Check(req *forwarder.MinimalForwarderForwardRequest) error {
+	settings := GetSettings()
+	if req.Gas.Sign() <= 0 || !req.Gas.IsUint64() || req.Gas.Uint64() > settings.MaxGas {
+		return appErrors.InvalidArgument("gas must be between 1 and %d", settings.MaxGas)
+	}
+	if !settings.AllowAnyTarget && !settings.AllowedTargets[req.To] {
+		return appErrors.ErrRelayTargetNotAllowed
+	}
+	return nil
+}
+
+// Hash returns the EIP-712 digest of a forward request
+func Hash(req *forwarder.MinimalForwarderForwardRequest) (common.Hash, error) {
+	domain, err := Domain()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	td := &apitypes.TypedData{
+		Types:       forwardRequestTypes,
+		PrimaryType: "ForwardRequest",
+		Domain:      domain,
+		Message: apitypes.TypedDataMessage{
+			"from":  req.From.Hex(),
+			"to":    req.To.Hex(),
+			"value": (*math.HexOrDecimal256)(req.Value),
+			"gas":   (*math.HexOrDecimal256)(req.Gas),
+			"nonce": (*math.HexOrDecimal256)(req.Nonce),
+			"data":  hexutil.Encode(req.Data),
+		},
+	}
+	return signing.HashTypedData(td)
+}
+
+// Verify checks that the forward request was signed by its sender
+func Verify(req *forwarder.MinimalForwarderForwardRequest, sig []byte) error {
+	digest, err := Hash(req)
+	if err != nil {
+		return err
+	}
+	signer, err := keystore.RecoverSigner(digest, sig)
+	if err != nil || signer != req.From {
+		return appErrors.ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,430 @@
+// Package reload applies configuration changes at runtime.
+// It watches the configuration sources and re-applies the sections that are safe to change
+// without a restart: the contract addresses map, fee caps, the signing policy, relayer
+// quotas and allow-lists, the named signers, the treasury thresholds, the deploy settings
+// and the log level.
+// A change is validated as a whole before any provider is updated; changes to other
+// sections are logged and take effect on the next restart.
+package reload
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/deploy"
+	"eth-contract-service/internal/relayer"
+	"eth-contract-service/internal/signing"
+	"eth-contract-service/internal/treasury"
+	"eth-contract-service/internal/txmanager"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+	"eth-contract-service/provider/logger"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// redacted replaces secret values in the effective configuration and in change logs
+const redacted = "******"
+
+// Reloadable lists the configuration paths that are applied without a restart
+var Reloadable = []string{
+	"log.level",
+	"ethereum.contracts",
+	"transactions",
+	"signing",
+	"relayer",
+	"signers",
+	"treasury",
+	"deploy",
+}
+
+// secretFields are the configuration fields whose values are never exposed
+var secretFields = map[string]bool{
+	"source":            true, // database DSN including credentials
+	"password":          true,
+	"keystore_password": true,
+	"passphrase":        true,
+	"encryption_key":    true,
+	"api_tokens":        true,
+	"rpc_url":           true, // provider URLs often embed an API key
+	"url":               true, // remote signer endpoint
+	"alert_webhook_url": true,
+}
+
+var (
+	// effective stores the configuration currently applied
+	effective *conf.Bootstrap
+	// version counts the applied reloads
+	version int64
+	// reloadedAt is the time of the last applied reload
+	reloadedAt time.Time
+	// pendingLogged stores the restart-only changes reported last, so that every source
+	// event does not repeat the same warning
+	pendingLogged string
+	// mu serializes reloads and guards the fields above
+	mu sync.RWMutex
+	// helper logs reload events
+	helper *log.Helper
+	// rawLogger is passed to providers that log while reloading
+	rawLogger log.Logger
+)
+
+// Watch records the configuration applied at startup and re-applies the reloadable
+// sections whenever a configuration source reports a change.
+//
+// Parameters:
+//   - c: Loaded configuration whose sources are watched
+//   - bc: Configuration applied at startup
+//   - logger: Logger instance for reload logging
+//
+// Returns:
+//   - error: Error if an observer cannot be registered
+func Watch(c config.Config, bc *conf.Bootstrap, logger log.Logger) error {
+	mu.Lock()
+	effective = proto.Clone(bc).(*conf.Bootstrap)
+	reloadedAt = time.Now()
+	helper = log.NewHelper(logger)
+	rawLogger = logger
+	mu.Unlock()
+
+	observer := func(key string, _ config.Value) {
+		var next conf.Bootstrap
+		if err := c.Scan(&next); err != nil {
+			helper.Errorf("configuration reload failed: key=%s, error=%v", key, err)
+			return
+		}
+		if _, err := Apply(context.Background(), &next); err != nil {
+			helper.Errorf("configuration reload rejected: key=%s, error=%v", key, err)
+		}
+	}
+
+	// Every top-level section is observed so that restart-only changes are reported too
+	fields := (&conf.Bootstrap{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		key := string(fields.Get(i).Name())
+		if err := c.Watch(key, observer); err != nil {
+			if errors.Is(err, config.ErrNotFound) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to watch configuration key %s", key)
+		}
+	}
+	helper.Infof("configuration watch started: reloadable=%s", strings.Join(Reloadable, ","))
+	return nil
+}
+
+// Apply validates a new configuration and applies its reloadable sections.
+// Nothing is applied when any reloadable section is invalid.
+//
+// Parameters:
+//   - ctx: Context for loading signers
+//   - next: New configuration
+//
+// Returns:
+//   - []string: Applied changes, empty when nothing reloadable changed
+//   - error: Error if the reloadable sections are invalid
+func Apply(ctx context.Context, next *conf.Bootstrap) ([]string, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if effective == nil {
+		return nil, errors.New("configuration watch not started")
+	}
+
+	var applied, pending []string
+	for _, change := range Diff(effective, next) {
+		if isReloadable(change) {
+			applied = append(applied, change)
+		} else {
+			pending = append(pending, change)
+		}
+	}
+	if p := strings.Join(pending, "; "); p != pendingLogged {
+		if p != "" {
+			helper.Warnf("configuration changes require a restart: %s", p)
+		}
+		pendingLogged = p
+	}
+	if len(applied) == 0 {
+		return nil, nil
+	}
+
+	// Validate every section before touching a provider
+	if err := eth.ValidateContracts(next.GetEthereum().GetContracts()); err != nil {
+		return nil, err
+	}
+	if err := txmanager.Validate(next.GetTransactions()); err != nil {
+		return nil, err
+	}
+	if err := signing.Validate(next.GetSigning()); err != nil {
+		return nil, err
+	}
+	if err := relayer.Validate(next.GetRelayer()); err != nil {
+		return nil, err
+	}
+	if err := treasury.Validate(next.GetTreasury()); err != nil {
+		return nil, err
+	}
+	if err := deploy.Validate(next.GetDeploy()); err != nil {
+		return nil, err
+	}
+	// Signers are loaded before the swap, so a failing signer keeps the current list
+	if changed(applied, "signers") {
+		if err := keystore.ReloadSigners(ctx, next.GetSigners(), rawLogger); err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply the validated sections; these cannot fail anymore
+	logger.SetLevel(next.GetLog().GetLevel())
+	if changed(applied, "ethereum.contracts") {
+		if err := eth.ReloadContracts(next.GetEthereum().GetContracts()); err != nil {
+			helper.Warnf("contract addresses not reloaded: %v", err)
+		}
+	}
+	_ = txmanager.Reload(next.GetTransactions())
+	_ = signing.Reload(next.GetSigning())
+	_ = relayer.Reload(next.GetRelayer())
+	_ = treasury.Reload(next.GetTreasury())
+	_ = deploy.Reload(next.GetDeploy())
+
+	// Restart-only sections keep their startup values in the effective configuration
+	updated := proto.Clone(effective).(*conf.Bootstrap)
+	if updated.Log == nil {
+		updated.Log = &conf.Log{}
+	}
+	updated.Log.Level = next.GetLog().GetLevel()
+	if updated.Ethereum != nil {
+		updated.Ethereum.Contracts = next.GetEthereum().GetContracts()
+	}
+	updated.Transactions = next.GetTransactions()
+	updated.Signing = next.GetSigning()
+	updated.Relayer = next.GetRelayer()
+	updated.Signers = next.GetSigners()
+	updated.Treasury = next.GetTreasury()
+	updated.Deploy = next.GetDeploy()
+	effective = updated
+	version++
+	reloadedAt = time.Now()
+
+	helper.Infof("configuration reloaded: version=%d, changes=%s", version, strings.Join(applied, "; "))
+	return applied, nil
+}
+
+// Effective returns the configuration currently applied with secrets redacted
+//
+// Returns:
+//   - *conf.Bootstrap: Redacted copy of the effective configuration (nil before Watch)
+//   - int64: Number of applied reloads
+//   - time.Time: Time of the last applied reload, or of startup
+func Effective() (*conf.Bootstrap, int64, time.Time) {
+	mu.RLock()
+	defer mu.RUnlock()
+	if effective == nil {
+		return nil, 0, time.Time{}
+	}
+	bc := proto.Clone(effective).(*conf.Bootstrap)
+	redact(bc.ProtoReflect())
+	return bc, version, reloadedAt
+}
+
+// Diff describes the fields that differ between two configurations, one entry per
+// field in the form path: old -> new. Secret values are redacted.
+func Diff(prev, next *conf.Bootstrap) []string {
+	var changes []string
+	diffMessage("", prev.ProtoReflect(), next.ProtoReflect(), &changes)
+	return changes
+}
+
+// diffMessage appends the differences between two messages of the same type
+func diffMessage(prefix string, a, b protoreflect.Message, out *[]string) {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := string(fd.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		va, vb := a.Get(fd), b.Get(fd)
+
+		switch {
+		case fd.IsMap():
+			diffMap(path, fd, va.Map(), vb.Map(), out)
+		case fd.IsList():
+			if listEqual(fd, va.List(), vb.List()) {
+				continue
+			}
+			if secretFields[string(fd.Name())] {
+				*out = append(*out, path+": changed")
+			} else {
+				*out = append(*out, path+": "+formatList(fd, va.List())+" -> "+formatList(fd, vb.List()))
+			}
+		case fd.Message() != nil && fd.Message().FullName() == "google.protobuf.Duration":
+			da, db := formatDuration(a, fd), formatDuration(b, fd)
+			if da != db {
+				*out = append(*out, path+": "+da+" -> "+db)
+			}
+		case fd.Message() != nil:
+			diffMessage(path, va.Message(), vb.Message(), out)
+		default:
+			sa, sb := fmt.Sprint(va.Interface()), fmt.Sprint(vb.Interface())
+			if sa == sb {
+				continue
+			}
+			if secretFields[string(fd.Name())] {
+				*out = append(*out, path+": changed")
+			} else {
+				*out = append(*out, path+": "+quote(sa)+" -> "+quote(sb))
+			}
+		}
+	}
+}
+
+// diffMap appends the added, removed and changed entries of a string map
+func diffMap(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.Map, out *[]string) {
+	keys := make(map[string]bool)
+	collect := func(m protoreflect.Map) {
+		m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys[k.String()] = true
+			return true
+		})
+	}
+	collect(a)
+	collect(b)
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		mk := protoreflect.ValueOfString(k).MapKey()
+		sa, sb := "<unset>", "<unset>"
+		if a.Has(mk) {
+			sa = quote(fmt.Sprint(a.Get(mk).Interface()))
+		}
+		if b.Has(mk) {
+			sb = quote(fmt.Sprint(b.Get(mk).Interface()))
+		}
+		if sa != sb {
+			*out = append(*out, path+"."+k+": "+sa+" -> "+sb)
+		}
+	}
+}
+
+// listEqual reports whether two lists hold the same elements in the same order
+func listEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.List) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if fd.Message() != nil {
+			if !proto.Equal(a.Get(i).Message().Interface(), b.Get(i).Message().Interface()) {
+				return false
+			}
+		} else if fmt.Sprint(a.Get(i).Interface()) != fmt.Sprint(b.Get(i).Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatList formats a list for a change log; message lists are summarized by name
+func formatList(fd protoreflect.FieldDescriptor, l protoreflect.List) string {
+	items := make([]string, 0, l.Len())
+	for i := 0; i < l.Len(); i++ {
+		if fd.Message() == nil {
+			items = append(items, fmt.Sprint(l.Get(i).Interface()))
+			continue
+		}
+		m := l.Get(i).Message()
+		if name := m.Descriptor().Fields().ByName("name"); name != nil {
+			items = append(items, m.Get(name).String())
+		} else {
+			items = append(items, fmt.Sprint(i))
+		}
+	}
+	return "[" + strings.Join(items, ",") + "]"
+}
+
+// formatDuration formats a duration field, <unset> when it is not set
+func formatDuration(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if !m.Has(fd) {
+		return "<unset>"
+	}
+	return m.Get(fd).Message().Interface().(*durationpb.Duration).AsDuration().String()
+}
+
+// quote formats a scalar value for a change log
+func quote(s string) string {
+	if s == "" {
+		return `""`
+	}
+	return s
+}
+
+// isReloadable reports whether a change path belongs to a reloadable section
+func isReloadable(change string) bool {
+	path := change
+	if i := strings.Index(change, ":"); i >= 0 {
+		path = change[:i]
+	}
+	for _, p := range Reloadable {
+		if path == p || strings.HasPrefix(path, p+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// changed reports whether any change belongs to the section at path
+func changed(changes []string, path string) bool {
+	for _, change := range changes {
+		if strings.HasPrefix(change, path+":") || strings.HasPrefix(change, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// redact replaces the values of secret fields in place
+func redact(m protoreflect.Message) {
+	var secrets []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			for i := 0; i < v.List().Len(); i++ {
+				redact(v.List().Get(i).Message())
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.StringKind && secretFields[string(fd.Name())] {
+				secrets = append(secrets, fd)
+			}
+		case fd.IsMap():
+		case fd.Message() != nil:
+			redact(v.Message())
+		case fd.Kind() == protoreflect.StringKind && secretFields[string(fd.Name())] && v.String() != "":
+			secrets = append(secrets, fd)
+		}
+		return true
+	})
+	for _, fd := range secrets {
+		if fd.IsList() {
+			list := m.Mutable(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				list.Set(i, protoreflect.ValueOfString(redacted))
+			}
+			continue
+		}
+		m.Set(fd, protoreflect.ValueOfString(redacted))
+	}
+}
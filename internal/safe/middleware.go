@@ -0,0 +1,96 @@
+package safe
+
+import (
+	"context"
+
+	"eth-contract-service/internal/contract"
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/internal/job"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// HeaderSafeAddress selects the Safe that should execute a write request
+	HeaderSafeAddress = "X-Safe-Address"
+	// HeaderProposalID carries the ID of the created proposal in the reply
+	HeaderProposalID = "X-Safe-Proposal-Id"
+	// HeaderSafeTxHash carries the safeTxHash of the created proposal in the reply
+	HeaderSafeTxHash = "X-Safe-Tx-Hash"
+)
+
+// asyncRequest is implemented by write requests that carry an async flag
+type asyncRequest interface {
+	GetAsync() bool
+}
+
+// Middleware returns a server middleware that turns write requests carrying the
+// X-Safe-Address header into Safe proposals. The service method runs with a capture
+// that records the call instead of sending it; the call is stored as a proposal and the
+// reply carries the proposal ID and safeTxHash headers with an empty tx_hash.
+// The key of the request only builds the call and must belong to a Safe owner.
+func Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			header := tr.RequestHeader().Get(HeaderSafeAddress)
+			if header == "" {
+				return handler(ctx, req)
+			}
+
+			operation := tr.Operation()
+			if !job.Registered(operation) {
+				return nil, appErrors.ToGRPCError(appErrors.InvalidArgument("operation %s cannot be proposed to a Safe", operation))
+			}
+			if ar, ok := req.(asyncRequest); ok && ar.GetAsync() {
+				return nil, appErrors.ToGRPCError(appErrors.InvalidArgument("async cannot be combined with a Safe proposal"))
+			}
+			if !common.IsHexAddress(header) {
+				return nil, appErrors.ToGRPCError(appErrors.InvalidArgument("invalid %s header: %s", HeaderSafeAddress, header))
+			}
+			safeAddr := common.HexToAddress(header)
+
+			// Build the call without sending it
+			capture := contract.NewTxCapture(nil).OnBehalfOf(safeAddr)
+			resp, err := handler(contract.WithTxCapture(ctx, capture), req)
+			if err != nil {
+				return nil, err
+			}
+			tx := capture.Transaction()
+			if tx == nil {
+				return nil, appErrors.ToGRPCError(appErrors.NewError(appErrors.CodeInternal, "operation did not produce a transaction"))
+			}
+			if tx.To() == nil {
+				return nil, appErrors.ToGRPCError(appErrors.InvalidArgument("contract deployments cannot be proposed to a Safe"))
+			}
+
+			p, err := Propose(ctx, safeAddr, operation, capture.From(), *tx.To(), tx.Value(), tx.Data())
+			if err != nil {
+				return nil, appErrors.ToGRPCError(err)
+			}
+
+			tr.ReplyHeader().Set(HeaderProposalID, p.ID)
+			tr.ReplyHeader().Set(HeaderSafeTxHash, p.SafeTxHash)
+			if m, ok := resp.(proto.Message); ok {
+				clearTxHash(m)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// clearTxHash clears the tx_hash field of a response; the captured transaction is never sent
+func clearTxHash(m proto.Message) {
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("tx_hash")
+	if fd != nil && fd.Kind() == protoreflect.StringKind {
+		msg.Clear(fd)
+	}
+}
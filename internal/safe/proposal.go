@@ -0,0 +1,493 @@
+package safe
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/internal/signing"
+	"eth-contract-service/internal/txmanager"
+	safeContract "eth-contract-service/provider/contract/safe"
+	"eth-contract-service/provider/db"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// operationCall is the Safe operation type of a regular call (1 is delegatecall)
+	operationCall = 0
+	// maxNonceAttempts bounds the retries when concurrent proposals claim the same Safe nonce
+	maxNonceAttempts = 5
+)
+
+// safeTxTypes are the EIP-712 types of a SafeTx (Safe v1.3.0 and later)
+var safeTxTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"SafeTx": {
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+		{Name: "operation", Type: "uint8"},
+		{Name: "safeTxGas", Type: "uint256"},
+		{Name: "baseGas", Type: "uint256"},
+		{Name: "gasPrice", Type: "uint256"},
+		{Name: "gasToken", Type: "address"},
+		{Name: "refundReceiver", Type: "address"},
+		{Name: "nonce", Type: "uint256"},
+	},
+}
+
+// ListFilter restricts the proposals returned by List
+type ListFilter struct {
+	SafeAddress string
+	Status      Status
+	Limit       int
+	Offset      int
+}
+
+// HashSafeTx returns the EIP-712 safeTxHash of a call without gas refund
+func HashSafeTx(safeAddr, to common.Address, value *big.Int, data []byte, nonce uint64) (common.Hash, error) {
+	td, err := SafeTxTypedData(safeAddr, to, value, data, nonce)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return signing.HashTypedData(td)
+}
+
+// SafeTxTypedData returns the EIP-712 SafeTx document of a call without gas refund,
+// as signed by Safe owners
+func SafeTxTypedData(safeAddr, to common.Address, value *big.Int, data []byte, nonce uint64) (*apitypes.TypedData, error) {
+	chainID := eth.GetChainID()
+	if chainID == nil {
+		return nil, appErrors.ErrChainIDNotConfigured
+	}
+	zero := math.NewHexOrDecimal256(0)
+	return &apitypes.TypedData{
+		Types:       safeTxTypes,
+		PrimaryType: "SafeTx",
+		Domain: apitypes.TypedDataDomain{
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: safeAddr.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"to":             to.Hex(),
+			"value":          (*math.HexOrDecimal256)(value),
+			"data":           hexutil.Encode(data),
+			"operation":      math.NewHexOrDecimal256(operationCall),
+			"safeTxGas":      zero,
+			"baseGas":        zero,
+			"gasPrice":       zero,
+			"gasToken":       common.Address{}.Hex(),
+			"refundReceiver": common.Address{}.Hex(),
+			"nonce":          math.NewHexOrDecimal256(int64(nonce)),
+		},
+	}, nil
+}
+
+// TypedData returns the EIP-712 SafeTx document of a proposal
+func (p *Proposal) TypedData() (*apitypes.TypedData, error) {
+	data, err := hexutil.Decode(p.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid stored calldata")
+	}
+	return SafeTxTypedData(common.HexToAddress(p.SafeAddress), common.HexToAddress(p.To), p.ValueInt(), data, p.SafeNonce)
+}
+
+// Propose stores a new proposal for a call to be executed by a Safe.
+// The proposer must be a Safe owner. The proposal takes the lowest Safe nonce at or above
+// the on-chain nonce that no open proposal holds, and the local safeTxHash is checked
+// against the contract.
+//
+// Parameters:
+//   - ctx: Context for the contract calls and database operations
+//   - safeAddr: Safe address
+//   - operation: Write operation the call was built from
+//   - proposer: Signer of the write request
+//   - to: Call target
+//   - value: Call value in wei
+//   - data: Calldata
+//
+// Returns:
+//   - *Proposal: The stored proposal
+//   - error: Error if the proposer is not an owner or the hash does not match
+func Propose(ctx context.Context, safeAddr common.Address, operation string, proposer, to common.Address, value *big.Int, data []byte) (*Proposal, error) {
+	wallet, err := bindSafe(safeAddr)
+	if err != nil {
+		return nil, err
+	}
+	opts := eth.NewCallOpts(ctx, nil)
+
+	isOwner, err := wallet.IsOwner(opts, proposer)
+	if err != nil {
+		return nil, appErrors.WrapError(err, appErrors.CodeFailedPrecondition, "failed to query Safe owners, check safe address")
+	}
+	if !isOwner {
+		return nil, appErrors.FailedPrecondition("proposer %s is not an owner of Safe %s", proposer.Hex(), safeAddr.Hex())
+	}
+
+	onchain, err := wallet.Nonce(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get Safe nonce")
+	}
+
+	// The unique nonce slot index makes concurrent proposals for the same nonce collide;
+	// the loser moves on to the next free nonce
+	from := onchain.Uint64()
+	for attempt := 0; attempt < maxNonceAttempts; attempt++ {
+		nonce, err := nextNonce(ctx, safeAddr, from)
+		if err != nil {
+			return nil, err
+		}
+
+		safeTxHash, err := HashSafeTx(safeAddr, to, value, data, nonce)
+		if err != nil {
+			return nil, err
+		}
+		zero := new(big.Int)
+		expected, err := wallet.GetTransactionHash(opts, to, value, data, operationCall, zero, zero, zero,
+			common.Address{}, common.Address{}, new(big.Int).SetUint64(nonce))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get Safe transaction hash")
+		}
+		if common.Hash(expected) != safeTxHash {
+			return nil, appErrors.ErrSafeHashMismatch
+		}
+
+		now := time.Now()
+		p := &Proposal{
+			ID:          uuid.NewString(),
+			SafeAddress: safeAddr.Hex(),
+			Operation:   operation,
+			Proposer:    proposer.Hex(),
+			To:          to.Hex(),
+			Value:       value.String(),
+			Data:        hexutil.Encode(data),
+			SafeNonce:   nonce,
+			NonceSlot:   &nonce,
+			SafeTxHash:  safeTxHash.Hex(),
+			Status:      StatusPending,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		res := db.Get().WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(p)
+		if res.Error != nil {
+			return nil, errors.Wrap(res.Error, "failed to store Safe proposal")
+		}
+		if res.RowsAffected == 1 {
+			return p, nil
+		}
+		from = nonce + 1
+	}
+	return nil, appErrors.FailedPrecondition("no free nonce for Safe %s after %d attempts, retry later", safeAddr.Hex(), maxNonceAttempts)
+}
+
+// Get returns a proposal and its signatures
+func Get(ctx context.Context, id string) (*Proposal, []*Signature, error) {
+	gdb := db.Get().WithContext(ctx)
+	var p Proposal
+	if err := gdb.Where("id = ?", id).Limit(1).Find(&p).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get Safe proposal")
+	}
+	if p.ID == "" {
+		return nil, nil, appErrors.ErrSafeProposalNotFound
+	}
+	if p.Status == StatusSubmitted {
+		if err := settle(ctx, &p); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var sigs []*Signature
+	if err := gdb.Where("proposal_id = ?", id).Order("id ASC").Find(&sigs).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get Safe proposal signatures")
+	}
+	return &p, sigs, nil
+}
+
+// List returns proposals matching the filter, newest first, and the total count
+func List(ctx context.Context, filter ListFilter) ([]*Proposal, int64, error) {
+	q := db.Get().WithContext(ctx).Model(&Proposal{})
+	if filter.SafeAddress != "" {
+		q = q.Where("safe_address = ?", filter.SafeAddress)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count Safe proposals")
+	}
+	var proposals []*Proposal
+	if err := q.Order("created_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&proposals).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list Safe proposals")
+	}
+	return proposals, total, nil
+}
+
+// Approve verifies an owner signature over the safeTxHash of a pending proposal and stores it.
+// Approving twice with the same owner replaces the earlier signature.
+//
+// Parameters:
+//   - ctx: Context for the contract calls and database operations
+//   - id: Proposal ID
+//   - sig: 65-byte signature over the safeTxHash
+//
+// Returns:
+//   - common.Address: The owner that signed
+//   - error: Error if the proposal is not pending or the signer is not an owner
+func Approve(ctx context.Context, id string, sig []byte) (common.Address, error) {
+	p, _, err := Get(ctx, id)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if p.Status != StatusPending {
+		return common.Address{}, appErrors.ErrSafeProposalClosed
+	}
+
+	signer, err := keystore.RecoverSigner(common.HexToHash(p.SafeTxHash), sig)
+	if err != nil {
+		return common.Address{}, appErrors.WrapError(err, appErrors.CodeInvalidArgument, appErrors.ErrInvalidSignature.Message)
+	}
+	// Safe treats v of 27 and 28 as a plain ECDSA signature of the safeTxHash
+	normalized := append([]byte{}, sig...)
+	if normalized[64] < 27 {
+		normalized[64] += 27
+	}
+
+	wallet, err := bindSafe(common.HexToAddress(p.SafeAddress))
+	if err != nil {
+		return common.Address{}, err
+	}
+	isOwner, err := wallet.IsOwner(eth.NewCallOpts(ctx, nil), signer)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to query Safe owners")
+	}
+	if !isOwner {
+		return common.Address{}, appErrors.FailedPrecondition("signer %s is not an owner of Safe %s", signer.Hex(), p.SafeAddress)
+	}
+
+	gdb := db.Get().WithContext(ctx)
+	if err := gdb.Where("proposal_id = ? AND signer = ?", id, signer.Hex()).Delete(&Signature{}).Error; err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to replace Safe proposal signature")
+	}
+	rec := &Signature{
+		ProposalID: id,
+		Signer:     signer.Hex(),
+		Signature:  hexutil.Encode(normalized),
+		CreatedAt:  time.Now(),
+	}
+	if err := gdb.Create(rec).Error; err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to store Safe proposal signature")
+	}
+	return signer, nil
+}
+
+// Threshold returns the current signature threshold of a Safe
+func Threshold(ctx context.Context, safeAddr common.Address) (uint64, error) {
+	wallet, err := bindSafe(safeAddr)
+	if err != nil {
+		return 0, err
+	}
+	threshold, err := wallet.GetThreshold(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get Safe threshold")
+	}
+	return threshold.Uint64(), nil
+}
+
+// Execute sends execTransaction for a proposal whose signatures meet the Safe threshold.
+// Signatures of accounts that are no longer owners are ignored. The proposal becomes
+// submitted and is settled from the receipt of the transaction by Get.
+//
+// Parameters:
+//   - ctx: Context for the contract calls and database operations
+//   - id: Proposal ID
+//   - auth: Transaction options of the executor
+//
+// Returns:
+//   - *types.Transaction: The execTransaction transaction
+//   - error: Error if the threshold is not met or the nonce is not current
+func Execute(ctx context.Context, id string, auth *bind.TransactOpts) (*types.Transaction, error) {
+	p, sigs, err := Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != StatusPending && p.Status != StatusFailed {
+		return nil, appErrors.ErrSafeProposalClosed
+	}
+
+	safeAddr := common.HexToAddress(p.SafeAddress)
+	wallet, err := bindSafe(safeAddr)
+	if err != nil {
+		return nil, err
+	}
+	opts := eth.NewCallOpts(ctx, nil)
+
+	nonce, err := wallet.Nonce(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get Safe nonce")
+	}
+	if nonce.Uint64() != p.SafeNonce {
+		return nil, appErrors.FailedPrecondition("Safe nonce is %d, proposal nonce is %d", nonce.Uint64(), p.SafeNonce)
+	}
+
+	threshold, err := wallet.GetThreshold(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get Safe threshold")
+	}
+	packed, err := packSignatures(ctx, wallet, sigs, threshold.Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := hexutil.Decode(p.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid stored calldata")
+	}
+	zero := new(big.Int)
+	tx, err := wallet.ExecTransaction(auth, common.HexToAddress(p.To), p.ValueInt(), data, operationCall,
+		zero, zero, zero, common.Address{}, common.Address{}, packed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute Safe transaction")
+	}
+
+	p.Status = StatusSubmitted
+	p.ExecTxHash = tx.Hash().Hex()
+	p.UpdatedAt = time.Now()
+	if err := db.Get().WithContext(ctx).Save(p).Error; err != nil {
+		return tx, errors.Wrap(err, "failed to update Safe proposal")
+	}
+	return tx, nil
+}
+
+// Reject withdraws a pending or failed proposal and releases its Safe nonce, which the
+// next proposal of the Safe takes over.
+func Reject(ctx context.Context, id string) (*Proposal, error) {
+	p, _, err := Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != StatusPending && p.Status != StatusFailed {
+		return nil, appErrors.ErrSafeProposalClosed
+	}
+	p.Status = StatusRejected
+	p.NonceSlot = nil
+	p.UpdatedAt = time.Now()
+	if err := db.Get().WithContext(ctx).Save(p).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to update Safe proposal")
+	}
+	return p, nil
+}
+
+// packSignatures concatenates threshold owner signatures sorted by owner address, as
+// required by Safe.checkSignatures
+func packSignatures(ctx context.Context, wallet *safeContract.Safe, sigs []*Signature, threshold uint64) ([]byte, error) {
+	owners, err := wallet.GetOwners(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get Safe owners")
+	}
+	isOwner := make(map[common.Address]bool, len(owners))
+	for _, owner := range owners {
+		isOwner[owner] = true
+	}
+
+	valid := make([]*Signature, 0, len(sigs))
+	for _, sig := range sigs {
+		if isOwner[common.HexToAddress(sig.Signer)] {
+			valid = append(valid, sig)
+		}
+	}
+	if uint64(len(valid)) < threshold {
+		return nil, appErrors.FailedPrecondition("%d of %d required owner signatures collected", len(valid), threshold)
+	}
+
+	sort.Slice(valid, func(i, j int) bool {
+		a, b := common.HexToAddress(valid[i].Signer), common.HexToAddress(valid[j].Signer)
+		return bytes.Compare(a.Bytes(), b.Bytes()) < 0
+	})
+	packed := make([]byte, 0, 65*threshold)
+	for _, sig := range valid[:threshold] {
+		b, err := hexutil.Decode(sig.Signature)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid stored signature")
+		}
+		packed = append(packed, b...)
+	}
+	return packed, nil
+}
+
+// nextNonce returns the lowest Safe nonce at or above from that no open proposal holds
+func nextNonce(ctx context.Context, safeAddr common.Address, from uint64) (uint64, error) {
+	var held []uint64
+	err := db.Get().WithContext(ctx).Model(&Proposal{}).
+		Where("safe_address = ? AND nonce_slot >= ?", safeAddr.Hex(), from).
+		Order("nonce_slot ASC").Pluck("nonce_slot", &held).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get open Safe proposals")
+	}
+	nonce := from
+	for _, n := range held {
+		if n != nonce {
+			break
+		}
+		nonce++
+	}
+	return nonce, nil
+}
+
+// settle resolves the execTransaction of a submitted proposal. A mined transaction
+// marks the proposal executed; a reverted, replaced or vanished one marks it failed so
+// that it can be executed again. A transaction still pending leaves it submitted.
+func settle(ctx context.Context, p *Proposal) error {
+	res, err := txmanager.Resolve(ctx, common.HexToHash(p.ExecTxHash))
+	if err != nil && err != appErrors.ErrTransactionNotFound {
+		return errors.Wrapf(err, "failed to resolve Safe execution %s", p.ExecTxHash)
+	}
+
+	switch {
+	case res == nil:
+		p.Status = StatusFailed
+	case res.Status == txmanager.StatusConfirmed:
+		p.Status = StatusExecuted
+		p.ExecTxHash = res.MinedHash.Hex()
+	case res.Status == txmanager.StatusPending:
+		return nil
+	default:
+		p.Status = StatusFailed
+	}
+	p.UpdatedAt = time.Now()
+	if err := db.Get().WithContext(ctx).Save(p).Error; err != nil {
+		return errors.Wrap(err, "failed to update Safe proposal")
+	}
+	return nil
+}
+
+// bindSafe creates a Safe binding on the shared Ethereum client
+func bindSafe(safeAddr common.Address) (*safeContract.Safe, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, appErrors.ErrClientNotInitialized
+	}
+	wallet, err := safeContract.NewSafe(safeAddr, client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create Safe instance for address %s", safeAddr.Hex())
+	}
+	return wallet, nil
+}
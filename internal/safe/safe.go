@@ -0,0 +1,125 @@
+// Package safe turns write operations into Safe (Gnosis Safe) multisig proposals.
+// A proposal stores the SafeTx built from the call of a write operation, collects owner
+// signatures over its EIP-712 safeTxHash and executes execTransaction once the Safe
+// threshold is met.
+package safe
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/provider/db"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Status represents the state of a proposal
+type Status string
+
+const (
+	// StatusPending means the proposal is collecting signatures
+	StatusPending Status = "pending"
+	// StatusSubmitted means execTransaction was sent and is waiting to be mined
+	StatusSubmitted Status = "submitted"
+	// StatusExecuted means execTransaction was mined successfully
+	StatusExecuted Status = "executed"
+	// StatusFailed means execTransaction reverted or was never mined; it can be executed again
+	StatusFailed Status = "failed"
+	// StatusRejected means the proposal was withdrawn and will not be executed
+	StatusRejected Status = "rejected"
+)
+
+// Settings holds the effective Safe proposal settings
+type Settings struct {
+	// Executor is the keystore signer that sends execTransaction
+	Executor string
+	// AutoExecute sends execTransaction as soon as the threshold is met
+	AutoExecute bool
+}
+
+var (
+	// settings stores the effective Safe proposal settings
+	settings Settings
+	// initOnce ensures the tables are migrated only once
+	initOnce sync.Once
+)
+
+// Proposal is a pending or executed SafeTx
+type Proposal struct {
+	ID          string    `gorm:"primaryKey;size:36" json:"id"`
+	SafeAddress string    `gorm:"size:42;index;uniqueIndex:idx_safe_nonce_slot" json:"safe_address"`
+	Operation   string    `gorm:"size:128" json:"operation"` // write operation the call was built from
+	Proposer    string    `gorm:"size:42" json:"proposer"`
+	To          string    `gorm:"size:42" json:"to"`
+	Value       string    `gorm:"size:78" json:"value"`
+	Data        string    `gorm:"type:text" json:"data"` // hex encoded calldata
+	SafeNonce   uint64    `gorm:"index" json:"safe_nonce"`
+	NonceSlot   *uint64   `gorm:"uniqueIndex:idx_safe_nonce_slot" json:"-"` // Safe nonce held by the proposal, cleared on rejection
+	SafeTxHash  string    `gorm:"size:66;uniqueIndex" json:"safe_tx_hash"`
+	Status      Status    `gorm:"size:16;index" json:"status"`
+	ExecTxHash  string    `gorm:"size:66" json:"exec_tx_hash"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for proposals
+func (Proposal) TableName() string {
+	return "safe_proposals"
+}
+
+// ValueInt returns the value of the proposal in wei
+func (p *Proposal) ValueInt() *big.Int {
+	v, ok := new(big.Int).SetString(p.Value, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return v
+}
+
+// Signature is an owner signature over the safeTxHash of a proposal
+type Signature struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	ProposalID string    `gorm:"size:36;uniqueIndex:idx_safe_signature" json:"proposal_id"`
+	Signer     string    `gorm:"size:42;uniqueIndex:idx_safe_signature" json:"signer"`
+	Signature  string    `gorm:"size:132" json:"signature"` // hex encoded 65-byte signature
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for proposal signatures
+func (Signature) TableName() string {
+	return "safe_signatures"
+}
+
+// Init applies the Safe proposal settings and migrates the proposal tables.
+//
+// Parameters:
+//   - ctx: Context for the migration
+//   - cfg: Safe configuration (optional)
+//   - logger: Logger instance for Safe logging
+//
+// Returns:
+//   - error: Error if the migration fails
+func Init(ctx context.Context, cfg *conf.Safe, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		settings.Executor = cfg.GetExecutor()
+		settings.AutoExecute = cfg.GetAutoExecute()
+
+		if err := db.Get().WithContext(ctx).AutoMigrate(&Proposal{}, &Signature{}); err != nil {
+			initErr = errors.Wrap(err, "failed to migrate safe proposal tables")
+			return
+		}
+
+		log.NewHelper(logger).Infof("safe proposal tables initialized: auto_execute=%t", settings.AutoExecute)
+	})
+	return initErr
+}
+
+// GetSettings returns the effective Safe proposal settings
+func GetSettings() Settings {
+	return settings
+}
@@ -2,15 +2,33 @@
 package server
 
 import (
+	configV1 "eth-contract-service/api/config/v1"
+	contractV1 "eth-contract-service/api/contract/v1"
+	deployV1 "eth-contract-service/api/deploy/v1"
 	erc1155V1 "eth-contract-service/api/erc1155/v1"
 	erc20V1 "eth-contract-service/api/erc20/v1"
 	erc721V1 "eth-contract-service/api/erc721/v1"
+	jobV1 "eth-contract-service/api/job/v1"
+	keysV1 "eth-contract-service/api/keys/v1"
+	nativeV1 "eth-contract-service/api/native/v1"
+	portfolioV1 "eth-contract-service/api/portfolio/v1"
+	relayerV1 "eth-contract-service/api/relayer/v1"
+	safeV1 "eth-contract-service/api/safe/v1"
+	signingV1 "eth-contract-service/api/signing/v1"
+	treasuryV1 "eth-contract-service/api/treasury/v1"
+	txV1 "eth-contract-service/api/tx/v1"
+	walletV1 "eth-contract-service/api/wallet/v1"
 	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/safe"
 	"eth-contract-service/internal/service"
+	"eth-contract-service/provider/metrics"
+	"eth-contract-service/provider/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // NewGRPCServer creates and configures a new gRPC server instance.
@@ -27,7 +45,14 @@ func NewGRPCServer(c *conf.Server, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			metrics.Server(),
+			service.AdminAuth(),
+			safe.Middleware(),
+			job.Middleware(),
 		),
+		// The readiness checks replace the default always-serving health service
+		grpc.CustomHealth(),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -52,5 +77,61 @@ func NewGRPCServer(c *conf.Server, logger log.Logger) *grpc.Server {
 	erc721Service := service.NewERC721Service(logger)
 	erc721V1.RegisterERC721Server(srv, erc721Service)
 
+	// Register Job service
+	jobService := service.NewJobService(logger)
+	jobV1.RegisterJobServer(srv, jobService)
+
+	// Register Transaction service
+	transactionService := service.NewTransactionService(logger)
+	txV1.RegisterTransactionServer(srv, transactionService)
+
+	// Register Contract service
+	contractService := service.NewContractService(logger)
+	contractV1.RegisterContractServer(srv, contractService)
+
+	// Register Signing service
+	signingService := service.NewSigningService(logger)
+	signingV1.RegisterSigningServer(srv, signingService)
+
+	// Register Relayer service
+	relayerService := service.NewRelayerService(logger)
+	relayerV1.RegisterRelayerServer(srv, relayerService)
+
+	// Register Safe service
+	safeService := service.NewSafeService(logger)
+	safeV1.RegisterSafeServer(srv, safeService)
+
+	// Register Wallet service
+	walletService := service.NewWalletService(logger)
+	walletV1.RegisterWalletServer(srv, walletService)
+
+	// Register Keys service
+	keysService := service.NewKeysService(logger)
+	keysV1.RegisterKeysServer(srv, keysService)
+
+	// Register Treasury service
+	treasuryService := service.NewTreasuryService(logger)
+	treasuryV1.RegisterTreasuryServer(srv, treasuryService)
+
+	// Register Native service
+	nativeService := service.NewNativeService(logger)
+	nativeV1.RegisterNativeServer(srv, nativeService)
+
+	// Register Portfolio service
+	portfolioService := service.NewPortfolioService(logger)
+	portfolioV1.RegisterPortfolioServer(srv, portfolioService)
+
+	// Register Deploy service
+	deployService := service.NewDeployService(logger)
+	deployV1.RegisterDeployServer(srv, deployService)
+
+	// Register Config service
+	configService := service.NewConfigService(logger)
+	configV1.RegisterConfigServer(srv, configService)
+
+	// Register Health service
+	healthService := service.NewHealthService(logger)
+	healthpb.RegisterHealthServer(srv, healthService)
+
 	return srv
 }
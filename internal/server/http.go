@@ -2,12 +2,34 @@
 package server
 
 import (
+	stdhttp "net/http"
+
+	configV1 "eth-contract-service/api/config/v1"
+	contractV1 "eth-contract-service/api/contract/v1"
+	deployV1 "eth-contract-service/api/deploy/v1"
 	erc20V1 "eth-contract-service/api/erc20/v1"
 	erc1155V1 "eth-contract-service/api/erc1155/v1"
 	erc721V1 "eth-contract-service/api/erc721/v1"
+	jobV1 "eth-contract-service/api/job/v1"
+	keysV1 "eth-contract-service/api/keys/v1"
+	nativeV1 "eth-contract-service/api/native/v1"
+	portfolioV1 "eth-contract-service/api/portfolio/v1"
+	relayerV1 "eth-contract-service/api/relayer/v1"
+	safeV1 "eth-contract-service/api/safe/v1"
+	signingV1 "eth-contract-service/api/signing/v1"
+	treasuryV1 "eth-contract-service/api/treasury/v1"
+	txV1 "eth-contract-service/api/tx/v1"
+	walletV1 "eth-contract-service/api/wallet/v1"
 	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/health"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/safe"
 	"eth-contract-service/internal/service"
+	"eth-contract-service/provider/metrics"
+	"eth-contract-service/provider/tracing"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -30,7 +52,13 @@ func NewHTTPServer(c *conf.Server, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			metrics.Server(),
+			service.AdminAuth(),
+			safe.Middleware(),
+			job.Middleware(),
 		),
+		http.ErrorEncoder(errorEncoder),
 	}
 
 	if c.Http.Network != "" {
@@ -44,12 +72,27 @@ func NewHTTPServer(c *conf.Server, logger log.Logger) *http.Server {
 	}
 	srv := http.NewServer(opts...)
 
-	// Register health check endpoint
-	srv.Route("/").GET("/health", func(ctx http.Context) error {
-		return ctx.JSON(200, map[string]string{
-			"status": "ok",
-		})
+	// Register health check endpoints
+	// /livez only reports that the process runs; /readyz and /health run the readiness
+	// checks and answer 503 while a critical dependency is down
+	route := srv.Route("/")
+	route.GET("/livez", func(ctx http.Context) error {
+		return ctx.JSON(200, health.Live())
 	})
+	readyz := func(ctx http.Context) error {
+		report := health.Ready(ctx)
+		if report.Status == health.StatusDown {
+			return ctx.JSON(503, report)
+		}
+		return ctx.JSON(200, report)
+	}
+	route.GET("/readyz", readyz)
+	route.GET("/health", readyz)
+
+	// Register Prometheus metrics endpoint
+	if metrics.Enabled() {
+		srv.Handle(metrics.GetSettings().Path, metrics.Handler())
+	}
 
 	// Register ERC20 service
 	erc20Service := service.NewERC20Service(logger)
@@ -63,5 +106,66 @@ func NewHTTPServer(c *conf.Server, logger log.Logger) *http.Server {
 	erc721Service := service.NewERC721Service(logger)
 	erc721V1.RegisterERC721HTTPServer(srv, erc721Service)
 
+	// Register Job service
+	jobService := service.NewJobService(logger)
+	jobV1.RegisterJobHTTPServer(srv, jobService)
+
+	// Register Transaction service
+	transactionService := service.NewTransactionService(logger)
+	txV1.RegisterTransactionHTTPServer(srv, transactionService)
+
+	// Register Contract service
+	contractService := service.NewContractService(logger)
+	contractV1.RegisterContractHTTPServer(srv, contractService)
+
+	// Register Signing service
+	signingService := service.NewSigningService(logger)
+	signingV1.RegisterSigningHTTPServer(srv, signingService)
+
+	// Register Relayer service
+	relayerService := service.NewRelayerService(logger)
+	relayerV1.RegisterRelayerHTTPServer(srv, relayerService)
+
+	// Register Safe service
+	safeService := service.NewSafeService(logger)
+	safeV1.RegisterSafeHTTPServer(srv, safeService)
+
+	// Register Wallet service
+	walletService := service.NewWalletService(logger)
+	walletV1.RegisterWalletHTTPServer(srv, walletService)
+
+	// Register Keys service
+	keysService := service.NewKeysService(logger)
+	keysV1.RegisterKeysHTTPServer(srv, keysService)
+
+	// Register Treasury service
+	treasuryService := service.NewTreasuryService(logger)
+	treasuryV1.RegisterTreasuryHTTPServer(srv, treasuryService)
+
+	// Register Native service
+	nativeService := service.NewNativeService(logger)
+	nativeV1.RegisterNativeHTTPServer(srv, nativeService)
+
+	// Register Portfolio service
+	portfolioService := service.NewPortfolioService(logger)
+	portfolioV1.RegisterPortfolioHTTPServer(srv, portfolioService)
+
+	// Register Deploy service
+	deployService := service.NewDeployService(logger)
+	deployV1.RegisterDeployHTTPServer(srv, deployService)
+
+	// Register Config service
+	configService := service.NewConfigService(logger)
+	configV1.RegisterConfigHTTPServer(srv, configService)
+
 	return srv
 }
+
+// errorEncoder encodes errors like the default encoder and sets the Retry-After header
+// on errors suggesting when to retry, such as while the Ethereum node is unreachable
+func errorEncoder(w stdhttp.ResponseWriter, r *stdhttp.Request, err error) {
+	if retryAfter := kerrors.FromError(err).GetMetadata()[errors.MetadataRetryAfter]; retryAfter != "" {
+		w.Header().Set("Retry-After", retryAfter)
+	}
+	http.DefaultErrorEncoder(w, r, err)
+}
@@ -0,0 +1,94 @@
+// Package server provides server initialization for both gRPC and HTTP servers.
+package server
+
+import (
+	deployV1 "eth-contract-service/api/deploy/v1"
+	erc1155V1 "eth-contract-service/api/erc1155/v1"
+	erc20V1 "eth-contract-service/api/erc20/v1"
+	erc721V1 "eth-contract-service/api/erc721/v1"
+	nativeV1 "eth-contract-service/api/native/v1"
+	relayerV1 "eth-contract-service/api/relayer/v1"
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/relayer"
+	"eth-contract-service/internal/service"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewJobServer creates the asynchronous job worker server.
+// It registers every write operation that can be submitted with async=true.
+//
+// Parameters:
+//   - c: Job queue configuration
+//   - logger: Logger instance for worker logging
+//
+// Returns:
+//   - *job.Server: A job server managed by the kratos app lifecycle
+func NewJobServer(c *conf.Jobs, logger log.Logger) *job.Server {
+	// Register ERC20 write operations
+	erc20Service := service.NewERC20Service(logger)
+	job.Register(erc20V1.OperationERC20TransferERC20, erc20Service.TransferERC20)
+	job.Register(erc20V1.OperationERC20ApproveERC20, erc20Service.ApproveERC20)
+	job.Register(erc20V1.OperationERC20TransferFromERC20, erc20Service.TransferFromERC20)
+	job.Register(erc20V1.OperationERC20MintERC20, erc20Service.MintERC20)
+	job.Register(erc20V1.OperationERC20BurnERC20, erc20Service.BurnERC20)
+	job.Register(erc20V1.OperationERC20BurnFromERC20, erc20Service.BurnFromERC20)
+	job.Register(erc20V1.OperationERC20DeployERC20, erc20Service.DeployERC20)
+	job.Register(erc20V1.OperationERC20TransferERC20Ownership, erc20Service.TransferERC20Ownership)
+	job.Register(erc20V1.OperationERC20RenounceERC20Ownership, erc20Service.RenounceERC20Ownership)
+
+	// Register ERC721 write operations
+	erc721Service := service.NewERC721Service(logger)
+	job.Register(erc721V1.OperationERC721TransferERC721, erc721Service.TransferERC721)
+	job.Register(erc721V1.OperationERC721SafeTransferERC721, erc721Service.SafeTransferERC721)
+	job.Register(erc721V1.OperationERC721SafeTransferERC721WithData, erc721Service.SafeTransferERC721WithData)
+	job.Register(erc721V1.OperationERC721ApproveERC721, erc721Service.ApproveERC721)
+	job.Register(erc721V1.OperationERC721SetApprovalForAllERC721, erc721Service.SetApprovalForAllERC721)
+	job.Register(erc721V1.OperationERC721SafeMintERC721, erc721Service.SafeMintERC721)
+	job.Register(erc721V1.OperationERC721BurnERC721, erc721Service.BurnERC721)
+	job.Register(erc721V1.OperationERC721DeployERC721, erc721Service.DeployERC721)
+	job.Register(erc721V1.OperationERC721PauseERC721, erc721Service.PauseERC721)
+	job.Register(erc721V1.OperationERC721UnpauseERC721, erc721Service.UnpauseERC721)
+	job.Register(erc721V1.OperationERC721TransferERC721Ownership, erc721Service.TransferERC721Ownership)
+	job.Register(erc721V1.OperationERC721RenounceERC721Ownership, erc721Service.RenounceERC721Ownership)
+
+	// Register ERC1155 write operations
+	erc1155Service := service.NewERC1155Service(logger)
+	job.Register(erc1155V1.OperationERC1155SafeTransferERC1155, erc1155Service.SafeTransferERC1155)
+	job.Register(erc1155V1.OperationERC1155SafeBatchTransferERC1155, erc1155Service.SafeBatchTransferERC1155)
+	job.Register(erc1155V1.OperationERC1155SetApprovalForAllERC1155, erc1155Service.SetApprovalForAllERC1155)
+	job.Register(erc1155V1.OperationERC1155MintERC1155, erc1155Service.MintERC1155)
+	job.Register(erc1155V1.OperationERC1155MintBatchERC1155, erc1155Service.MintBatchERC1155)
+	job.Register(erc1155V1.OperationERC1155BurnERC1155, erc1155Service.BurnERC1155)
+	job.Register(erc1155V1.OperationERC1155BurnBatchERC1155, erc1155Service.BurnBatchERC1155)
+	job.Register(erc1155V1.OperationERC1155DeployERC1155, erc1155Service.DeployERC1155)
+	job.Register(erc1155V1.OperationERC1155PauseERC1155, erc1155Service.PauseERC1155)
+	job.Register(erc1155V1.OperationERC1155UnpauseERC1155, erc1155Service.UnpauseERC1155)
+	job.Register(erc1155V1.OperationERC1155TransferERC1155Ownership, erc1155Service.TransferERC1155Ownership)
+	job.Register(erc1155V1.OperationERC1155RenounceERC1155Ownership, erc1155Service.RenounceERC1155Ownership)
+
+	// Register native currency transfers
+	nativeService := service.NewNativeService(logger)
+	job.Register(nativeV1.OperationNativeTransferNative, nativeService.TransferNative)
+	job.Register(nativeV1.OperationNativeSweepNative, nativeService.SweepNative)
+
+	// Register deployments from registered artifacts
+	deployService := service.NewDeployService(logger)
+	job.Register(deployV1.OperationDeployDeployContract, deployService.DeployContract)
+
+	// Register relayed meta-transactions; their sender is the configured relayer signer and
+	// the sender quota is taken once when the job is enqueued
+	relayerService := service.NewRelayerService(logger)
+	job.Register(relayerV1.OperationRelayerRelay, relayerService.Relay)
+	job.RegisterSigner(relayerV1.OperationRelayerRelay, func() (keystore.Signer, error) {
+		return keystore.GetSigner(relayer.GetSettings().Signer)
+	})
+	job.RegisterAdmission(relayerV1.OperationRelayerRelay, relayerService.AdmitRelay)
+
+	// Operations invoked through job.Call are authorized like direct requests
+	job.Use(service.AdminAuth())
+
+	return job.NewServer(c, logger)
+}
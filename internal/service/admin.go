@@ -0,0 +1,213 @@
+// Package service provides shared helpers for contract administration endpoints.
+package service
+
+import (
+	"context"
+	"strings"
+
+	configV1 "eth-contract-service/api/config/v1"
+	erc20V1 "eth-contract-service/api/erc20/v1"
+	keysV1 "eth-contract-service/api/keys/v1"
+	nativeV1 "eth-contract-service/api/native/v1"
+	safeV1 "eth-contract-service/api/safe/v1"
+	signingV1 "eth-contract-service/api/signing/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// renounceConfirmationPrefix starts the confirmation token required to renounce ownership
+const renounceConfirmationPrefix = "RENOUNCE "
+
+// ownable is implemented by the bindings of contracts based on OpenZeppelin Ownable
+type ownable interface {
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// pausable is implemented by the bindings of contracts based on OpenZeppelin Pausable
+type pausable interface {
+	Paused(opts *bind.CallOpts) (bool, error)
+	Pause(opts *bind.TransactOpts) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// adminSigner returns the signer of an administration request.
+// The admin signer is used when useAdmin is set and admin.allow_use_admin is on,
+// otherwise the request private key.
+func adminSigner(privateKey string, useAdmin bool) (keystore.Signer, error) {
+	if !useAdmin {
+		keyBytes, err := validator.ValidatePrivateKey(privateKey)
+		if err != nil {
+			return nil, validator.ToAppError(err)
+		}
+		key, err := crypto.ToECDSA(keyBytes)
+		if err != nil {
+			return nil, errors.WrapError(err, errors.CodeInvalidArgument, errors.ErrInvalidPrivateKey.Message)
+		}
+		return keystore.NewKeySigner(key), nil
+	}
+
+	if privateKey != "" {
+		return nil, errors.InvalidArgument("private_key and use_admin cannot be used together")
+	}
+	if !keystore.AdminUseAllowed() {
+		return nil, errors.ErrAdminSigningDisabled
+	}
+	signer, err := keystore.GetSigner(keystore.SignerAdmin)
+	if err != nil {
+		return nil, errors.InvalidArgument("admin keystore not initialized, cannot use admin key")
+	}
+	return signer, nil
+}
+
+// adminAddressOperations only use the admin address when use_admin is set and never sign with it
+var adminAddressOperations = map[string]bool{
+	erc20V1.OperationERC20DeployERC20: true,
+}
+
+// adminOperations always require an admin API token because they expose the configuration
+// or act with server-held keys
+var adminOperations = map[string]bool{
+	configV1.OperationConfigGetEffectiveConfig:    true,
+	safeV1.OperationSafeRejectSafeProposal:        true,
+	keysV1.OperationKeysGenerateKey:               true,
+	keysV1.OperationKeysImportKey:                 true,
+	keysV1.OperationKeysExportKey:                 true,
+	keysV1.OperationKeysChangeKeyPassword:         true,
+	keysV1.OperationKeysListKeys:                  true,
+	keysV1.OperationKeysDisableKey:                true,
+	keysV1.OperationKeysUnlockKey:                 true,
+	keysV1.OperationKeysLockKey:                   true,
+	keysV1.OperationKeysRotateRole:                true,
+	keysV1.OperationKeysListRoles:                 true,
+	signingV1.OperationSigningSignTypedData:       true,
+	signingV1.OperationSigningSignPersonalMessage: true,
+}
+
+// signerOperations accept a server-held signer, such as an HD wallet account or a Safe
+// owner key, in their signer field, which requires an admin API token
+var signerOperations = map[string]bool{
+	nativeV1.OperationNativeTransferNative:  true,
+	nativeV1.OperationNativeSweepNative:     true,
+	erc20V1.OperationERC20TransferERC20:     true,
+	safeV1.OperationSafeApproveSafeProposal: true,
+}
+
+// useAdminRequest is implemented by requests that can be signed with the admin signer
+type useAdminRequest interface {
+	GetUseAdmin() bool
+}
+
+// signerRequest is implemented by requests that can name a server-held signer
+type signerRequest interface {
+	GetSigner() string
+}
+
+// AdminAuth returns a server middleware that guards requests signed with server-held keys.
+// Requests setting use_admin are refused unless admin.allow_use_admin is on. They, requests
+// naming a server-held signer and the admin operations must carry one of admin.api_tokens
+// as a bearer token in the authorization header or metadata.
+// It runs before the job middleware so that queued jobs are authorized when submitted.
+func AdminAuth() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			operation := tr.Operation()
+
+			if adminOperations[operation] {
+				return authorizeAdmin(ctx, tr, req, handler)
+			}
+			if sr, ok := req.(signerRequest); ok && signerOperations[operation] && sr.GetSigner() != "" {
+				return authorizeAdmin(ctx, tr, req, handler)
+			}
+			ar, ok := req.(useAdminRequest)
+			if !ok || !ar.GetUseAdmin() || adminAddressOperations[operation] {
+				return handler(ctx, req)
+			}
+			if !keystore.AdminUseAllowed() {
+				return nil, errors.ToGRPCError(errors.ErrAdminSigningDisabled)
+			}
+			return authorizeAdmin(ctx, tr, req, handler)
+		}
+	}
+}
+
+// authorizeAdmin invokes the handler when the request carries a valid admin API token
+func authorizeAdmin(ctx context.Context, tr transport.Transporter, req interface{}, handler middleware.Handler) (interface{}, error) {
+	token, found := strings.CutPrefix(tr.RequestHeader().Get("Authorization"), "Bearer ")
+	if !found || !keystore.AuthorizeAdmin(strings.TrimSpace(token)) {
+		return nil, errors.ToGRPCError(errors.ErrAdminUnauthenticated)
+	}
+	return handler(ctx, req)
+}
+
+// walletSigner returns the signer of a write request that may name an HD wallet account
+// in its signer field instead of carrying a private key. AdminAuth has checked the admin
+// API token of requests naming a signer.
+func walletSigner(privateKey string, useAdmin bool, signer string) (keystore.Signer, error) {
+	if signer == "" {
+		return adminSigner(privateKey, useAdmin)
+	}
+	if privateKey != "" || useAdmin {
+		return nil, errors.InvalidArgument("signer cannot be used together with private_key or use_admin")
+	}
+	if _, ok, err := keystore.ParseHDSigner(signer); !ok || err != nil {
+		return nil, errors.InvalidArgument("signer must be an HD wallet account hd:<index>")
+	}
+	return resolveSigner(signer)
+}
+
+// prepareAdminTx resolves the signing key of an administration request, checks that it
+// belongs to the contract owner and creates the transaction options.
+//
+// Parameters:
+//   - ctx: Context for the owner query and transaction options
+//   - client: Contract client used to derive the sender and transaction options
+//   - token: Ownable contract binding
+//   - privateKey: Private key of the request (empty when useAdmin is set)
+//   - useAdmin: Sign with the admin keystore
+//
+// Returns:
+//   - *bind.TransactOpts: Transaction options of the owner
+//   - error: Error if the key is invalid or does not belong to the owner
+func prepareAdminTx(ctx context.Context, client *contract.Client, token ownable, privateKey string, useAdmin bool) (*bind.TransactOpts, error) {
+	signer, err := adminSigner(privateKey, useAdmin)
+	if err != nil {
+		return nil, err
+	}
+	from := signer.Address()
+
+	// Reject early instead of sending a transaction that reverts with OwnableUnauthorizedAccount
+	owner, err := token.Owner(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		return nil, errors.WrapError(err, errors.CodeInternal, "failed to get owner")
+	}
+	if sender := contract.SenderFromContext(ctx, from); owner != sender {
+		return nil, errors.FailedPrecondition("signer %s is not the contract owner %s", sender.Hex(), owner.Hex())
+	}
+
+	return client.CreateSignerTransactOpts(ctx, signer)
+}
+
+// validateRenounceConfirmation checks the confirmation token of a renounce ownership request
+func validateRenounceConfirmation(contractAddr common.Address, confirmation string) error {
+	expected := renounceConfirmationPrefix + contractAddr.Hex()
+	if !strings.EqualFold(strings.TrimSpace(confirmation), expected) {
+		return errors.InvalidArgument("confirmation must be %q to renounce ownership", expected)
+	}
+	return nil
+}
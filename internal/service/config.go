@@ -0,0 +1,54 @@
+// Package service provides business logic services for the effective configuration.
+package service
+
+import (
+	"context"
+
+	pb "eth-contract-service/api/config/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/reload"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ConfigService implements the Config API service.
+// It reports the configuration currently applied, including runtime reloads.
+type ConfigService struct {
+	pb.UnimplementedConfigServer
+	logger *log.Helper // logger for service logging
+}
+
+// NewConfigService creates a new instance of ConfigService.
+func NewConfigService(logger log.Logger) *ConfigService {
+	return &ConfigService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// GetEffectiveConfig returns the applied configuration with secrets redacted.
+func (s *ConfigService) GetEffectiveConfig(ctx context.Context, req *pb.GetEffectiveConfigRequest) (*pb.GetEffectiveConfigResponse, error) {
+	bc, version, reloadedAt := reload.Effective()
+	if bc == nil {
+		return nil, errors.ToGRPCError(errors.NewError(errors.CodeUnavailable, "configuration watch not started"))
+	}
+
+	// Keys follow config.yaml, e.g. hd_wallet rather than hdWallet
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(bc)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to encode effective configuration: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to encode configuration"))
+	}
+	cfg := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, cfg); err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to encode configuration"))
+	}
+
+	return &pb.GetEffectiveConfigResponse{
+		Config:     cfg,
+		Version:    version,
+		ReloadedAt: reloadedAt.Unix(),
+		Reloadable: reload.Reloadable,
+	}, nil
+}
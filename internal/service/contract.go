@@ -0,0 +1,84 @@
+// Package service provides business logic services for contract inspection.
+package service
+
+import (
+	"context"
+	"fmt"
+
+	pb "eth-contract-service/api/contract/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/detect"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ContractService implements the Contract API service.
+// It provides methods for inspecting arbitrary contracts.
+type ContractService struct {
+	pb.UnimplementedContractServer
+	logger         *log.Helper      // logger for service logging
+	contractClient *contract.Client // client for contract interactions
+}
+
+// NewContractService creates a new instance of ContractService.
+func NewContractService(logger log.Logger) *ContractService {
+	return &ContractService{
+		logger:         log.NewHelper(logger),
+		contractClient: contract.NewClient(logger),
+	}
+}
+
+// DetectContract identifies the token standard and optional extensions of a contract
+// using ERC165 and method probing.
+func (s *ContractService) DetectContract(ctx context.Context, req *pb.DetectContractRequest) (*pb.DetectContractResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	res, err := detect.Detect(ctx, contractAddr, req.Refresh)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to detect contract: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to detect contract"))
+	}
+
+	s.logger.WithContext(ctx).Infof("contract detected: contract=%s, standard=%s, erc165=%t, ownable=%t, pausable=%t, cached=%t",
+		contractAddr.Hex(), res.Standard, res.ERC165, res.Ownable, res.Pausable, res.Cached)
+
+	resp := &pb.DetectContractResponse{
+		ContractAddress: req.ContractAddress,
+		IsContract:      res.IsContract,
+		Standard:        string(res.Standard),
+		SupportsErc165:  res.ERC165,
+		Interfaces:      make([]*pb.InterfaceSupport, 0, len(detect.Interfaces)),
+		Erc20:           res.ERC20,
+		Ownable:         res.Ownable,
+		Pausable:        res.Pausable,
+		Cached:          res.Cached,
+		DetectedAt:      res.DetectedAt.Unix(),
+	}
+	if res.Ownable {
+		resp.Owner = res.Owner.Hex()
+	}
+	for _, iface := range detect.Interfaces {
+		resp.Interfaces = append(resp.Interfaces, &pb.InterfaceSupport{
+			Name:        iface.Name,
+			InterfaceId: fmt.Sprintf("0x%x", iface.ID),
+			Supported:   res.Supports(iface.Name),
+		})
+	}
+
+	return resp, nil
+}
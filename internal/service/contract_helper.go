@@ -2,9 +2,11 @@
 package service
 
 import (
+	"context"
 	"math/big"
 
 	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/detect"
 	"eth-contract-service/provider/contract/erc20"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -38,11 +40,15 @@ func getContractType(contractTypeStr string) contract.ContractType {
 	return contract.ContractTypeStandard
 }
 
-// getERC20Contract gets the appropriate ERC20 contract instance
+// getERC20Contract gets the appropriate ERC20 contract instance.
+// When contractTypeStr is empty the binding is chosen by detecting whether the contract is Ownable.
 //
 //nolint:unused // This function is used in service methods
-func (s *ERC20Service) getERC20Contract(contractAddr common.Address, contractTypeStr string) (ERC20Contract, error) {
+func (s *ERC20Service) getERC20Contract(ctx context.Context, contractAddr common.Address, contractTypeStr string) (ERC20Contract, error) {
 	contractType := getContractType(contractTypeStr)
+	if contractTypeStr == "" {
+		contractType = s.detectContractType(ctx, contractAddr)
+	}
 
 	switch contractType {
 	case contract.ContractTypeOwnable:
@@ -62,6 +68,20 @@ func (s *ERC20Service) getERC20Contract(contractAddr common.Address, contractTyp
 	}
 }
 
+// detectContractType picks the ERC20 binding for a contract from its detected capabilities.
+// Detection failures fall back to the standard binding.
+func (s *ERC20Service) detectContractType(ctx context.Context, contractAddr common.Address) contract.ContractType {
+	res, err := detect.Detect(ctx, contractAddr, false)
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to detect contract type, using standard: contract=%s, error=%v", contractAddr.Hex(), err)
+		return contract.ContractTypeStandard
+	}
+	if res.Ownable {
+		return contract.ContractTypeOwnable
+	}
+	return contract.ContractTypeStandard
+}
+
 // ERC20TokenWrapper wraps ERC20Token to implement ERC20Contract interface
 type ERC20TokenWrapper struct {
 	token *erc20.ERC20Token
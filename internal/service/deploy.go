@@ -0,0 +1,392 @@
+// Package service provides business logic services for deployments from registered artifacts.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	pb "eth-contract-service/api/deploy/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/deploy"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DeployService implements the Deploy API service.
+// It registers compiler artifacts and deploys them with CREATE or a CREATE2 factory.
+type DeployService struct {
+	pb.UnimplementedDeployServer
+	contractClient *contract.Client // contract client for transaction signing
+	logger         *log.Helper      // logger for service logging
+}
+
+// NewDeployService creates a new instance of DeployService.
+func NewDeployService(logger log.Logger) *DeployService {
+	return &DeployService{
+		contractClient: contract.NewClient(logger),
+		logger:         log.NewHelper(logger),
+	}
+}
+
+// RegisterArtifact registers a compiled contract under a name and version.
+func (s *DeployService) RegisterArtifact(ctx context.Context, req *pb.RegisterArtifactRequest) (*pb.RegisterArtifactResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	a, created, err := deploy.RegisterArtifact(ctx, &deploy.ArtifactInput{
+		Name:                req.Name,
+		Version:             req.Version,
+		ABI:                 req.Abi,
+		Bytecode:            req.Bytecode,
+		DeployedBytecode:    req.DeployedBytecode,
+		ImmutableReferences: req.ImmutableReferences,
+		Metadata:            req.Metadata,
+		Sources:             req.Sources,
+	})
+	if err != nil {
+		if errors.IsAppError(err) {
+			return nil, errors.ToGRPCError(err)
+		}
+		s.logger.WithContext(ctx).Errorf("failed to register artifact: name=%s, version=%s, error=%v", req.Name, req.Version, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to register artifact"))
+	}
+
+	if created {
+		s.logger.WithContext(ctx).Infof("artifact registered: name=%s, version=%s, contract=%s, compiler=%s",
+			a.Name, a.Version, a.ContractName, a.CompilerVersion)
+	}
+	return &pb.RegisterArtifactResponse{Artifact: toArtifact(a, true), Created: created}, nil
+}
+
+// GetArtifact returns a registered artifact.
+func (s *DeployService) GetArtifact(ctx context.Context, req *pb.GetArtifactRequest) (*pb.GetArtifactResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.Name == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("name cannot be empty"))
+	}
+
+	a, err := deploy.GetArtifact(ctx, req.Name, req.Version)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.GetArtifactResponse{Artifact: toArtifact(a, true)}, nil
+}
+
+// ListArtifacts returns registered artifacts, newest first.
+func (s *DeployService) ListArtifacts(ctx context.Context, req *pb.ListArtifactsRequest) (*pb.ListArtifactsResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	artifacts, total, err := deploy.ListArtifacts(ctx, deploy.ArtifactFilter{
+		Name:   req.Name,
+		Offset: (page - 1) * pageSize,
+		Limit:  pageSize,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list artifacts: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to list artifacts"))
+	}
+
+	resp := &pb.ListArtifactsResponse{Artifacts: make([]*pb.Artifact, 0, len(artifacts)), Total: total}
+	for _, a := range artifacts {
+		resp.Artifacts = append(resp.Artifacts, toArtifact(a, false))
+	}
+	return resp, nil
+}
+
+// DeployContract deploys a registered artifact with its constructor arguments given as JSON.
+// With create2 the contract is created by the CREATE2 factory, so that its address only
+// depends on the factory, the salt and the init code. The deployment is recorded and,
+// unless skip_wait is set, the receipt is awaited for up to deploy.wait_timeout and the
+// code at the contract address is checked against the deployed bytecode of the artifact.
+func (s *DeployService) DeployContract(ctx context.Context, req *pb.DeployContractRequest) (*pb.DeployContractResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.ArtifactName == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("artifact_name cannot be empty"))
+	}
+
+	// Validate CREATE2 options
+	salt, err := deploy.ParseSalt(req.Salt)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	if !req.Create2 && (req.Salt != "" || req.FactoryAddress != "") {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("salt and factory_address require create2"))
+	}
+	factory := deploy.GetSettings().Create2Factory
+	if req.FactoryAddress != "" {
+		if factory, err = validator.ValidateAddress(req.FactoryAddress, "factory_address"); err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+	}
+
+	var value *big.Int
+	if req.Value != "" {
+		if value, err = validator.ValidateAmount(req.Value, "value"); err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+	}
+	signer, err := adminSigner(req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.ToGRPCError(errors.ErrClientNotInitialized)
+	}
+
+	plan, err := deploy.NewPlan(ctx, req.ArtifactName, req.ArtifactVersion, req.ConstructorArgs)
+	if err != nil {
+		if errors.IsAppError(err) {
+			return nil, errors.ToGRPCError(err)
+		}
+		s.logger.WithContext(ctx).Errorf("failed to prepare deployment: artifact=%s, version=%s, error=%v",
+			req.ArtifactName, req.ArtifactVersion, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to prepare deployment"))
+	}
+
+	auth, err := s.contractClient.CreateSignerTransactOpts(ctx, signer)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+	auth.Value = value
+	// A Safe proposal or an unsigned build deploys from another account
+	deployerAddr := contract.SenderFromContext(ctx, auth.From)
+
+	var contractAddr common.Address
+	var tx *types.Transaction
+	if req.Create2 {
+		contractAddr = plan.Create2Address(factory, salt)
+		if code, err := client.CodeAt(ctx, factory, nil); err != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get factory code"))
+		} else if len(code) == 0 {
+			return nil, errors.ToGRPCError(errors.FailedPrecondition("CREATE2 factory %s has no code on this chain", factory.Hex()))
+		}
+		if code, err := client.CodeAt(ctx, contractAddr, nil); err != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get contract code"))
+		} else if len(code) > 0 {
+			return nil, errors.ToGRPCError(errors.FailedPrecondition("contract already deployed at %s, use another salt", contractAddr.Hex()))
+		}
+		tx, err = bind.NewBoundContract(factory, abi.ABI{}, client, client, client).RawTransact(auth, plan.Create2Calldata(salt))
+	} else {
+		if deployerAddr != auth.From {
+			return nil, errors.ToGRPCError(errors.InvalidArgument("contract deployments cannot be proposed to a Safe, deploy with create2"))
+		}
+		contractAddr, tx, _, err = bind.DeployContract(auth, plan.ABI, plan.Bytecode, client, plan.Args...)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to deploy contract: artifact=%s, version=%s, create2=%t, error=%v",
+			plan.Artifact.Name, plan.Artifact.Version, req.Create2, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to deploy contract"))
+	}
+
+	d := &deploy.Deployment{
+		ArtifactName:    plan.Artifact.Name,
+		ArtifactVersion: plan.Artifact.Version,
+		Address:         contractAddr.Hex(),
+		Deployer:        deployerAddr.Hex(),
+		ConstructorArgs: hexutil.Encode(plan.EncodedArgs),
+	}
+	if req.Create2 {
+		d.Salt = salt.Hex()
+		d.Factory = factory.Hex()
+	}
+	// Safe proposals and unsigned builds send a different transaction, if any
+	capture := contract.TxCaptureFromContext(ctx)
+	if capture == nil || capture.Broadcasts() {
+		d.TxHash = tx.Hash().Hex()
+	}
+	if err := deploy.Record(ctx, d); err != nil {
+		// The transaction is already signed and possibly sent, so the deployment goes on
+		s.logger.WithContext(ctx).Errorf("failed to record deployment: contract=%s, tx=%s, error=%v", d.Address, tx.Hash().Hex(), err)
+	} else if capture == nil && !req.SkipWait {
+		waitCtx, cancel := context.WithTimeout(ctx, deploy.GetSettings().WaitTimeout)
+		if err := deploy.Wait(waitCtx, d); err != nil {
+			s.logger.WithContext(ctx).Warnf("failed to confirm deployment: id=%s, contract=%s, error=%v", d.ID, d.Address, err)
+		}
+		cancel()
+	}
+
+	s.logger.WithContext(ctx).Infof("contract deployed: artifact=%s, version=%s, contract=%s, deployer=%s, tx=%s, status=%s",
+		d.ArtifactName, d.ArtifactVersion, d.Address, d.Deployer, tx.Hash().Hex(), d.Status)
+
+	return &pb.DeployContractResponse{
+		DeploymentId:    d.ID,
+		ContractAddress: d.Address,
+		DeployerAddress: d.Deployer,
+		TxHash:          tx.Hash().Hex(),
+		ArtifactName:    d.ArtifactName,
+		ArtifactVersion: d.ArtifactVersion,
+		ConstructorArgs: d.ConstructorArgs,
+		Salt:            d.Salt,
+		FactoryAddress:  d.Factory,
+		Status:          string(d.Status),
+		CodeChecked:     d.CodeChecked,
+		BlockNumber:     d.BlockNumber,
+		GasUsed:         d.GasUsed,
+		Error:           d.Error,
+	}, nil
+}
+
+// GetDeployment returns a deployment. A pending deployment is checked again first.
+func (s *DeployService) GetDeployment(ctx context.Context, req *pb.GetDeploymentRequest) (*pb.GetDeploymentResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.DeploymentId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("deployment_id cannot be empty"))
+	}
+
+	d, err := deploy.GetDeployment(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	s.refresh(ctx, d)
+	return &pb.GetDeploymentResponse{Deployment: toDeployment(d)}, nil
+}
+
+// ListDeployments returns deployments, newest first. Pending deployments on the page are
+// checked again first.
+func (s *DeployService) ListDeployments(ctx context.Context, req *pb.ListDeploymentsRequest) (*pb.ListDeploymentsResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	filter := deploy.DeploymentFilter{ArtifactName: req.ArtifactName, Status: deploy.Status(req.Status)}
+	if req.ContractAddress != "" {
+		addr, err := validator.ValidateAddress(req.ContractAddress, "contract_address")
+		if err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+		filter.Address = addr.Hex()
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	filter.Offset = (page - 1) * pageSize
+	filter.Limit = pageSize
+
+	deployments, total, err := deploy.ListDeployments(ctx, filter)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list deployments: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to list deployments"))
+	}
+
+	resp := &pb.ListDeploymentsResponse{Deployments: make([]*pb.Deployment, 0, len(deployments)), Total: total}
+	for _, d := range deployments {
+		s.refresh(ctx, d)
+		resp.Deployments = append(resp.Deployments, toDeployment(d))
+	}
+	return resp, nil
+}
+
+// GetVerificationBundle returns the fields of a source verification request for a
+// confirmed deployment, such as the standard JSON input for Etherscan-like explorers.
+func (s *DeployService) GetVerificationBundle(ctx context.Context, req *pb.GetVerificationBundleRequest) (*pb.GetVerificationBundleResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.DeploymentId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("deployment_id cannot be empty"))
+	}
+
+	d, err := deploy.GetDeployment(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	s.refresh(ctx, d)
+
+	bundle, err := deploy.VerificationBundle(ctx, d)
+	if err != nil {
+		if errors.IsAppError(err) {
+			return nil, errors.ToGRPCError(err)
+		}
+		s.logger.WithContext(ctx).Errorf("failed to build verification bundle: id=%s, error=%v", d.ID, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to build verification bundle"))
+	}
+
+	return &pb.GetVerificationBundleResponse{
+		ContractAddress:      bundle.Address,
+		ChainId:              bundle.ChainID,
+		CodeFormat:           bundle.CodeFormat,
+		ContractName:         bundle.ContractName,
+		CompilerVersion:      bundle.CompilerVersion,
+		ConstructorArguments: bundle.ConstructorArguments,
+		StandardJsonInput:    bundle.StandardJSONInput,
+		OptimizationUsed:     bundle.OptimizationUsed,
+		Runs:                 bundle.Runs,
+		EvmVersion:           bundle.EVMVersion,
+	}, nil
+}
+
+// refresh checks a pending deployment again; failures leave it pending
+func (s *DeployService) refresh(ctx context.Context, d *deploy.Deployment) {
+	if err := deploy.Refresh(ctx, d); err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to refresh deployment: id=%s, contract=%s, error=%v", d.ID, d.Address, err)
+	}
+}
+
+// toArtifact converts an artifact to its API representation, with or without its code
+func toArtifact(a *deploy.Artifact, full bool) *pb.Artifact {
+	info := &pb.Artifact{
+		Name:            a.Name,
+		Version:         a.Version,
+		ContractName:    a.ContractName,
+		SourcePath:      a.SourcePath,
+		CompilerVersion: a.CompilerVersion,
+		CreatedAt:       a.CreatedAt.Unix(),
+	}
+	if !full {
+		return info
+	}
+	info.Abi = a.ABI
+	info.Bytecode = a.Bytecode
+	info.DeployedBytecode = a.DeployedBytecode
+	info.ImmutableReferences = a.ImmutableReferences
+	info.Metadata = a.Metadata
+	if a.Sources != "" {
+		_ = json.Unmarshal([]byte(a.Sources), &info.Sources)
+	}
+	return info
+}
+
+// toDeployment converts a deployment to its API representation
+func toDeployment(d *deploy.Deployment) *pb.Deployment {
+	return &pb.Deployment{
+		DeploymentId:    d.ID,
+		ArtifactName:    d.ArtifactName,
+		ArtifactVersion: d.ArtifactVersion,
+		ContractAddress: d.Address,
+		DeployerAddress: d.Deployer,
+		TxHash:          d.TxHash,
+		ConstructorArgs: d.ConstructorArgs,
+		Salt:            d.Salt,
+		FactoryAddress:  d.Factory,
+		Status:          string(d.Status),
+		CodeChecked:     d.CodeChecked,
+		BlockNumber:     d.BlockNumber,
+		GasUsed:         d.GasUsed,
+		Error:           d.Error,
+		CreatedAt:       d.CreatedAt.Unix(),
+		UpdatedAt:       d.UpdatedAt.Unix(),
+	}
+}
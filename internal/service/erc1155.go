@@ -8,6 +8,7 @@ import (
 	pb "eth-contract-service/api/erc1155/v1"
 	"eth-contract-service/internal/contract"
 	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/metadata"
 	"eth-contract-service/internal/validator"
 	"eth-contract-service/provider/contract/erc1155"
 	"eth-contract-service/provider/eth"
@@ -63,19 +64,19 @@ func (s *ERC1155Service) GetERC1155Balance(ctx context.Context, req *pb.GetERC11
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get balance
 	balance, err := token.BalanceOf(nil, accountAddr, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to get balance: contract=%s, account=%s, token_id=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to get balance: contract=%s, account=%s, token_id=%s, error=%v",
 			contractAddr.Hex(), accountAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get balance"))
 	}
 
-	s.logger.Infof("balance queried: contract=%s, account=%s, token_id=%s, balance=%s",
+	s.logger.WithContext(ctx).Infof("balance queried: contract=%s, account=%s, token_id=%s, balance=%s",
 		contractAddr.Hex(), accountAddr.Hex(), tokenID.String(), balance.String())
 
 	return &pb.GetERC1155BalanceResponse{
@@ -140,14 +141,14 @@ func (s *ERC1155Service) GetERC1155BalancesBatch(ctx context.Context, req *pb.Ge
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get batch balances
 	balances, err := token.BalanceOfBatch(nil, accounts, tokenIDs)
 	if err != nil {
-		s.logger.Errorf("failed to get batch balances: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get batch balances: contract=%s, error=%v", contractAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get batch balances"))
 	}
 
@@ -157,7 +158,7 @@ func (s *ERC1155Service) GetERC1155BalancesBatch(ctx context.Context, req *pb.Ge
 		balanceStrings[i] = balance.String()
 	}
 
-	s.logger.Infof("batch balances queried: contract=%s, num_accounts=%d", contractAddr.Hex(), len(accounts))
+	s.logger.WithContext(ctx).Infof("batch balances queried: contract=%s, num_accounts=%d", contractAddr.Hex(), len(accounts))
 
 	return &pb.GetERC1155BalancesBatchResponse{
 		Balances:        balanceStrings,
@@ -193,23 +194,24 @@ func (s *ERC1155Service) GetERC1155TokenURI(ctx context.Context, req *pb.GetERC1
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get token URI
 	tokenURI, err := token.Uri(nil, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to get token URI: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token URI: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get token URI"))
 	}
 
-	s.logger.Infof("token URI queried: contract=%s, token_id=%s, uri=%s", contractAddr.Hex(), tokenID.String(), tokenURI)
+	s.logger.WithContext(ctx).Infof("token URI queried: contract=%s, token_id=%s, uri=%s", contractAddr.Hex(), tokenID.String(), tokenURI)
 
 	return &pb.GetERC1155TokenURIResponse{
 		TokenUri:        tokenURI,
 		ContractAddress: req.ContractAddress,
 		TokenId:         req.TokenId,
+		ResolvedUri:     metadata.ExpandID(tokenURI, tokenID),
 	}, nil
 }
 
@@ -243,19 +245,19 @@ func (s *ERC1155Service) IsApprovedForAllERC1155(ctx context.Context, req *pb.Is
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Check approval
 	approved, err := token.IsApprovedForAll(nil, accountAddr, operatorAddr)
 	if err != nil {
-		s.logger.Errorf("failed to check approval: contract=%s, account=%s, operator=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to check approval: contract=%s, account=%s, operator=%s, error=%v",
 			contractAddr.Hex(), accountAddr.Hex(), operatorAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to check approval"))
 	}
 
-	s.logger.Infof("approval checked: contract=%s, account=%s, operator=%s, approved=%v",
+	s.logger.WithContext(ctx).Infof("approval checked: contract=%s, account=%s, operator=%s, approved=%v",
 		contractAddr.Hex(), accountAddr.Hex(), operatorAddr.Hex(), approved)
 
 	return &pb.IsApprovedForAllERC1155Response{
@@ -318,34 +320,34 @@ func (s *ERC1155Service) SafeTransferERC1155(ctx context.Context, req *pb.SafeTr
 	}
 
 	// Verify sender matches from_address
-	if senderAddr.Hex() != fromAddr.Hex() {
+	if contract.SenderFromContext(ctx, senderAddr).Hex() != fromAddr.Hex() {
 		return nil, errors.ToGRPCError(errors.InvalidArgument("private key does not match from_address"))
 	}
 
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Transfer token
 	tx, err := token.SafeTransferFrom(auth, fromAddr, toAddr, tokenID, amount, req.Data)
 	if err != nil {
-		s.logger.Errorf("failed to transfer token: contract=%s, from=%s, to=%s, token_id=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to transfer token: contract=%s, from=%s, to=%s, token_id=%s, amount=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("transfer initiated: contract=%s, from=%s, to=%s, token_id=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("transfer initiated: contract=%s, from=%s, to=%s, token_id=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), amount.String(), txHash.Hex())
 
 	return &pb.SafeTransferERC1155Response{
@@ -432,34 +434,34 @@ func (s *ERC1155Service) SafeBatchTransferERC1155(ctx context.Context, req *pb.S
 	}
 
 	// Verify sender matches from_address
-	if senderAddr.Hex() != fromAddr.Hex() {
+	if contract.SenderFromContext(ctx, senderAddr).Hex() != fromAddr.Hex() {
 		return nil, errors.ToGRPCError(errors.InvalidArgument("private key does not match from_address"))
 	}
 
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Batch transfer tokens
 	tx, err := token.SafeBatchTransferFrom(auth, fromAddr, toAddr, tokenIDs, amounts, req.Data)
 	if err != nil {
-		s.logger.Errorf("failed to batch transfer tokens: contract=%s, from=%s, to=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to batch transfer tokens: contract=%s, from=%s, to=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to batch transfer tokens"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("batch transfer initiated: contract=%s, from=%s, to=%s, num_tokens=%d, tx=%s",
+	s.logger.WithContext(ctx).Infof("batch transfer initiated: contract=%s, from=%s, to=%s, num_tokens=%d, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), len(tokenIDs), txHash.Hex())
 
 	return &pb.SafeBatchTransferERC1155Response{
@@ -509,27 +511,27 @@ func (s *ERC1155Service) SetApprovalForAllERC1155(ctx context.Context, req *pb.S
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Set approval for all
 	tx, err := token.SetApprovalForAll(auth, operatorAddr, req.Approved)
 	if err != nil {
-		s.logger.Errorf("failed to set approval for all: contract=%s, owner=%s, operator=%s, approved=%v, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to set approval for all: contract=%s, owner=%s, operator=%s, approved=%v, error=%v",
 			contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), req.Approved, err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to set approval for all"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("approval for all initiated: contract=%s, owner=%s, operator=%s, approved=%v, tx=%s",
+	s.logger.WithContext(ctx).Infof("approval for all initiated: contract=%s, owner=%s, operator=%s, approved=%v, tx=%s",
 		contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), req.Approved, txHash.Hex())
 
 	return &pb.SetApprovalForAllERC1155Response{
@@ -584,27 +586,27 @@ func (s *ERC1155Service) MintERC1155(ctx context.Context, req *pb.MintERC1155Req
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Mint token
 	tx, err := token.Mint(auth, toAddr, tokenID, amount, req.Data)
 	if err != nil {
-		s.logger.Errorf("failed to mint token: contract=%s, to=%s, token_id=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to mint token: contract=%s, to=%s, token_id=%s, amount=%s, error=%v",
 			contractAddr.Hex(), toAddr.Hex(), tokenID.String(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to mint token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("mint initiated: contract=%s, to=%s, token_id=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("mint initiated: contract=%s, to=%s, token_id=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), toAddr.Hex(), tokenID.String(), amount.String(), txHash.Hex())
 
 	return &pb.MintERC1155Response{
@@ -681,27 +683,27 @@ func (s *ERC1155Service) MintBatchERC1155(ctx context.Context, req *pb.MintBatch
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Batch mint tokens
 	tx, err := token.MintBatch(auth, toAddr, tokenIDs, amounts, req.Data)
 	if err != nil {
-		s.logger.Errorf("failed to batch mint tokens: contract=%s, to=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to batch mint tokens: contract=%s, to=%s, error=%v",
 			contractAddr.Hex(), toAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to batch mint tokens"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("batch mint initiated: contract=%s, to=%s, num_tokens=%d, tx=%s",
+	s.logger.WithContext(ctx).Infof("batch mint initiated: contract=%s, to=%s, num_tokens=%d, tx=%s",
 		contractAddr.Hex(), toAddr.Hex(), len(tokenIDs), txHash.Hex())
 
 	return &pb.MintBatchERC1155Response{
@@ -767,27 +769,27 @@ func (s *ERC1155Service) BurnERC1155(ctx context.Context, req *pb.BurnERC1155Req
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Burn token
 	tx, err := token.Burn(auth, accountAddr, tokenID, amount)
 	if err != nil {
-		s.logger.Errorf("failed to burn token: contract=%s, account=%s, token_id=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to burn token: contract=%s, account=%s, token_id=%s, amount=%s, error=%v",
 			contractAddr.Hex(), accountAddr.Hex(), tokenID.String(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to burn token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("burn initiated: contract=%s, account=%s, token_id=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("burn initiated: contract=%s, account=%s, token_id=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), accountAddr.Hex(), tokenID.String(), amount.String(), txHash.Hex())
 
 	return &pb.BurnERC1155Response{
@@ -875,27 +877,27 @@ func (s *ERC1155Service) BurnBatchERC1155(ctx context.Context, req *pb.BurnBatch
 	// Create ERC1155 contract instance
 	token, err := s.contractClient.GetERC1155Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC1155 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Batch burn tokens
 	tx, err := token.BurnBatch(auth, accountAddr, tokenIDs, amounts)
 	if err != nil {
-		s.logger.Errorf("failed to batch burn tokens: contract=%s, account=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to batch burn tokens: contract=%s, account=%s, error=%v",
 			contractAddr.Hex(), accountAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to batch burn tokens"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("batch burn initiated: contract=%s, account=%s, num_tokens=%d, tx=%s",
+	s.logger.WithContext(ctx).Infof("batch burn initiated: contract=%s, account=%s, num_tokens=%d, tx=%s",
 		contractAddr.Hex(), accountAddr.Hex(), len(tokenIDs), txHash.Hex())
 
 	return &pb.BurnBatchERC1155Response{
@@ -934,6 +936,8 @@ func (s *ERC1155Service) DeployERC1155(ctx context.Context, req *pb.DeployERC115
 	if err != nil {
 		return nil, errors.ToGRPCError(err)
 	}
+	// An unsigned build deploys from the external signer
+	deployerAddr = contract.SenderFromContext(ctx, deployerAddr)
 
 	// Determine initial owner
 	ownerAddr := deployerAddr
@@ -947,19 +951,19 @@ func (s *ERC1155Service) DeployERC1155(ctx context.Context, req *pb.DeployERC115
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Deploy contract
 	contractAddr, tx, _, err := erc1155.DeployErc1155(auth, eth.GetClient(), ownerAddr, req.Uri)
 	if err != nil {
-		s.logger.Errorf("failed to deploy ERC1155 contract: uri=%s, error=%v", req.Uri, err)
+		s.logger.WithContext(ctx).Errorf("failed to deploy ERC1155 contract: uri=%s, error=%v", req.Uri, err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to deploy ERC1155 contract"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("contract deployed: uri=%s, contract=%s, deployer=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("contract deployed: uri=%s, contract=%s, deployer=%s, tx=%s",
 		req.Uri, contractAddr.Hex(), deployerAddr.Hex(), txHash.Hex())
 
 	return &pb.DeployERC1155Response{
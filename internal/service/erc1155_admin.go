@@ -0,0 +1,294 @@
+// Package service provides business logic services for ERC1155 contract administration.
+package service
+
+import (
+	"context"
+
+	pb "eth-contract-service/api/erc1155/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+)
+
+// PauseERC1155 pauses all token transfers of an ERC1155 contract.
+// It is signed by the contract owner, either with private_key or with the admin keystore.
+func (s *ERC1155Service) PauseERC1155(ctx context.Context, req *pb.PauseERC1155Request) (*pb.PauseERC1155Response, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC1155 contract instance
+	token, err := s.contractClient.GetERC1155Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare pause: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Pause contract
+	tx, err := token.Pause(auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to pause contract: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to pause contract"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("pause initiated: contract=%s, from=%s, tx=%s", contractAddr.Hex(), auth.From.Hex(), txHash.Hex())
+
+	return &pb.PauseERC1155Response{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		FromAddress:     auth.From.Hex(),
+	}, nil
+}
+
+// UnpauseERC1155 resumes token transfers of an ERC1155 contract.
+// It is signed by the contract owner, either with private_key or with the admin keystore.
+func (s *ERC1155Service) UnpauseERC1155(ctx context.Context, req *pb.UnpauseERC1155Request) (*pb.UnpauseERC1155Response, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC1155 contract instance
+	token, err := s.contractClient.GetERC1155Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare unpause: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Unpause contract
+	tx, err := token.Unpause(auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to unpause contract: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to unpause contract"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("unpause initiated: contract=%s, from=%s, tx=%s", contractAddr.Hex(), auth.From.Hex(), txHash.Hex())
+
+	return &pb.UnpauseERC1155Response{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		FromAddress:     auth.From.Hex(),
+	}, nil
+}
+
+// GetERC1155Paused returns whether token transfers of an ERC1155 contract are paused.
+func (s *ERC1155Service) GetERC1155Paused(ctx context.Context, req *pb.GetERC1155PausedRequest) (*pb.GetERC1155PausedResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC1155 contract instance
+	token, err := s.contractClient.GetERC1155Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	paused, err := token.Paused(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get paused: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get paused"))
+	}
+
+	return &pb.GetERC1155PausedResponse{
+		ContractAddress: req.ContractAddress,
+		Paused:          paused,
+	}, nil
+}
+
+// GetERC1155Owner returns the current owner of an ERC1155 contract.
+func (s *ERC1155Service) GetERC1155Owner(ctx context.Context, req *pb.GetERC1155OwnerRequest) (*pb.GetERC1155OwnerResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC1155 contract instance
+	token, err := s.contractClient.GetERC1155Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	owner, err := token.Owner(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get owner: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get owner"))
+	}
+
+	return &pb.GetERC1155OwnerResponse{
+		ContractAddress: req.ContractAddress,
+		OwnerAddress:    owner.Hex(),
+	}, nil
+}
+
+// TransferERC1155Ownership transfers ownership of an ERC1155 contract to a new owner.
+// It is signed by the current owner, either with private_key or with the admin keystore.
+func (s *ERC1155Service) TransferERC1155Ownership(ctx context.Context, req *pb.TransferERC1155OwnershipRequest) (*pb.TransferERC1155OwnershipResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate addresses
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	newOwner, err := validator.ValidateAddress(req.NewOwner, "new_owner")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC1155 contract instance
+	token, err := s.contractClient.GetERC1155Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare ownership transfer: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Transfer ownership
+	tx, err := token.TransferOwnership(auth, newOwner)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to transfer ownership: contract=%s, new_owner=%s, error=%v",
+			contractAddr.Hex(), newOwner.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer ownership"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("ownership transfer initiated: contract=%s, from=%s, new_owner=%s, tx=%s",
+		contractAddr.Hex(), auth.From.Hex(), newOwner.Hex(), txHash.Hex())
+
+	return &pb.TransferERC1155OwnershipResponse{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		PreviousOwner:   auth.From.Hex(),
+		NewOwner:        newOwner.Hex(),
+	}, nil
+}
+
+// RenounceERC1155Ownership leaves an ERC1155 contract without an owner.
+// Renouncing is irreversible, so the request must carry the confirmation token
+// "RENOUNCE <contract_address>".
+func (s *ERC1155Service) RenounceERC1155Ownership(ctx context.Context, req *pb.RenounceERC1155OwnershipRequest) (*pb.RenounceERC1155OwnershipResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate confirmation
+	if err := validateRenounceConfirmation(contractAddr, req.Confirmation); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC1155 contract instance
+	token, err := s.contractClient.GetERC1155Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare ownership renounce: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Renounce ownership
+	tx, err := token.RenounceOwnership(auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to renounce ownership: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to renounce ownership"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Warnf("ownership renounce initiated: contract=%s, from=%s, tx=%s",
+		contractAddr.Hex(), auth.From.Hex(), txHash.Hex())
+
+	return &pb.RenounceERC1155OwnershipResponse{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		PreviousOwner:   auth.From.Hex(),
+	}, nil
+}
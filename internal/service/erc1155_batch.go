@@ -0,0 +1,392 @@
+// Package service provides business logic services for ERC1155 airdrops.
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	pb "eth-contract-service/api/erc1155/v1"
+	"eth-contract-service/internal/batch"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/contract/erc1155"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// batchOpERC1155Airdrop is the batch operation name for ERC1155 airdrops
+const batchOpERC1155Airdrop = "erc1155_airdrop"
+
+// airdropRow is a validated airdrop item (one recipient and token ID) as stored in a batch item
+type airdropRow struct {
+	To      string `json:"to"`
+	TokenID string `json:"token_id"`
+	Amount  string `json:"amount"`
+	Data    []byte `json:"data,omitempty"`
+}
+
+// airdropSlot is a token ID position of a recipient; empty IDs are assigned from the sequence
+type airdropSlot struct {
+	tokenID string
+	amount  string
+	shared  int // index into the request token_ids, -1 for recipient-specific slots
+}
+
+// AirdropERC1155 mints ERC1155 tokens to many recipients using mintBatch.
+// Each recipient receives the request token_ids and amounts unless it lists its own.
+// Empty token IDs are assigned from the contract's token ID sequence. Items are grouped
+// per recipient and split into transactions that fit the configured gas budget.
+// Items that failed can be minted again by calling it with the returned batch_id.
+func (s *ERC1155Service) AirdropERC1155(ctx context.Context, req *pb.AirdropERC1155Request) (*pb.AirdropERC1155Response, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate private key
+	privateKey, err := validator.ValidatePrivateKey(req.PrivateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Get sender address from private key
+	fromAddr, err := s.contractClient.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Load the items to mint: either a previous batch or the validated input
+	var b *batch.Batch
+	var items []*batch.Item
+	var slots [][]airdropSlot
+	var recipients []common.Address
+	if req.BatchId != "" {
+		b, items, err = loadBatch(ctx, req.BatchId, batchOpERC1155Airdrop, contractAddr, fromAddr)
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+	} else {
+		recipients, slots, err = parseAirdrop(req)
+		if err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+	}
+
+	// Create ERC1155 contract instance
+	token, err := s.contractClient.GetERC1155Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC1155 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Only the contract owner can mint
+	owner, err := token.Owner(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get owner: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get owner"))
+	}
+	if owner != fromAddr {
+		return nil, errors.ToGRPCError(errors.FailedPrecondition("sender %s is not the contract owner %s", fromAddr.Hex(), owner.Hex()))
+	}
+
+	// Assign token IDs and persist a new batch only after all checks passed
+	if b == nil {
+		rows, err := airdropRows(ctx, contractAddr, recipients, slots, req.Data)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to assign token IDs: contract=%s, error=%v", contractAddr.Hex(), err)
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to assign token IDs"))
+		}
+		b, items, err = batch.Create(ctx, batchOpERC1155Airdrop, contractAddr, fromAddr, rows)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to create batch: contract=%s, from=%s, error=%v", contractAddr.Hex(), fromAddr.Hex(), err)
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to create batch"))
+		}
+	}
+
+	// Create transaction options
+	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	if err := s.mintAirdrop(ctx, auth, contractAddr, token, items, batch.NewBudget()); err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to save batch progress: batch=%s, error=%v", b.ID, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to save batch progress"))
+	}
+
+	resp := &pb.AirdropERC1155Response{
+		BatchId:         b.ID,
+		ContractAddress: req.ContractAddress,
+		FromAddress:     fromAddr.Hex(),
+		Results:         make([]*pb.AirdropResult, 0, len(items)),
+	}
+	for _, item := range items {
+		var row airdropRow
+		if err := item.Decode(&row); err != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to decode batch items"))
+		}
+		switch {
+		case item.Done():
+			resp.Succeeded++
+		case item.Status == batch.StatusPending:
+			resp.Pending++
+		default:
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, &pb.AirdropResult{
+			Index:     int32(item.Seq),
+			ToAddress: row.To,
+			TokenId:   row.TokenID,
+			Amount:    row.Amount,
+			Status:    string(item.Status),
+			TxHash:    item.TxHash,
+			Error:     item.Error,
+		})
+	}
+
+	s.logger.WithContext(ctx).Infof("airdrop initiated: contract=%s, from=%s, batch=%s, items=%d, succeeded=%d, failed=%d, pending=%d",
+		contractAddr.Hex(), fromAddr.Hex(), b.ID, len(items), resp.Succeeded, resp.Failed, resp.Pending)
+
+	return resp, nil
+}
+
+// mintAirdrop groups the items still to be sent by recipient and mints them in chunks
+func (s *ERC1155Service) mintAirdrop(ctx context.Context, auth *bind.TransactOpts, contractAddr common.Address, token *erc1155.Erc1155, items []*batch.Item, budget *batch.Budget) error {
+	var order []string
+	groups := make(map[string][]*batch.Item)
+	rows := make(map[*batch.Item]airdropRow)
+	for _, item := range items {
+		if item.Done() {
+			continue
+		}
+		var row airdropRow
+		if err := item.Decode(&row); err != nil {
+			return err
+		}
+		if _, ok := groups[row.To]; !ok {
+			order = append(order, row.To)
+		}
+		groups[row.To] = append(groups[row.To], item)
+		rows[item] = row
+	}
+	if len(order) == 0 {
+		return nil
+	}
+
+	// Transactions must stay below a share of the block gas limit
+	head, err := eth.GetClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return batch.Mark(ctx, pendingOf(groups), nil, fmt.Errorf("failed to get latest block: %w", err))
+	}
+	settings := batch.GetSettings()
+	gasBudget := uint64(float64(head.GasLimit) * settings.BlockGasRatio)
+
+	for _, to := range order {
+		group := groups[to]
+		for start := 0; start < len(group) && !budget.Exhausted(); start += settings.MaxItemsPerTx {
+			end := min(start+settings.MaxItemsPerTx, len(group))
+			if err := s.mintChunk(ctx, auth, contractAddr, token, group[start:end], rows, gasBudget, budget); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mintChunk sends one mintBatch transaction for items of the same recipient.
+// A chunk whose gas estimate exceeds the gas budget is split in half until it fits.
+// Chunks beyond the transaction budget of the request are left pending.
+func (s *ERC1155Service) mintChunk(ctx context.Context, auth *bind.TransactOpts, contractAddr common.Address, token *erc1155.Erc1155, items []*batch.Item, rows map[*batch.Item]airdropRow, gasBudget uint64, budget *batch.Budget) error {
+	if budget.Exhausted() {
+		return nil
+	}
+	first := rows[items[0]]
+	to := common.HexToAddress(first.To)
+	ids := make([]*big.Int, 0, len(items))
+	amounts := make([]*big.Int, 0, len(items))
+	for _, item := range items {
+		row := rows[item]
+		id, _ := new(big.Int).SetString(row.TokenID, 10)
+		amount, _ := new(big.Int).SetString(row.Amount, 10)
+		ids = append(ids, id)
+		amounts = append(amounts, amount)
+	}
+
+	parsed, err := erc1155.Erc1155MetaData.GetAbi()
+	if err != nil {
+		return batch.Mark(ctx, items, nil, err)
+	}
+	input, err := parsed.Pack("mintBatch", to, ids, amounts, first.Data)
+	if err != nil {
+		return batch.Mark(ctx, items, nil, err)
+	}
+	gas, err := eth.GetClient().EstimateGas(ctx, ethereum.CallMsg{From: auth.From, To: &contractAddr, Data: input})
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("airdrop chunk failed: batch=%s, to=%s, items=%d, error=%v", items[0].BatchID, first.To, len(items), err)
+		return batch.Mark(ctx, items, nil, fmt.Errorf("failed to estimate gas: %w", err))
+	}
+	if gas > gasBudget && len(items) > 1 {
+		half := len(items) / 2
+		if err := s.mintChunk(ctx, auth, contractAddr, token, items[:half], rows, gasBudget, budget); err != nil {
+			return err
+		}
+		return s.mintChunk(ctx, auth, contractAddr, token, items[half:], rows, gasBudget, budget)
+	}
+	budget.Take()
+
+	tx, err := sendWithNonce(ctx, auth, func() (*types.Transaction, error) {
+		return token.MintBatch(auth, to, ids, amounts, first.Data)
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("airdrop chunk failed: batch=%s, to=%s, items=%d, error=%v", items[0].BatchID, first.To, len(items), err)
+	}
+	return batch.Mark(ctx, items, tx, err)
+}
+
+// parseAirdrop validates the airdrop recipients and resolves the token ID slots of each recipient
+func parseAirdrop(req *pb.AirdropERC1155Request) ([]common.Address, [][]airdropSlot, error) {
+	if len(req.Recipients) == 0 {
+		return nil, nil, errors.InvalidArgument("recipients cannot be empty")
+	}
+
+	defaults, err := parseAirdropSlots(req.TokenIds, req.Amounts, "token_ids", "amounts", true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	total := 0
+	recipients := make([]common.Address, 0, len(req.Recipients))
+	slots := make([][]airdropSlot, 0, len(req.Recipients))
+	for i, r := range req.Recipients {
+		to, err := validator.ValidateAddress(r.ToAddress, fmt.Sprintf("recipients[%d].to_address", i))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		own := defaults
+		if len(r.TokenIds) > 0 || len(r.Amounts) > 0 {
+			own, err = parseAirdropSlots(r.TokenIds, r.Amounts,
+				fmt.Sprintf("recipients[%d].token_ids", i), fmt.Sprintf("recipients[%d].amounts", i), false)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if len(own) == 0 {
+			return nil, nil, errors.InvalidArgument("recipients[%d] has no token_ids", i)
+		}
+
+		total += len(own)
+		if total > maxBatchRows {
+			return nil, nil, errors.InvalidArgument("too many items: more than %d", maxBatchRows)
+		}
+		recipients = append(recipients, to)
+		slots = append(slots, own)
+	}
+	return recipients, slots, nil
+}
+
+// parseAirdropSlots validates a token ID list and its amounts
+func parseAirdropSlots(tokenIDs, amounts []string, idsField, amountsField string, shared bool) ([]airdropSlot, error) {
+	if len(tokenIDs) != len(amounts) {
+		return nil, errors.InvalidArgument("%s and %s length mismatch: %d != %d", idsField, amountsField, len(tokenIDs), len(amounts))
+	}
+
+	slots := make([]airdropSlot, 0, len(tokenIDs))
+	for i := range tokenIDs {
+		slot := airdropSlot{shared: -1}
+		if shared {
+			slot.shared = i
+		}
+		if tokenIDs[i] != "" {
+			id, err := validator.ValidateAmount(tokenIDs[i], fmt.Sprintf("%s[%d]", idsField, i))
+			if err != nil {
+				return nil, err
+			}
+			slot.tokenID = id.String()
+		}
+		amount, err := validator.ValidateAmount(amounts[i], fmt.Sprintf("%s[%d]", amountsField, i))
+		if err != nil {
+			return nil, err
+		}
+		if amount.Sign() == 0 {
+			return nil, errors.InvalidArgument("%s[%d] must be greater than zero", amountsField, i)
+		}
+		slot.amount = amount.String()
+		slots = append(slots, slot)
+	}
+	return slots, nil
+}
+
+// airdropRows assigns token IDs to empty slots and expands the recipients into batch rows.
+// An empty slot of the request token_ids gets one ID shared by all recipients using it;
+// an empty slot of a recipient's own token_ids gets an ID of its own.
+func airdropRows(ctx context.Context, contractAddr common.Address, recipients []common.Address, slots [][]airdropSlot, data []byte) ([]airdropRow, error) {
+	sharedMissing := make(map[int]bool)
+	missing := 0
+	for _, own := range slots {
+		for _, slot := range own {
+			if slot.tokenID != "" {
+				continue
+			}
+			if slot.shared < 0 {
+				missing++
+			} else if !sharedMissing[slot.shared] {
+				sharedMissing[slot.shared] = true
+				missing++
+			}
+		}
+	}
+
+	ids, err := batch.NextTokenIDs(ctx, contractAddr, missing)
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	sharedIDs := make(map[int]string)
+
+	var rows []airdropRow
+	for i, own := range slots {
+		for _, slot := range own {
+			tokenID := slot.tokenID
+			if tokenID == "" {
+				if slot.shared < 0 {
+					tokenID = ids[next].String()
+					next++
+				} else if id, ok := sharedIDs[slot.shared]; ok {
+					tokenID = id
+				} else {
+					tokenID = ids[next].String()
+					sharedIDs[slot.shared] = tokenID
+					next++
+				}
+			}
+			rows = append(rows, airdropRow{To: recipients[i].Hex(), TokenID: tokenID, Amount: slot.amount, Data: data})
+		}
+	}
+	return rows, nil
+}
+
+// pendingOf returns all items of the recipient groups
+func pendingOf(groups map[string][]*batch.Item) []*batch.Item {
+	var items []*batch.Item
+	for _, group := range groups {
+		items = append(items, group...)
+	}
+	return items
+}
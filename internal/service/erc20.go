@@ -62,38 +62,35 @@ func (s *ERC20Service) GetERC20Balance(ctx context.Context, req *pb.GetERC20Bala
 		return nil, errors.ToGRPCError(err)
 	}
 
-	// Get contract instance (supports both standard and ownable)
-	contractType := req.GetContractType()
-	if contractType == "" {
-		contractType = "standard"
-	}
-	token, err := s.getERC20Contract(contractAddr, contractType)
+	// Get contract instance (supports both standard and ownable, detected when omitted)
+	token, err := s.getERC20Contract(ctx, contractAddr, req.GetContractType())
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 contract: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 contract: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get balance
 	balance, err := token.BalanceOf(nil, ownerAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get balance: contract=%s, owner=%s, error=%v", contractAddr.Hex(), ownerAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get balance: contract=%s, owner=%s, error=%v", contractAddr.Hex(), ownerAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get balance"))
 	}
 
 	// Get decimals for display
-	decimals, err := token.Decimals(nil)
+	decimals, err := s.tokenDecimals(ctx, contractAddr)
 	if err != nil {
-		s.logger.Warnf("failed to get decimals, using 18 as default: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Warnf("failed to get decimals, using 18 as default: contract=%s, error=%v", contractAddr.Hex(), err)
 		decimals = 18
 	}
 
-	s.logger.Infof("balance queried: contract=%s, owner=%s, balance=%s", contractAddr.Hex(), ownerAddr.Hex(), balance.String())
+	s.logger.WithContext(ctx).Infof("balance queried: contract=%s, owner=%s, balance=%s", contractAddr.Hex(), ownerAddr.Hex(), balance.String())
 
 	return &pb.GetERC20BalanceResponse{
-		Balance:         balance.String(),
-		ContractAddress: req.ContractAddress,
-		OwnerAddress:    req.OwnerAddress,
-		Decimals:        uint32(decimals),
+		Balance:          balance.String(),
+		BalanceFormatted: validator.FormatTokenAmount(balance, decimals),
+		ContractAddress:  req.ContractAddress,
+		OwnerAddress:     req.OwnerAddress,
+		Decimals:         uint32(decimals),
 	}, nil
 }
 
@@ -114,50 +111,47 @@ func (s *ERC20Service) GetERC20Info(ctx context.Context, req *pb.GetERC20InfoReq
 		return nil, errors.ToGRPCError(err)
 	}
 
-	// Get contract instance (supports both standard and ownable)
-	contractType := req.GetContractType()
-	if contractType == "" {
-		contractType = "standard"
-	}
-	token, err := s.getERC20Contract(contractAddr, contractType)
+	// Get contract instance (supports both standard and ownable, detected when omitted)
+	token, err := s.getERC20Contract(ctx, contractAddr, req.GetContractType())
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 contract: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 contract: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get token info
 	name, err := token.Name(nil)
 	if err != nil {
-		s.logger.Errorf("failed to get token name: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token name: contract=%s, error=%v", contractAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get name"))
 	}
 
 	symbol, err := token.Symbol(nil)
 	if err != nil {
-		s.logger.Errorf("failed to get token symbol: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token symbol: contract=%s, error=%v", contractAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get symbol"))
 	}
 
 	decimals, err := token.Decimals(nil)
 	if err != nil {
-		s.logger.Errorf("failed to get token decimals: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token decimals: contract=%s, error=%v", contractAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get decimals"))
 	}
 
 	totalSupply, err := token.TotalSupply(nil)
 	if err != nil {
-		s.logger.Errorf("failed to get token total supply: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token total supply: contract=%s, error=%v", contractAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get total supply"))
 	}
 
-	s.logger.Infof("token info queried: contract=%s, name=%s, symbol=%s", contractAddr.Hex(), name, symbol)
+	s.logger.WithContext(ctx).Infof("token info queried: contract=%s, name=%s, symbol=%s", contractAddr.Hex(), name, symbol)
 
 	return &pb.GetERC20InfoResponse{
-		Name:            name,
-		Symbol:          symbol,
-		Decimals:        uint32(decimals),
-		TotalSupply:     totalSupply.String(),
-		ContractAddress: req.ContractAddress,
+		Name:                 name,
+		Symbol:               symbol,
+		Decimals:             uint32(decimals),
+		TotalSupply:          totalSupply.String(),
+		TotalSupplyFormatted: validator.FormatTokenAmount(totalSupply, decimals),
+		ContractAddress:      req.ContractAddress,
 	}, nil
 }
 
@@ -179,15 +173,15 @@ func (s *ERC20Service) TransferERC20(ctx context.Context, req *pb.TransferERC20R
 	}
 
 	// Validate amount
-	amount, err := validator.ValidateAmount(req.Amount, "amount")
+	amount, err := s.parseTokenAmount(ctx, contractAddr, req.Amount, req.Unit, "amount")
 	if err != nil {
-		return nil, errors.ToGRPCError(validator.ToAppError(err))
+		return nil, errors.ToGRPCError(err)
 	}
 
-	// Validate private key
-	privateKey, err := validator.ValidatePrivateKey(req.PrivateKey)
+	// Resolve signer from the private key or HD wallet account
+	signer, err := walletSigner(req.PrivateKey, false, req.Signer)
 	if err != nil {
-		return nil, errors.ToGRPCError(validator.ToAppError(err))
+		return nil, errors.ToGRPCError(err)
 	}
 
 	// Validate client
@@ -195,36 +189,32 @@ func (s *ERC20Service) TransferERC20(ctx context.Context, req *pb.TransferERC20R
 		return nil, errors.ToGRPCError(err)
 	}
 
-	// Get sender address from private key
-	fromAddr, err := s.contractClient.GetAddressFromPrivateKey(privateKey)
-	if err != nil {
-		return nil, errors.ToGRPCError(err)
-	}
+	fromAddr := signer.Address()
 
 	// Create ERC20Token contract instance
 	token, err := s.contractClient.GetERC20Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
-	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
+	auth, err := s.contractClient.CreateSignerTransactOpts(ctx, signer)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Transfer tokens
 	tx, err := token.Transfer(auth, toAddr, amount)
 	if err != nil {
-		s.logger.Errorf("failed to transfer tokens: contract=%s, from=%s, to=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to transfer tokens: contract=%s, from=%s, to=%s, amount=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer tokens"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("transfer initiated: contract=%s, from=%s, to=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("transfer initiated: contract=%s, from=%s, to=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), amount.String(), txHash.Hex())
 
 	return &pb.TransferERC20Response{
@@ -232,7 +222,8 @@ func (s *ERC20Service) TransferERC20(ctx context.Context, req *pb.TransferERC20R
 		ContractAddress: req.ContractAddress,
 		FromAddress:     fromAddr.Hex(),
 		ToAddress:       req.ToAddress,
-		Amount:          req.Amount,
+		Amount:          amount.String(),
+		AmountFormatted: s.formatTokenAmount(ctx, contractAddr, amount),
 	}, nil
 }
 
@@ -254,9 +245,9 @@ func (s *ERC20Service) ApproveERC20(ctx context.Context, req *pb.ApproveERC20Req
 	}
 
 	// Validate amount
-	amount, err := validator.ValidateAmount(req.Amount, "amount")
+	amount, err := s.parseTokenAmount(ctx, contractAddr, req.Amount, req.Unit, "amount")
 	if err != nil {
-		return nil, errors.ToGRPCError(validator.ToAppError(err))
+		return nil, errors.ToGRPCError(err)
 	}
 
 	// Validate private key
@@ -279,27 +270,27 @@ func (s *ERC20Service) ApproveERC20(ctx context.Context, req *pb.ApproveERC20Req
 	// Create ERC20Token contract instance
 	token, err := s.contractClient.GetERC20Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Approve tokens
 	tx, err := token.Approve(auth, spenderAddr, amount)
 	if err != nil {
-		s.logger.Errorf("failed to approve tokens: contract=%s, owner=%s, spender=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to approve tokens: contract=%s, owner=%s, spender=%s, amount=%s, error=%v",
 			contractAddr.Hex(), ownerAddr.Hex(), spenderAddr.Hex(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to approve tokens"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("approval initiated: contract=%s, owner=%s, spender=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("approval initiated: contract=%s, owner=%s, spender=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), ownerAddr.Hex(), spenderAddr.Hex(), amount.String(), txHash.Hex())
 
 	return &pb.ApproveERC20Response{
@@ -307,7 +298,8 @@ func (s *ERC20Service) ApproveERC20(ctx context.Context, req *pb.ApproveERC20Req
 		ContractAddress: req.ContractAddress,
 		OwnerAddress:    ownerAddr.Hex(),
 		SpenderAddress:  req.SpenderAddress,
-		Amount:          req.Amount,
+		Amount:          amount.String(),
+		AmountFormatted: s.formatTokenAmount(ctx, contractAddr, amount),
 	}, nil
 }
 
@@ -341,26 +333,27 @@ func (s *ERC20Service) GetERC20Allowance(ctx context.Context, req *pb.GetERC20Al
 	// Create ERC20Token contract instance
 	token, err := s.contractClient.GetERC20Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get allowance
 	allowance, err := token.Allowance(nil, ownerAddr, spenderAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get allowance: contract=%s, owner=%s, spender=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to get allowance: contract=%s, owner=%s, spender=%s, error=%v",
 			contractAddr.Hex(), ownerAddr.Hex(), spenderAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get allowance"))
 	}
 
-	s.logger.Infof("allowance queried: contract=%s, owner=%s, spender=%s, allowance=%s",
+	s.logger.WithContext(ctx).Infof("allowance queried: contract=%s, owner=%s, spender=%s, allowance=%s",
 		contractAddr.Hex(), ownerAddr.Hex(), spenderAddr.Hex(), allowance.String())
 
 	return &pb.GetERC20AllowanceResponse{
-		Allowance:       allowance.String(),
-		ContractAddress: req.ContractAddress,
-		OwnerAddress:    req.OwnerAddress,
-		SpenderAddress:  req.SpenderAddress,
+		Allowance:          allowance.String(),
+		AllowanceFormatted: s.formatTokenAmount(ctx, contractAddr, allowance),
+		ContractAddress:    req.ContractAddress,
+		OwnerAddress:       req.OwnerAddress,
+		SpenderAddress:     req.SpenderAddress,
 	}, nil
 }
 
@@ -387,9 +380,9 @@ func (s *ERC20Service) TransferFromERC20(ctx context.Context, req *pb.TransferFr
 	}
 
 	// Validate amount
-	amount, err := validator.ValidateAmount(req.Amount, "amount")
+	amount, err := s.parseTokenAmount(ctx, contractAddr, req.Amount, req.Unit, "amount")
 	if err != nil {
-		return nil, errors.ToGRPCError(validator.ToAppError(err))
+		return nil, errors.ToGRPCError(err)
 	}
 
 	// Validate private key
@@ -406,27 +399,27 @@ func (s *ERC20Service) TransferFromERC20(ctx context.Context, req *pb.TransferFr
 	// Create ERC20Token contract instance
 	token, err := s.contractClient.GetERC20Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Transfer from
 	tx, err := token.TransferFrom(auth, fromAddr, toAddr, amount)
 	if err != nil {
-		s.logger.Errorf("failed to transfer from: contract=%s, from=%s, to=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to transfer from: contract=%s, from=%s, to=%s, amount=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer from"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("transfer from initiated: contract=%s, from=%s, to=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("transfer from initiated: contract=%s, from=%s, to=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), amount.String(), txHash.Hex())
 
 	return &pb.TransferFromERC20Response{
@@ -434,7 +427,8 @@ func (s *ERC20Service) TransferFromERC20(ctx context.Context, req *pb.TransferFr
 		ContractAddress: req.ContractAddress,
 		FromAddress:     req.FromAddress,
 		ToAddress:       req.ToAddress,
-		Amount:          req.Amount,
+		Amount:          amount.String(),
+		AmountFormatted: s.formatTokenAmount(ctx, contractAddr, amount),
 	}, nil
 }
 
@@ -456,9 +450,9 @@ func (s *ERC20Service) MintERC20(ctx context.Context, req *pb.MintERC20Request)
 	}
 
 	// Validate amount
-	amount, err := validator.ValidateAmount(req.Amount, "amount")
+	amount, err := s.parseTokenAmount(ctx, contractAddr, req.Amount, req.Unit, "amount")
 	if err != nil {
-		return nil, errors.ToGRPCError(validator.ToAppError(err))
+		return nil, errors.ToGRPCError(err)
 	}
 
 	// Validate private key
@@ -475,34 +469,35 @@ func (s *ERC20Service) MintERC20(ctx context.Context, req *pb.MintERC20Request)
 	// Create ERC20Token contract instance
 	token, err := s.contractClient.GetERC20Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Mint tokens
 	tx, err := token.Mint(auth, toAddr, amount)
 	if err != nil {
-		s.logger.Errorf("failed to mint tokens: contract=%s, to=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to mint tokens: contract=%s, to=%s, amount=%s, error=%v",
 			contractAddr.Hex(), toAddr.Hex(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to mint tokens"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("mint initiated: contract=%s, to=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("mint initiated: contract=%s, to=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), toAddr.Hex(), amount.String(), txHash.Hex())
 
 	return &pb.MintERC20Response{
 		TxHash:          txHash.Hex(),
 		ContractAddress: req.ContractAddress,
 		ToAddress:       req.ToAddress,
-		Amount:          req.Amount,
+		Amount:          amount.String(),
+		AmountFormatted: s.formatTokenAmount(ctx, contractAddr, amount),
 	}, nil
 }
 
@@ -519,9 +514,9 @@ func (s *ERC20Service) BurnERC20(ctx context.Context, req *pb.BurnERC20Request)
 	}
 
 	// Validate amount
-	amount, err := validator.ValidateAmount(req.Amount, "amount")
+	amount, err := s.parseTokenAmount(ctx, contractAddr, req.Amount, req.Unit, "amount")
 	if err != nil {
-		return nil, errors.ToGRPCError(validator.ToAppError(err))
+		return nil, errors.ToGRPCError(err)
 	}
 
 	// Validate private key
@@ -544,34 +539,35 @@ func (s *ERC20Service) BurnERC20(ctx context.Context, req *pb.BurnERC20Request)
 	// Create ERC20Token contract instance
 	token, err := s.contractClient.GetERC20Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Burn tokens
 	tx, err := token.Burn(auth, amount)
 	if err != nil {
-		s.logger.Errorf("failed to burn tokens: contract=%s, from=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to burn tokens: contract=%s, from=%s, amount=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to burn tokens"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("burn initiated: contract=%s, from=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("burn initiated: contract=%s, from=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), amount.String(), txHash.Hex())
 
 	return &pb.BurnERC20Response{
 		TxHash:          txHash.Hex(),
 		ContractAddress: req.ContractAddress,
 		FromAddress:     fromAddr.Hex(),
-		Amount:          req.Amount,
+		Amount:          amount.String(),
+		AmountFormatted: s.formatTokenAmount(ctx, contractAddr, amount),
 	}, nil
 }
 
@@ -593,9 +589,9 @@ func (s *ERC20Service) BurnFromERC20(ctx context.Context, req *pb.BurnFromERC20R
 	}
 
 	// Validate amount
-	amount, err := validator.ValidateAmount(req.Amount, "amount")
+	amount, err := s.parseTokenAmount(ctx, contractAddr, req.Amount, req.Unit, "amount")
 	if err != nil {
-		return nil, errors.ToGRPCError(validator.ToAppError(err))
+		return nil, errors.ToGRPCError(err)
 	}
 
 	// Validate private key
@@ -612,34 +608,35 @@ func (s *ERC20Service) BurnFromERC20(ctx context.Context, req *pb.BurnFromERC20R
 	// Create ERC20Token contract instance
 	token, err := s.contractClient.GetERC20Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC20 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Burn from
 	tx, err := token.BurnFrom(auth, fromAddr, amount)
 	if err != nil {
-		s.logger.Errorf("failed to burn from: contract=%s, from=%s, amount=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to burn from: contract=%s, from=%s, amount=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), amount.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to burn from"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("burn from initiated: contract=%s, from=%s, amount=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("burn from initiated: contract=%s, from=%s, amount=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), amount.String(), txHash.Hex())
 
 	return &pb.BurnFromERC20Response{
 		TxHash:          txHash.Hex(),
 		ContractAddress: req.ContractAddress,
 		FromAddress:     req.FromAddress,
-		Amount:          req.Amount,
+		Amount:          amount.String(),
+		AmountFormatted: s.formatTokenAmount(ctx, contractAddr, amount),
 	}, nil
 }
 
@@ -666,7 +663,7 @@ func (s *ERC20Service) DeployERC20(ctx context.Context, req *pb.DeployERC20Reque
 	initialSupply := big.NewInt(0)
 	if req.InitialSupply != "" {
 		var err error
-		initialSupply, err = validator.ValidateAmount(req.InitialSupply, "initial_supply")
+		initialSupply, err = validator.ValidateTokenAmount(req.InitialSupply, req.Unit, uint8(req.Decimals), "initial_supply")
 		if err != nil {
 			return nil, errors.ToGRPCError(validator.ToAppError(err))
 		}
@@ -688,11 +685,13 @@ func (s *ERC20Service) DeployERC20(ctx context.Context, req *pb.DeployERC20Reque
 	if err != nil {
 		return nil, errors.ToGRPCError(err)
 	}
+	// An unsigned build deploys from the external signer
+	deployerAddr = contract.SenderFromContext(ctx, deployerAddr)
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
@@ -708,12 +707,12 @@ func (s *ERC20Service) DeployERC20(ctx context.Context, req *pb.DeployERC20Reque
 
 	// For ownable contracts, use admin address as owner if requested
 	if contractType == contract.ContractTypeOwnable && req.GetUseAdmin() {
-		adminAddr := keystore.GetAdminAddress()
-		if adminAddr == (common.Address{}) {
+		admin, err := keystore.GetSigner(keystore.SignerAdmin)
+		if err != nil {
 			return nil, errors.ToGRPCError(errors.InvalidArgument("admin keystore not initialized, cannot use admin address"))
 		}
-		ownerAddr = adminAddr
-		s.logger.Infof("using admin address as owner: %s", ownerAddr.Hex())
+		ownerAddr = admin.Address()
+		s.logger.WithContext(ctx).Infof("using admin address as owner: %s", ownerAddr.Hex())
 	}
 
 	// Deploy contract based on type
@@ -743,22 +742,23 @@ func (s *ERC20Service) DeployERC20(ctx context.Context, req *pb.DeployERC20Reque
 	}
 
 	if err != nil {
-		s.logger.Errorf("failed to deploy ERC20 contract: type=%s, name=%s, symbol=%s, decimals=%d, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to deploy ERC20 contract: type=%s, name=%s, symbol=%s, decimals=%d, error=%v",
 			contractType, req.Name, req.Symbol, req.Decimals, err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to deploy ERC20 contract"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("contract deployed: name=%s, symbol=%s, contract=%s, deployer=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("contract deployed: name=%s, symbol=%s, contract=%s, deployer=%s, tx=%s",
 		req.Name, req.Symbol, contractAddr.Hex(), deployerAddr.Hex(), txHash.Hex())
 
 	return &pb.DeployERC20Response{
-		TxHash:          txHash.Hex(),
-		ContractAddress: contractAddr.Hex(),
-		DeployerAddress: deployerAddr.Hex(),
-		Name:            req.Name,
-		Symbol:          req.Symbol,
-		Decimals:        req.Decimals,
-		InitialSupply:   req.InitialSupply,
+		TxHash:                 txHash.Hex(),
+		ContractAddress:        contractAddr.Hex(),
+		DeployerAddress:        deployerAddr.Hex(),
+		Name:                   req.Name,
+		Symbol:                 req.Symbol,
+		Decimals:               req.Decimals,
+		InitialSupply:          initialSupply.String(),
+		InitialSupplyFormatted: validator.FormatTokenAmount(initialSupply, uint8(req.Decimals)),
 	}, nil
 }
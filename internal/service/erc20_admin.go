@@ -0,0 +1,160 @@
+// Package service provides business logic services for ownable ERC20 contract administration.
+package service
+
+import (
+	"context"
+
+	pb "eth-contract-service/api/erc20/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+)
+
+// GetERC20Owner returns the current owner of an ownable ERC20 contract.
+func (s *ERC20Service) GetERC20Owner(ctx context.Context, req *pb.GetERC20OwnerRequest) (*pb.GetERC20OwnerResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ownable ERC20 contract instance
+	token, err := s.contractClient.GetERC20TokenOwnable(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ownable ERC20 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	owner, err := token.Owner(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get owner: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get owner"))
+	}
+
+	return &pb.GetERC20OwnerResponse{
+		ContractAddress: req.ContractAddress,
+		OwnerAddress:    owner.Hex(),
+	}, nil
+}
+
+// TransferERC20Ownership transfers ownership of an ownable ERC20 contract to a new owner.
+// It is signed by the current owner, either with private_key or with the admin keystore.
+func (s *ERC20Service) TransferERC20Ownership(ctx context.Context, req *pb.TransferERC20OwnershipRequest) (*pb.TransferERC20OwnershipResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate addresses
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	newOwner, err := validator.ValidateAddress(req.NewOwner, "new_owner")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ownable ERC20 contract instance
+	token, err := s.contractClient.GetERC20TokenOwnable(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ownable ERC20 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare ownership transfer: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Transfer ownership
+	tx, err := token.TransferOwnership(auth, newOwner)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to transfer ownership: contract=%s, new_owner=%s, error=%v",
+			contractAddr.Hex(), newOwner.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer ownership"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("ownership transfer initiated: contract=%s, from=%s, new_owner=%s, tx=%s",
+		contractAddr.Hex(), auth.From.Hex(), newOwner.Hex(), txHash.Hex())
+
+	return &pb.TransferERC20OwnershipResponse{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		PreviousOwner:   auth.From.Hex(),
+		NewOwner:        newOwner.Hex(),
+	}, nil
+}
+
+// RenounceERC20Ownership leaves an ownable ERC20 contract without an owner.
+// Renouncing is irreversible, so the request must carry the confirmation token
+// "RENOUNCE <contract_address>".
+func (s *ERC20Service) RenounceERC20Ownership(ctx context.Context, req *pb.RenounceERC20OwnershipRequest) (*pb.RenounceERC20OwnershipResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate confirmation
+	if err := validateRenounceConfirmation(contractAddr, req.Confirmation); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ownable ERC20 contract instance
+	token, err := s.contractClient.GetERC20TokenOwnable(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ownable ERC20 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare ownership renounce: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Renounce ownership
+	tx, err := token.RenounceOwnership(auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to renounce ownership: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to renounce ownership"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Warnf("ownership renounce initiated: contract=%s, from=%s, tx=%s",
+		contractAddr.Hex(), auth.From.Hex(), txHash.Hex())
+
+	return &pb.RenounceERC20OwnershipResponse{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		PreviousOwner:   auth.From.Hex(),
+	}, nil
+}
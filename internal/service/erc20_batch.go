@@ -0,0 +1,418 @@
+// Package service provides business logic services for bulk ERC20 transfers.
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	pb "eth-contract-service/api/erc20/v1"
+	"eth-contract-service/internal/batch"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/contract/disperse"
+	"eth-contract-service/provider/contract/erc20"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// batchOpERC20Transfer is the batch operation name for bulk ERC20 transfers
+	batchOpERC20Transfer = "erc20_transfer"
+	// maxBatchRows is the maximum number of rows accepted by a bulk request
+	maxBatchRows = 5000
+	// disperseChunkSize is the number of rows sent per disperse contract call
+	disperseChunkSize = 200
+
+	// batchMethodSequential sends one transaction per row with sequential nonces
+	batchMethodSequential = "sequential"
+	// batchMethodDisperse sends rows in chunks through the configured disperse contract
+	batchMethodDisperse = "disperse"
+)
+
+// transferRow is a validated bulk transfer row as stored in a batch item
+type transferRow struct {
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+}
+
+// BatchTransferERC20 transfers ERC20 tokens to many recipients and reports the result per row.
+// Rows that failed can be sent again by calling it with the returned batch_id.
+func (s *ERC20Service) BatchTransferERC20(ctx context.Context, req *pb.BatchTransferERC20Request) (*pb.BatchTransferERC20Response, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate private key
+	privateKey, err := validator.ValidatePrivateKey(req.PrivateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Get sender address from private key
+	fromAddr, err := s.contractClient.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Load the rows to send: either a previous batch or the validated input
+	var b *batch.Batch
+	var items []*batch.Item
+	var rows []transferRow
+	if req.BatchId != "" {
+		b, items, err = loadBatch(ctx, req.BatchId, batchOpERC20Transfer, contractAddr, fromAddr)
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+	} else {
+		rows, err = parseTransferRows(req.Rows, req.Csv, func(amount, fieldName string) (*big.Int, error) {
+			return s.parseTokenAmount(ctx, contractAddr, amount, req.Unit, fieldName)
+		})
+		if err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+	}
+
+	pending, amounts, pendingTotal, err := pendingTransfers(items, rows)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to decode batch items"))
+	}
+
+	// Create ERC20Token contract instance
+	token, err := s.contractClient.GetERC20Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Check the sender can cover all rows that still have to be sent
+	callOpts := eth.NewCallOpts(ctx, nil)
+	balance, err := token.BalanceOf(callOpts, fromAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get balance: contract=%s, owner=%s, error=%v", contractAddr.Hex(), fromAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get balance"))
+	}
+	if balance.Cmp(pendingTotal) < 0 {
+		return nil, errors.ToGRPCError(errors.FailedPrecondition("insufficient balance: balance=%s, required=%s",
+			balance.String(), pendingTotal.String()))
+	}
+
+	method := batchMethodSequential
+	disperseAddr := eth.GetContractAddress("disperse")
+	if disperseAddr != (common.Address{}) {
+		method = batchMethodDisperse
+		allowance, err := token.Allowance(callOpts, fromAddr, disperseAddr)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to get allowance: contract=%s, owner=%s, spender=%s, error=%v",
+				contractAddr.Hex(), fromAddr.Hex(), disperseAddr.Hex(), err)
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get allowance"))
+		}
+		if allowance.Cmp(pendingTotal) < 0 {
+			return nil, errors.ToGRPCError(errors.FailedPrecondition("insufficient allowance for disperse contract %s: allowance=%s, required=%s",
+				disperseAddr.Hex(), allowance.String(), pendingTotal.String()))
+		}
+	}
+
+	// Persist a new batch only after all checks passed
+	if b == nil {
+		b, items, err = batch.Create(ctx, batchOpERC20Transfer, contractAddr, fromAddr, rows)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to create batch: contract=%s, from=%s, error=%v", contractAddr.Hex(), fromAddr.Hex(), err)
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to create batch"))
+		}
+		pending = items
+	}
+
+	// Create transaction options
+	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	budget := batch.NewBudget()
+	if method == batchMethodDisperse {
+		err = s.disperseTransfers(ctx, auth, contractAddr, disperseAddr, pending, amounts, budget)
+	} else {
+		err = s.sendTransfers(ctx, auth, token, pending, amounts, budget)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to save batch progress: batch=%s, error=%v", b.ID, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to save batch progress"))
+	}
+
+	resp := &pb.BatchTransferERC20Response{
+		BatchId:         b.ID,
+		ContractAddress: req.ContractAddress,
+		FromAddress:     fromAddr.Hex(),
+		Method:          method,
+		Results:         make([]*pb.BatchTransferResult, 0, len(items)),
+	}
+	decimals, decimalsErr := s.tokenDecimals(ctx, contractAddr)
+	if decimalsErr != nil {
+		s.logger.WithContext(ctx).Warnf("failed to get decimals, amounts not formatted: contract=%s, error=%v", contractAddr.Hex(), decimalsErr)
+	}
+	format := func(amount *big.Int) string {
+		if decimalsErr != nil {
+			return ""
+		}
+		return validator.FormatTokenAmount(amount, decimals)
+	}
+	total := new(big.Int)
+	for _, item := range items {
+		var row transferRow
+		if err := item.Decode(&row); err != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to decode batch items"))
+		}
+		amount, ok := new(big.Int).SetString(row.Amount, 10)
+		if ok {
+			total.Add(total, amount)
+		}
+		switch {
+		case item.Done():
+			resp.Succeeded++
+		case item.Status == batch.StatusPending:
+			resp.Pending++
+		default:
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, &pb.BatchTransferResult{
+			Index:           int32(item.Seq),
+			ToAddress:       row.To,
+			Amount:          row.Amount,
+			AmountFormatted: format(amount),
+			Status:          string(item.Status),
+			TxHash:          item.TxHash,
+			Error:           item.Error,
+		})
+	}
+	resp.TotalAmount = total.String()
+	resp.TotalAmountFormatted = format(total)
+
+	s.logger.WithContext(ctx).Infof("batch transfer initiated: contract=%s, from=%s, batch=%s, rows=%d, method=%s, succeeded=%d, failed=%d, pending=%d",
+		contractAddr.Hex(), fromAddr.Hex(), b.ID, len(items), method, resp.Succeeded, resp.Failed, resp.Pending)
+
+	return resp, nil
+}
+
+// sendTransfers sends one transfer per item with sequentially assigned nonces
+func (s *ERC20Service) sendTransfers(ctx context.Context, auth *bind.TransactOpts, token *erc20.ERC20Token, items []*batch.Item, amounts []*big.Int, budget *batch.Budget) error {
+	for i, item := range items {
+		if !budget.Take() {
+			return nil
+		}
+		var row transferRow
+		_ = item.Decode(&row)
+
+		tx, err := sendWithNonce(ctx, auth, func() (*types.Transaction, error) {
+			return token.Transfer(auth, common.HexToAddress(row.To), amounts[i])
+		})
+		if err != nil {
+			s.logger.WithContext(ctx).Warnf("batch row failed: batch=%s, index=%d, to=%s, error=%v", item.BatchID, item.Seq, row.To, err)
+		}
+		if err := batch.Mark(ctx, []*batch.Item{item}, tx, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// disperseTransfers sends the items in chunks through the disperse contract
+func (s *ERC20Service) disperseTransfers(ctx context.Context, auth *bind.TransactOpts, contractAddr, disperseAddr common.Address, items []*batch.Item, amounts []*big.Int, budget *batch.Budget) error {
+	contract, err := disperse.NewDisperse(disperseAddr, eth.GetClient())
+	if err != nil {
+		return batch.Mark(ctx, items, nil, err)
+	}
+
+	for start := 0; start < len(items); start += disperseChunkSize {
+		if !budget.Take() {
+			return nil
+		}
+		end := min(start+disperseChunkSize, len(items))
+		chunk := items[start:end]
+
+		recipients := make([]common.Address, 0, len(chunk))
+		for _, item := range chunk {
+			var row transferRow
+			_ = item.Decode(&row)
+			recipients = append(recipients, common.HexToAddress(row.To))
+		}
+
+		tx, err := sendWithNonce(ctx, auth, func() (*types.Transaction, error) {
+			return contract.DisperseTokenSimple(auth, contractAddr, recipients, amounts[start:end])
+		})
+		if err != nil {
+			s.logger.WithContext(ctx).Warnf("batch chunk failed: batch=%s, rows=%d-%d, error=%v", chunk[0].BatchID, chunk[0].Seq, chunk[len(chunk)-1].Seq, err)
+		}
+		if err := batch.Mark(ctx, chunk, tx, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadBatch loads a batch to resume and checks that it belongs to the same operation, contract and sender
+func loadBatch(ctx context.Context, id, operation string, contractAddr, fromAddr common.Address) (*batch.Batch, []*batch.Item, error) {
+	b, items, err := batch.Get(ctx, id)
+	if err == batch.ErrNotFound {
+		return nil, nil, errors.ErrBatchNotFound
+	}
+	if err != nil {
+		return nil, nil, errors.WrapError(err, errors.CodeInternal, "failed to get batch")
+	}
+	if b.Operation != operation || b.ContractAddress != contractAddr.Hex() || b.FromAddress != fromAddr.Hex() {
+		return nil, nil, errors.InvalidArgument("batch %s belongs to a different operation, contract or sender", id)
+	}
+
+	// Pick up receipts so that reverted rows are sent again
+	if err := batch.Refresh(ctx, items); err != nil {
+		return nil, nil, errors.WrapError(err, errors.CodeInternal, "failed to refresh batch")
+	}
+	return b, items, nil
+}
+
+// pendingTransfers returns the items still to be sent, their amounts and the amount total.
+// For a new batch the rows are used, since the items are only created after all checks.
+func pendingTransfers(items []*batch.Item, rows []transferRow) ([]*batch.Item, []*big.Int, *big.Int, error) {
+	total := new(big.Int)
+	var pending []*batch.Item
+	var amounts []*big.Int
+
+	add := func(row transferRow) error {
+		amount, ok := new(big.Int).SetString(row.Amount, 10)
+		if !ok {
+			return fmt.Errorf("invalid stored amount: %s", row.Amount)
+		}
+		amounts = append(amounts, amount)
+		total.Add(total, amount)
+		return nil
+	}
+
+	for _, row := range rows {
+		if err := add(row); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	for _, item := range items {
+		if item.Done() {
+			continue
+		}
+		var row transferRow
+		if err := item.Decode(&row); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := add(row); err != nil {
+			return nil, nil, nil, err
+		}
+		pending = append(pending, item)
+	}
+	return pending, amounts, total, nil
+}
+
+// parseTransferRows validates inline rows or CSV content into transfer rows.
+// parseAmount converts a row amount to base units.
+func parseTransferRows(rows []*pb.BatchTransferRow, content string, parseAmount func(amount, fieldName string) (*big.Int, error)) ([]transferRow, error) {
+	if len(rows) > 0 && content != "" {
+		return nil, errors.InvalidArgument("provide either rows or csv, not both")
+	}
+
+	var raw [][2]string
+	if content != "" {
+		records, err := readCSV(content, 2)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range records {
+			raw = append(raw, [2]string{rec[0], rec[1]})
+		}
+	} else {
+		for _, row := range rows {
+			raw = append(raw, [2]string{row.ToAddress, row.Amount})
+		}
+	}
+
+	if len(raw) == 0 {
+		return nil, errors.InvalidArgument("rows cannot be empty")
+	}
+	if len(raw) > maxBatchRows {
+		return nil, errors.InvalidArgument("too many rows: %d (max %d)", len(raw), maxBatchRows)
+	}
+
+	result := make([]transferRow, 0, len(raw))
+	for i, r := range raw {
+		to, err := validator.ValidateAddress(r[0], fmt.Sprintf("rows[%d].to_address", i))
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseAmount(r[1], fmt.Sprintf("rows[%d].amount", i))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, transferRow{To: to.Hex(), Amount: amount.String()})
+	}
+	return result, nil
+}
+
+// readCSV parses CSV content with at least the given number of columns.
+// A leading header row (first cell not an address) is skipped.
+func readCSV(content string, columns int) ([][]string, error) {
+	r := csv.NewReader(strings.NewReader(content))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	var records [][]string
+	for line := 1; ; line++ {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.InvalidArgument("invalid csv: %v", err)
+		}
+		if line == 1 && len(rec) > 0 && !common.IsHexAddress(strings.TrimSpace(rec[0])) {
+			continue
+		}
+		if len(rec) < columns {
+			return nil, errors.InvalidArgument("invalid csv: line %d has %d columns, expected %d", line, len(rec), columns)
+		}
+		for i := range rec {
+			rec[i] = strings.TrimSpace(rec[i])
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// sendWithNonce assigns the next nonce of the sender to auth before calling send.
+// The nonce is given back if the transaction could not be sent.
+func sendWithNonce(ctx context.Context, auth *bind.TransactOpts, send func() (*types.Transaction, error)) (*types.Transaction, error) {
+	nonce, err := eth.NextNonce(ctx, auth.From)
+	if err != nil {
+		return nil, err
+	}
+	auth.Nonce = new(big.Int).SetUint64(nonce)
+
+	tx, err := send()
+	if err != nil {
+		eth.ReturnNonce(auth.From, nonce, err)
+		return nil, err
+	}
+	return tx, nil
+}
@@ -0,0 +1,319 @@
+// Package service provides business logic services for EIP-2612 permits.
+package service
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	pb "eth-contract-service/api/erc20/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/permit"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// defaultPermitValidity is the permit lifetime when no deadline is given
+	defaultPermitValidity = time.Hour
+	// transferFromGasLimit is used for the transferFrom following a permit.
+	// Its gas cannot be estimated before the permit is mined because the allowance is not set yet.
+	transferFromGasLimit = 120000
+)
+
+// SignERC20Permit builds the EIP-712 Permit typed data for the owner key and signs it.
+// Only a caller-supplied private key is accepted, never a server-held signer.
+// No transaction is sent; the signature can be relayed with SubmitERC20Permit by anyone.
+func (s *ERC20Service) SignERC20Permit(ctx context.Context, req *pb.SignERC20PermitRequest) (*pb.SignERC20PermitResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate addresses
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	spender, err := validator.ValidateAddress(req.Spender, "spender")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate value
+	value, err := s.parseTokenAmount(ctx, contractAddr, req.Value, req.Unit, "value")
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Validate private key; a permit lets the spender move all approved tokens, so keys held
+	// by the server, such as HD deposit accounts, never sign one
+	if strings.HasPrefix(req.PrivateKey, keystore.SignerHDPrefix) {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("permits must be signed with the owner private key, server-held signers are not accepted"))
+	}
+	privateKey, err := validator.ValidatePrivateKey(req.PrivateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	key, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInvalidArgument, errors.ErrInvalidPrivateKey.Message))
+	}
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+
+	// Validate deadline
+	deadline := req.Deadline
+	if deadline == 0 {
+		deadline = uint64(time.Now().Add(defaultPermitValidity).Unix())
+	}
+	if deadline <= uint64(time.Now().Unix()) {
+		return nil, errors.ToGRPCError(errors.ErrPermitExpired)
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create permit contract instance
+	token, err := s.contractClient.GetERC20Permit(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 permit contract: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	domain, err := permit.Domain(ctx, token, contractAddr, req.Version)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to resolve permit domain: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	nonce, err := token.Nonces(eth.NewCallOpts(ctx, nil), owner)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get permit nonce: contract=%s, owner=%s, error=%v", contractAddr.Hex(), owner.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get permit nonce"))
+	}
+
+	msg := &permit.Permit{
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Nonce:    nonce,
+		Deadline: new(big.Int).SetUint64(deadline),
+	}
+	digest, err := permit.Hash(domain, msg)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to hash permit"))
+	}
+	sig, err := permit.Sign(key, domain, msg)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to sign permit"))
+	}
+	v, r, sv := permit.Split(sig)
+
+	s.logger.WithContext(ctx).Infof("permit signed: contract=%s, owner=%s, spender=%s, value=%s, nonce=%s, deadline=%d",
+		contractAddr.Hex(), owner.Hex(), spender.Hex(), value.String(), nonce.String(), deadline)
+
+	return &pb.SignERC20PermitResponse{
+		ContractAddress: req.ContractAddress,
+		Owner:           owner.Hex(),
+		Spender:         spender.Hex(),
+		Value:           value.String(),
+		ValueFormatted:  s.formatTokenAmount(ctx, contractAddr, value),
+		Nonce:           nonce.String(),
+		Deadline:        deadline,
+		Signature:       hexutil.Encode(sig),
+		V:               uint32(v),
+		R:               hexutil.Encode(r[:]),
+		S:               hexutil.Encode(sv[:]),
+		Digest:          digest.Hex(),
+	}, nil
+}
+
+// SubmitERC20Permit relays a permit signature from a relayer key that pays the gas.
+// When transfer_to is set, the relayer must be the spender and transferFrom is sent right
+// after the permit with the next nonce of the relayer. A transferFrom that cannot be sent
+// is reported in transfer_error together with the permit transaction hash.
+func (s *ERC20Service) SubmitERC20Permit(ctx context.Context, req *pb.SubmitERC20PermitRequest) (*pb.SubmitERC20PermitResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate addresses
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	owner, err := validator.ValidateAddress(req.Owner, "owner")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	spender, err := validator.ValidateAddress(req.Spender, "spender")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate value and deadline
+	value, err := s.parseTokenAmount(ctx, contractAddr, req.Value, req.Unit, "value")
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	if req.Deadline <= uint64(time.Now().Unix()) {
+		return nil, errors.ToGRPCError(errors.ErrPermitExpired)
+	}
+
+	// Validate signature
+	sig, err := permitSignature(req)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Validate optional transfer
+	var transferTo common.Address
+	transferAmount := value
+	if req.TransferTo != "" {
+		transferTo, err = validator.ValidateAddress(req.TransferTo, "transfer_to")
+		if err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+		if req.TransferAmount != "" {
+			transferAmount, err = s.parseTokenAmount(ctx, contractAddr, req.TransferAmount, req.Unit, "transfer_amount")
+			if err != nil {
+				return nil, errors.ToGRPCError(err)
+			}
+			if transferAmount.Cmp(value) > 0 {
+				return nil, errors.ToGRPCError(errors.InvalidArgument("transfer_amount exceeds the permitted value"))
+			}
+		}
+	}
+
+	// Resolve relayer signer
+	relayerSigner, err := adminSigner(req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	relayer := relayerSigner.Address()
+	if req.TransferTo != "" && relayer != spender {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("relayer %s must be the spender %s to transfer", relayer.Hex(), spender.Hex()))
+	}
+
+	// Create permit contract instance
+	token, err := s.contractClient.GetERC20Permit(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC20 permit contract: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Verify the signature off-chain so that a bad permit does not cost the relayer gas
+	domain, err := permit.Domain(ctx, token, contractAddr, req.Version)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to resolve permit domain: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+	nonce, err := token.Nonces(eth.NewCallOpts(ctx, nil), owner)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get permit nonce: contract=%s, owner=%s, error=%v", contractAddr.Hex(), owner.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get permit nonce"))
+	}
+	msg := &permit.Permit{
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Nonce:    nonce,
+		Deadline: new(big.Int).SetUint64(req.Deadline),
+	}
+	if err := permit.Verify(domain, msg, sig); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create transaction options
+	auth, err := s.contractClient.CreateSignerTransactOpts(ctx, relayerSigner)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Submit permit
+	v, r, sv := permit.Split(sig)
+	permitTx, err := sendWithNonce(ctx, auth, func() (*types.Transaction, error) {
+		return token.Permit(auth, owner, spender, value, msg.Deadline, v, r, sv)
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to submit permit: contract=%s, owner=%s, spender=%s, error=%v",
+			contractAddr.Hex(), owner.Hex(), spender.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to submit permit"))
+	}
+
+	s.logger.WithContext(ctx).Infof("permit initiated: contract=%s, owner=%s, spender=%s, value=%s, relayer=%s, tx=%s",
+		contractAddr.Hex(), owner.Hex(), spender.Hex(), value.String(), relayer.Hex(), permitTx.Hash().Hex())
+
+	resp := &pb.SubmitERC20PermitResponse{
+		PermitTxHash:    permitTx.Hash().Hex(),
+		ContractAddress: req.ContractAddress,
+		RelayerAddress:  relayer.Hex(),
+		Owner:           owner.Hex(),
+		Spender:         spender.Hex(),
+		Value:           value.String(),
+		ValueFormatted:  s.formatTokenAmount(ctx, contractAddr, value),
+	}
+	if req.TransferTo == "" {
+		return resp, nil
+	}
+
+	// Transfer the permitted tokens with the next relayer nonce
+	auth.GasLimit = transferFromGasLimit
+	transferTx, err := sendWithNonce(ctx, auth, func() (*types.Transaction, error) {
+		return token.TransferFrom(auth, owner, transferTo, transferAmount)
+	})
+	if err != nil {
+		// The permit is already broadcast, so its hash is returned with the transfer error
+		s.logger.WithContext(ctx).Errorf("failed to transfer after permit: contract=%s, from=%s, to=%s, amount=%s, permit_tx=%s, error=%v",
+			contractAddr.Hex(), owner.Hex(), transferTo.Hex(), transferAmount.String(), resp.PermitTxHash, err)
+		resp.TransferError = errors.WrapError(err, errors.CodeInternal, "permit submitted but transfer failed").Error()
+		return resp, nil
+	}
+	resp.TransferTxHash = transferTx.Hash().Hex()
+
+	s.logger.WithContext(ctx).Infof("transfer from initiated: contract=%s, from=%s, to=%s, amount=%s, spender=%s, tx=%s",
+		contractAddr.Hex(), owner.Hex(), transferTo.Hex(), transferAmount.String(), relayer.Hex(), transferTx.Hash().Hex())
+
+	return resp, nil
+}
+
+// permitSignature returns the permit signature from either the signature field or v, r and s
+func permitSignature(req *pb.SubmitERC20PermitRequest) ([]byte, error) {
+	if req.Signature != "" {
+		sig, err := keystore.ParseSignature(req.Signature)
+		if err != nil {
+			return nil, errors.ErrPermitInvalidSignature
+		}
+		return sig, nil
+	}
+	r, err := hexutil.Decode(req.R)
+	if err != nil {
+		return nil, errors.ErrPermitInvalidSignature
+	}
+	sv, err := hexutil.Decode(req.S)
+	if err != nil {
+		return nil, errors.ErrPermitInvalidSignature
+	}
+	if req.V > 255 {
+		return nil, errors.ErrPermitInvalidSignature
+	}
+	return permit.Join(uint8(req.V), r, sv)
+}
@@ -0,0 +1,76 @@
+// Package service provides business logic services for ERC20 amounts in whole tokens.
+package service
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// decimalsCache stores the decimals of ERC20 tokens per contract address.
+	// Decimals are fixed at deployment, so entries never expire.
+	decimalsCache   = make(map[common.Address]uint8)
+	decimalsCacheMu sync.Mutex
+)
+
+// tokenDecimals returns the decimals of an ERC20 token, read from the contract once
+func (s *ERC20Service) tokenDecimals(ctx context.Context, contractAddr common.Address) (uint8, error) {
+	decimalsCacheMu.Lock()
+	decimals, ok := decimalsCache[contractAddr]
+	decimalsCacheMu.Unlock()
+	if ok {
+		return decimals, nil
+	}
+
+	token, err := s.contractClient.GetERC20Token(contractAddr)
+	if err != nil {
+		return 0, err
+	}
+	decimals, err = token.Decimals(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		return 0, errors.WrapError(err, errors.CodeFailedPrecondition, "failed to get token decimals")
+	}
+
+	decimalsCacheMu.Lock()
+	decimalsCache[contractAddr] = decimals
+	decimalsCacheMu.Unlock()
+	return decimals, nil
+}
+
+// parseTokenAmount converts an amount in base units or whole tokens to base units.
+// The token decimals are only read for amounts in whole tokens.
+func (s *ERC20Service) parseTokenAmount(ctx context.Context, contractAddr common.Address, amount, unit, fieldName string) (*big.Int, error) {
+	if err := validator.ValidateUnit(unit); err != nil {
+		return nil, validator.ToAppError(err)
+	}
+	var decimals uint8
+	if validator.IsWholeAmount(amount, unit) {
+		var err error
+		if decimals, err = s.tokenDecimals(ctx, contractAddr); err != nil {
+			return nil, err
+		}
+	}
+	v, err := validator.ValidateTokenAmount(amount, unit, decimals, fieldName)
+	if err != nil {
+		return nil, validator.ToAppError(err)
+	}
+	return v, nil
+}
+
+// formatTokenAmount formats an amount in base units as whole tokens for a response.
+// It returns an empty string when the token decimals cannot be read.
+func (s *ERC20Service) formatTokenAmount(ctx context.Context, contractAddr common.Address, amount *big.Int) string {
+	decimals, err := s.tokenDecimals(ctx, contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to get decimals, amount not formatted: contract=%s, error=%v", contractAddr.Hex(), err)
+		return ""
+	}
+	return validator.FormatTokenAmount(amount, decimals)
+}
@@ -59,18 +59,18 @@ func (s *ERC721Service) GetERC721Balance(ctx context.Context, req *pb.GetERC721B
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get balance
 	balance, err := token.BalanceOf(nil, ownerAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get balance: contract=%s, owner=%s, error=%v", contractAddr.Hex(), ownerAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get balance: contract=%s, owner=%s, error=%v", contractAddr.Hex(), ownerAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get balance"))
 	}
 
-	s.logger.Infof("balance queried: contract=%s, owner=%s, balance=%s", contractAddr.Hex(), ownerAddr.Hex(), balance.String())
+	s.logger.WithContext(ctx).Infof("balance queried: contract=%s, owner=%s, balance=%s", contractAddr.Hex(), ownerAddr.Hex(), balance.String())
 
 	return &pb.GetERC721BalanceResponse{
 		Balance:         balance.String(),
@@ -99,24 +99,24 @@ func (s *ERC721Service) GetERC721TokenInfo(ctx context.Context, req *pb.GetERC72
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get token info
 	name, err := token.Name(nil)
 	if err != nil {
-		s.logger.Errorf("failed to get token name: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token name: contract=%s, error=%v", contractAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get name"))
 	}
 
 	symbol, err := token.Symbol(nil)
 	if err != nil {
-		s.logger.Errorf("failed to get token symbol: contract=%s, error=%v", contractAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token symbol: contract=%s, error=%v", contractAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get symbol"))
 	}
 
-	s.logger.Infof("token info queried: contract=%s, name=%s, symbol=%s", contractAddr.Hex(), name, symbol)
+	s.logger.WithContext(ctx).Infof("token info queried: contract=%s, name=%s, symbol=%s", contractAddr.Hex(), name, symbol)
 
 	return &pb.GetERC721TokenInfoResponse{
 		Name:            name,
@@ -151,18 +151,18 @@ func (s *ERC721Service) GetERC721TokenURI(ctx context.Context, req *pb.GetERC721
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get token URI
 	tokenURI, err := token.TokenURI(nil, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to get token URI: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get token URI: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get token URI"))
 	}
 
-	s.logger.Infof("token URI queried: contract=%s, token_id=%s, uri=%s", contractAddr.Hex(), tokenID.String(), tokenURI)
+	s.logger.WithContext(ctx).Infof("token URI queried: contract=%s, token_id=%s, uri=%s", contractAddr.Hex(), tokenID.String(), tokenURI)
 
 	return &pb.GetERC721TokenURIResponse{
 		TokenUri:        tokenURI,
@@ -197,18 +197,18 @@ func (s *ERC721Service) GetERC721OwnerOf(ctx context.Context, req *pb.GetERC721O
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get owner
 	owner, err := token.OwnerOf(nil, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to get owner: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get owner: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get owner"))
 	}
 
-	s.logger.Infof("owner queried: contract=%s, token_id=%s, owner=%s", contractAddr.Hex(), tokenID.String(), owner.Hex())
+	s.logger.WithContext(ctx).Infof("owner queried: contract=%s, token_id=%s, owner=%s", contractAddr.Hex(), tokenID.String(), owner.Hex())
 
 	return &pb.GetERC721OwnerOfResponse{
 		OwnerAddress:    owner.Hex(),
@@ -243,18 +243,18 @@ func (s *ERC721Service) GetERC721Approved(ctx context.Context, req *pb.GetERC721
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Get approved address
 	approved, err := token.GetApproved(nil, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to get approved: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
+		s.logger.WithContext(ctx).Errorf("failed to get approved: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get approved"))
 	}
 
-	s.logger.Infof("approved queried: contract=%s, token_id=%s, approved=%s", contractAddr.Hex(), tokenID.String(), approved.Hex())
+	s.logger.WithContext(ctx).Infof("approved queried: contract=%s, token_id=%s, approved=%s", contractAddr.Hex(), tokenID.String(), approved.Hex())
 
 	return &pb.GetERC721ApprovedResponse{
 		ApprovedAddress: approved.Hex(),
@@ -293,18 +293,18 @@ func (s *ERC721Service) IsApprovedForAllERC721(ctx context.Context, req *pb.IsAp
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Check approval
 	approved, err := token.IsApprovedForAll(nil, ownerAddr, operatorAddr)
 	if err != nil {
-		s.logger.Errorf("failed to check approval: contract=%s, owner=%s, operator=%s, error=%v", contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), err)
+		s.logger.WithContext(ctx).Errorf("failed to check approval: contract=%s, owner=%s, operator=%s, error=%v", contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to check approval"))
 	}
 
-	s.logger.Infof("approval checked: contract=%s, owner=%s, operator=%s, approved=%v", contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), approved)
+	s.logger.WithContext(ctx).Infof("approval checked: contract=%s, owner=%s, operator=%s, approved=%v", contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), approved)
 
 	return &pb.IsApprovedForAllERC721Response{
 		Approved:        approved,
@@ -360,34 +360,34 @@ func (s *ERC721Service) TransferERC721(ctx context.Context, req *pb.TransferERC7
 	}
 
 	// Verify sender matches from_address
-	if senderAddr.Hex() != fromAddr.Hex() {
+	if contract.SenderFromContext(ctx, senderAddr).Hex() != fromAddr.Hex() {
 		return nil, errors.ToGRPCError(errors.InvalidArgument("private key does not match from_address"))
 	}
 
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Transfer token
 	tx, err := token.TransferFrom(auth, fromAddr, toAddr, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to transfer token: contract=%s, from=%s, to=%s, token_id=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to transfer token: contract=%s, from=%s, to=%s, token_id=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("transfer initiated: contract=%s, from=%s, to=%s, token_id=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("transfer initiated: contract=%s, from=%s, to=%s, token_id=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), txHash.Hex())
 
 	return &pb.TransferERC721Response{
@@ -445,34 +445,34 @@ func (s *ERC721Service) SafeTransferERC721(ctx context.Context, req *pb.SafeTran
 	}
 
 	// Verify sender matches from_address
-	if senderAddr.Hex() != fromAddr.Hex() {
+	if contract.SenderFromContext(ctx, senderAddr).Hex() != fromAddr.Hex() {
 		return nil, errors.ToGRPCError(errors.InvalidArgument("private key does not match from_address"))
 	}
 
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Safe transfer token
 	tx, err := token.SafeTransferFrom(auth, fromAddr, toAddr, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to safe transfer token: contract=%s, from=%s, to=%s, token_id=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to safe transfer token: contract=%s, from=%s, to=%s, token_id=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to safe transfer token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("safe transfer initiated: contract=%s, from=%s, to=%s, token_id=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("safe transfer initiated: contract=%s, from=%s, to=%s, token_id=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), txHash.Hex())
 
 	return &pb.SafeTransferERC721Response{
@@ -530,34 +530,34 @@ func (s *ERC721Service) SafeTransferERC721WithData(ctx context.Context, req *pb.
 	}
 
 	// Verify sender matches from_address
-	if senderAddr.Hex() != fromAddr.Hex() {
+	if contract.SenderFromContext(ctx, senderAddr).Hex() != fromAddr.Hex() {
 		return nil, errors.ToGRPCError(errors.InvalidArgument("private key does not match from_address"))
 	}
 
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Safe transfer token with data
 	tx, err := token.SafeTransferFrom0(auth, fromAddr, toAddr, tokenID, req.Data)
 	if err != nil {
-		s.logger.Errorf("failed to safe transfer token with data: contract=%s, from=%s, to=%s, token_id=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to safe transfer token with data: contract=%s, from=%s, to=%s, token_id=%s, error=%v",
 			contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to safe transfer token with data"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("safe transfer with data initiated: contract=%s, from=%s, to=%s, token_id=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("safe transfer with data initiated: contract=%s, from=%s, to=%s, token_id=%s, tx=%s",
 		contractAddr.Hex(), fromAddr.Hex(), toAddr.Hex(), tokenID.String(), txHash.Hex())
 
 	return &pb.SafeTransferERC721WithDataResponse{
@@ -612,27 +612,27 @@ func (s *ERC721Service) ApproveERC721(ctx context.Context, req *pb.ApproveERC721
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Approve token
 	tx, err := token.Approve(auth, approvedAddr, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to approve token: contract=%s, owner=%s, approved=%s, token_id=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to approve token: contract=%s, owner=%s, approved=%s, token_id=%s, error=%v",
 			contractAddr.Hex(), ownerAddr.Hex(), approvedAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to approve token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("approval initiated: contract=%s, owner=%s, approved=%s, token_id=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("approval initiated: contract=%s, owner=%s, approved=%s, token_id=%s, tx=%s",
 		contractAddr.Hex(), ownerAddr.Hex(), approvedAddr.Hex(), tokenID.String(), txHash.Hex())
 
 	return &pb.ApproveERC721Response{
@@ -681,27 +681,27 @@ func (s *ERC721Service) SetApprovalForAllERC721(ctx context.Context, req *pb.Set
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Set approval for all
 	tx, err := token.SetApprovalForAll(auth, operatorAddr, req.Approved)
 	if err != nil {
-		s.logger.Errorf("failed to set approval for all: contract=%s, owner=%s, operator=%s, approved=%v, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to set approval for all: contract=%s, owner=%s, operator=%s, approved=%v, error=%v",
 			contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), req.Approved, err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to set approval for all"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("approval for all initiated: contract=%s, owner=%s, operator=%s, approved=%v, tx=%s",
+	s.logger.WithContext(ctx).Infof("approval for all initiated: contract=%s, owner=%s, operator=%s, approved=%v, tx=%s",
 		contractAddr.Hex(), ownerAddr.Hex(), operatorAddr.Hex(), req.Approved, txHash.Hex())
 
 	return &pb.SetApprovalForAllERC721Response{
@@ -750,27 +750,27 @@ func (s *ERC721Service) SafeMintERC721(ctx context.Context, req *pb.SafeMintERC7
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Mint token
 	tx, err := token.SafeMint(auth, toAddr, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to mint token: contract=%s, to=%s, token_id=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to mint token: contract=%s, to=%s, token_id=%s, error=%v",
 			contractAddr.Hex(), toAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to mint token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("mint initiated: contract=%s, to=%s, token_id=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("mint initiated: contract=%s, to=%s, token_id=%s, tx=%s",
 		contractAddr.Hex(), toAddr.Hex(), tokenID.String(), txHash.Hex())
 
 	return &pb.SafeMintERC721Response{
@@ -819,27 +819,27 @@ func (s *ERC721Service) BurnERC721(ctx context.Context, req *pb.BurnERC721Reques
 	// Create ERC721 contract instance
 	token, err := s.contractClient.GetERC721Token(contractAddr)
 	if err != nil {
-		s.logger.Errorf("failed to get ERC721 token: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Burn token
 	tx, err := token.Burn(auth, tokenID)
 	if err != nil {
-		s.logger.Errorf("failed to burn token: contract=%s, token_id=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to burn token: contract=%s, token_id=%s, error=%v",
 			contractAddr.Hex(), tokenID.String(), err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to burn token"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("burn initiated: contract=%s, owner=%s, token_id=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("burn initiated: contract=%s, owner=%s, token_id=%s, tx=%s",
 		contractAddr.Hex(), ownerAddr.Hex(), tokenID.String(), txHash.Hex())
 
 	return &pb.BurnERC721Response{
@@ -880,6 +880,8 @@ func (s *ERC721Service) DeployERC721(ctx context.Context, req *pb.DeployERC721Re
 	if err != nil {
 		return nil, errors.ToGRPCError(err)
 	}
+	// An unsigned build deploys from the external signer
+	deployerAddr = contract.SenderFromContext(ctx, deployerAddr)
 
 	// Determine initial owner
 	ownerAddr := deployerAddr
@@ -893,20 +895,20 @@ func (s *ERC721Service) DeployERC721(ctx context.Context, req *pb.DeployERC721Re
 	// Create transaction options
 	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
 	if err != nil {
-		s.logger.Errorf("failed to create transaction options: %v", err)
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
 		return nil, errors.ToGRPCError(err)
 	}
 
 	// Deploy contract
 	contractAddr, tx, _, err := s.deployERC721Token(auth, ownerAddr, req.Name, req.Symbol)
 	if err != nil {
-		s.logger.Errorf("failed to deploy ERC721 contract: name=%s, symbol=%s, error=%v",
+		s.logger.WithContext(ctx).Errorf("failed to deploy ERC721 contract: name=%s, symbol=%s, error=%v",
 			req.Name, req.Symbol, err)
 		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to deploy ERC721 contract"))
 	}
 
 	txHash := tx.Hash()
-	s.logger.Infof("contract deployed: name=%s, symbol=%s, contract=%s, deployer=%s, tx=%s",
+	s.logger.WithContext(ctx).Infof("contract deployed: name=%s, symbol=%s, contract=%s, deployer=%s, tx=%s",
 		req.Name, req.Symbol, contractAddr.Hex(), deployerAddr.Hex(), txHash.Hex())
 
 	return &pb.DeployERC721Response{
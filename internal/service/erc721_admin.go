@@ -0,0 +1,294 @@
+// Package service provides business logic services for ERC721 contract administration.
+package service
+
+import (
+	"context"
+
+	pb "eth-contract-service/api/erc721/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+)
+
+// PauseERC721 pauses all token transfers of an ERC721 contract.
+// It is signed by the contract owner, either with private_key or with the admin keystore.
+func (s *ERC721Service) PauseERC721(ctx context.Context, req *pb.PauseERC721Request) (*pb.PauseERC721Response, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare pause: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Pause contract
+	tx, err := token.Pause(auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to pause contract: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to pause contract"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("pause initiated: contract=%s, from=%s, tx=%s", contractAddr.Hex(), auth.From.Hex(), txHash.Hex())
+
+	return &pb.PauseERC721Response{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		FromAddress:     auth.From.Hex(),
+	}, nil
+}
+
+// UnpauseERC721 resumes token transfers of an ERC721 contract.
+// It is signed by the contract owner, either with private_key or with the admin keystore.
+func (s *ERC721Service) UnpauseERC721(ctx context.Context, req *pb.UnpauseERC721Request) (*pb.UnpauseERC721Response, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare unpause: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Unpause contract
+	tx, err := token.Unpause(auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to unpause contract: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to unpause contract"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("unpause initiated: contract=%s, from=%s, tx=%s", contractAddr.Hex(), auth.From.Hex(), txHash.Hex())
+
+	return &pb.UnpauseERC721Response{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		FromAddress:     auth.From.Hex(),
+	}, nil
+}
+
+// GetERC721Paused returns whether token transfers of an ERC721 contract are paused.
+func (s *ERC721Service) GetERC721Paused(ctx context.Context, req *pb.GetERC721PausedRequest) (*pb.GetERC721PausedResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	paused, err := token.Paused(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get paused: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get paused"))
+	}
+
+	return &pb.GetERC721PausedResponse{
+		ContractAddress: req.ContractAddress,
+		Paused:          paused,
+	}, nil
+}
+
+// GetERC721Owner returns the current owner of an ERC721 contract.
+func (s *ERC721Service) GetERC721Owner(ctx context.Context, req *pb.GetERC721OwnerRequest) (*pb.GetERC721OwnerResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	owner, err := token.Owner(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get owner: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get owner"))
+	}
+
+	return &pb.GetERC721OwnerResponse{
+		ContractAddress: req.ContractAddress,
+		OwnerAddress:    owner.Hex(),
+	}, nil
+}
+
+// TransferERC721Ownership transfers ownership of an ERC721 contract to a new owner.
+// It is signed by the current owner, either with private_key or with the admin keystore.
+func (s *ERC721Service) TransferERC721Ownership(ctx context.Context, req *pb.TransferERC721OwnershipRequest) (*pb.TransferERC721OwnershipResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate addresses
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	newOwner, err := validator.ValidateAddress(req.NewOwner, "new_owner")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare ownership transfer: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Transfer ownership
+	tx, err := token.TransferOwnership(auth, newOwner)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to transfer ownership: contract=%s, new_owner=%s, error=%v",
+			contractAddr.Hex(), newOwner.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer ownership"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("ownership transfer initiated: contract=%s, from=%s, new_owner=%s, tx=%s",
+		contractAddr.Hex(), auth.From.Hex(), newOwner.Hex(), txHash.Hex())
+
+	return &pb.TransferERC721OwnershipResponse{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		PreviousOwner:   auth.From.Hex(),
+		NewOwner:        newOwner.Hex(),
+	}, nil
+}
+
+// RenounceERC721Ownership leaves an ERC721 contract without an owner.
+// Renouncing is irreversible, so the request must carry the confirmation token
+// "RENOUNCE <contract_address>".
+func (s *ERC721Service) RenounceERC721Ownership(ctx context.Context, req *pb.RenounceERC721OwnershipRequest) (*pb.RenounceERC721OwnershipResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate confirmation
+	if err := validateRenounceConfirmation(contractAddr, req.Confirmation); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve the owner key and create transaction options
+	auth, err := prepareAdminTx(ctx, s.contractClient, token, req.PrivateKey, req.UseAdmin)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare ownership renounce: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Renounce ownership
+	tx, err := token.RenounceOwnership(auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to renounce ownership: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to renounce ownership"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Warnf("ownership renounce initiated: contract=%s, from=%s, tx=%s",
+		contractAddr.Hex(), auth.From.Hex(), txHash.Hex())
+
+	return &pb.RenounceERC721OwnershipResponse{
+		TxHash:          txHash.Hex(),
+		ContractAddress: req.ContractAddress,
+		PreviousOwner:   auth.From.Hex(),
+	}, nil
+}
@@ -0,0 +1,248 @@
+// Package service provides business logic services for bulk ERC721 minting.
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	pb "eth-contract-service/api/erc721/v1"
+	"eth-contract-service/internal/batch"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/contract/erc721"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// batchOpERC721Mint is the batch operation name for bulk ERC721 minting
+const batchOpERC721Mint = "erc721_mint"
+
+// mintRow is a validated bulk mint item as stored in a batch item
+type mintRow struct {
+	To      string `json:"to"`
+	TokenID string `json:"token_id"`
+	URI     string `json:"uri,omitempty"`
+}
+
+// BatchMintERC721 mints many ERC721 tokens and reports the result per item.
+// Items without a token ID receive the next IDs from the contract's token ID sequence.
+// Items that failed can be minted again by calling it with the returned batch_id.
+func (s *ERC721Service) BatchMintERC721(ctx context.Context, req *pb.BatchMintERC721Request) (*pb.BatchMintERC721Response, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate private key
+	privateKey, err := validator.ValidatePrivateKey(req.PrivateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Get sender address from private key
+	fromAddr, err := s.contractClient.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Load the items to mint: either a previous batch or the validated input
+	var b *batch.Batch
+	var items []*batch.Item
+	var rows []mintRow
+	if req.BatchId != "" {
+		b, items, err = loadBatch(ctx, req.BatchId, batchOpERC721Mint, contractAddr, fromAddr)
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+	} else {
+		rows, err = parseMintRows(req.Items)
+		if err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Only the contract owner can mint
+	owner, err := token.Owner(eth.NewCallOpts(ctx, nil))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get owner: contract=%s, error=%v", contractAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get owner"))
+	}
+	if owner != fromAddr {
+		return nil, errors.ToGRPCError(errors.FailedPrecondition("sender %s is not the contract owner %s", fromAddr.Hex(), owner.Hex()))
+	}
+
+	// Assign token IDs and persist a new batch only after all checks passed
+	if b == nil {
+		if err := assignTokenIDs(ctx, contractAddr, rows); err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to assign token IDs: contract=%s, error=%v", contractAddr.Hex(), err)
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to assign token IDs"))
+		}
+		b, items, err = batch.Create(ctx, batchOpERC721Mint, contractAddr, fromAddr, rows)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to create batch: contract=%s, from=%s, error=%v", contractAddr.Hex(), fromAddr.Hex(), err)
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to create batch"))
+		}
+	}
+
+	// Create transaction options
+	auth, err := s.contractClient.CreateTransactOpts(ctx, privateKey)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	if err := s.mintItems(ctx, auth, contractAddr, token, items, batch.NewBudget()); err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to save batch progress: batch=%s, error=%v", b.ID, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to save batch progress"))
+	}
+
+	resp := &pb.BatchMintERC721Response{
+		BatchId:         b.ID,
+		ContractAddress: req.ContractAddress,
+		FromAddress:     fromAddr.Hex(),
+		Results:         make([]*pb.BatchMintResult, 0, len(items)),
+	}
+	for _, item := range items {
+		var row mintRow
+		if err := item.Decode(&row); err != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to decode batch items"))
+		}
+		switch {
+		case item.Done():
+			resp.Succeeded++
+		case item.Status == batch.StatusPending:
+			resp.Pending++
+		default:
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, &pb.BatchMintResult{
+			Index:     int32(item.Seq),
+			ToAddress: row.To,
+			TokenId:   row.TokenID,
+			Uri:       row.URI,
+			Status:    string(item.Status),
+			TxHash:    item.TxHash,
+			Error:     item.Error,
+		})
+	}
+
+	s.logger.WithContext(ctx).Infof("batch mint initiated: contract=%s, from=%s, batch=%s, items=%d, succeeded=%d, failed=%d, pending=%d",
+		contractAddr.Hex(), fromAddr.Hex(), b.ID, len(items), resp.Succeeded, resp.Failed, resp.Pending)
+
+	return resp, nil
+}
+
+// mintItems mints the items that still have to be sent, one transaction per token
+func (s *ERC721Service) mintItems(ctx context.Context, auth *bind.TransactOpts, contractAddr common.Address, token *erc721.Erc721, items []*batch.Item, budget *batch.Budget) error {
+	var uriMinter *erc721.ERC721URIMintable
+	for _, item := range items {
+		if item.Done() {
+			continue
+		}
+		if !budget.Take() {
+			return nil
+		}
+
+		var row mintRow
+		if err := item.Decode(&row); err != nil {
+			return err
+		}
+		to := common.HexToAddress(row.To)
+		tokenID, _ := new(big.Int).SetString(row.TokenID, 10)
+
+		tx, err := sendWithNonce(ctx, auth, func() (*types.Transaction, error) {
+			if row.URI == "" {
+				return token.SafeMint(auth, to, tokenID)
+			}
+			if uriMinter == nil {
+				var err error
+				if uriMinter, err = erc721.NewERC721URIMintable(contractAddr, eth.GetClient()); err != nil {
+					return nil, err
+				}
+			}
+			return uriMinter.SafeMint(auth, to, tokenID, row.URI)
+		})
+		if err != nil {
+			s.logger.WithContext(ctx).Warnf("batch mint item failed: batch=%s, index=%d, to=%s, token_id=%s, error=%v",
+				item.BatchID, item.Seq, row.To, row.TokenID, err)
+		}
+		if err := batch.Mark(ctx, []*batch.Item{item}, tx, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseMintRows validates the bulk mint items
+func parseMintRows(items []*pb.BatchMintItem) ([]mintRow, error) {
+	if len(items) == 0 {
+		return nil, errors.InvalidArgument("items cannot be empty")
+	}
+	if len(items) > maxBatchRows {
+		return nil, errors.InvalidArgument("too many items: %d (max %d)", len(items), maxBatchRows)
+	}
+
+	seen := make(map[string]int)
+	rows := make([]mintRow, 0, len(items))
+	for i, item := range items {
+		to, err := validator.ValidateAddress(item.ToAddress, fmt.Sprintf("items[%d].to_address", i))
+		if err != nil {
+			return nil, err
+		}
+
+		row := mintRow{To: to.Hex(), URI: item.Uri}
+		if item.TokenId != "" {
+			tokenID, err := validator.ValidateAmount(item.TokenId, fmt.Sprintf("items[%d].token_id", i))
+			if err != nil {
+				return nil, err
+			}
+			row.TokenID = tokenID.String()
+			if j, ok := seen[row.TokenID]; ok {
+				return nil, errors.InvalidArgument("items[%d].token_id duplicates items[%d].token_id: %s", i, j, row.TokenID)
+			}
+			seen[row.TokenID] = i
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// assignTokenIDs fills empty token IDs from the contract's token ID sequence
+func assignTokenIDs(ctx context.Context, contractAddr common.Address, rows []mintRow) error {
+	var missing []int
+	for i := range rows {
+		if rows[i].TokenID == "" {
+			missing = append(missing, i)
+		}
+	}
+
+	ids, err := batch.NextTokenIDs(ctx, contractAddr, len(missing))
+	if err != nil {
+		return err
+	}
+	for n, i := range missing {
+		rows[i].TokenID = ids[n].String()
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Package service provides business logic services for ERC721 metadata resolution.
+package service
+
+import (
+	"context"
+	"math/big"
+
+	pb "eth-contract-service/api/erc721/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/metadata"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+)
+
+// GetERC721Metadata resolves the token URI of a token and returns its normalized metadata.
+// Resolved documents are cached; refresh forces a new fetch.
+func (s *ERC721Service) GetERC721Metadata(ctx context.Context, req *pb.GetERC721MetadataRequest) (*pb.GetERC721MetadataResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	contractAddr, err := validator.ValidateContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate token ID
+	tokenID, ok := new(big.Int).SetString(req.TokenId, 10)
+	if !ok {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("invalid token_id format"))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Create ERC721 contract instance
+	token, err := s.contractClient.GetERC721Token(contractAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get ERC721 token: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Get token URI
+	tokenURI, err := token.TokenURI(eth.NewCallOpts(ctx, nil), tokenID)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get token URI: contract=%s, token_id=%s, error=%v", contractAddr.Hex(), tokenID.String(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get token URI"))
+	}
+
+	// Fetch and normalize the metadata document
+	res, err := metadata.Resolve(ctx, tokenURI, tokenID, req.Refresh)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to resolve metadata: contract=%s, token_id=%s, uri=%s, error=%v",
+			contractAddr.Hex(), tokenID.String(), tokenURI, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeUnavailable, "failed to resolve metadata"))
+	}
+
+	s.logger.WithContext(ctx).Infof("metadata resolved: contract=%s, token_id=%s, uri=%s, cached=%t",
+		contractAddr.Hex(), tokenID.String(), res.URI, res.Cached)
+
+	md := &pb.NFTMetadata{
+		Name:         res.Metadata.Name,
+		Description:  res.Metadata.Description,
+		Image:        res.Metadata.Image,
+		AnimationUrl: res.Metadata.AnimationURL,
+		ExternalUrl:  res.Metadata.ExternalURL,
+		Attributes:   make([]*pb.NFTAttribute, 0, len(res.Metadata.Attributes)),
+	}
+	for _, a := range res.Metadata.Attributes {
+		md.Attributes = append(md.Attributes, &pb.NFTAttribute{
+			TraitType:   a.TraitType,
+			Value:       a.Value,
+			DisplayType: a.DisplayType,
+		})
+	}
+
+	// The raw document is only returned on request; normalized fields are the default
+	raw := ""
+	if req.IncludeRaw {
+		raw = res.Metadata.Raw
+	}
+
+	return &pb.GetERC721MetadataResponse{
+		ContractAddress: req.ContractAddress,
+		TokenId:         req.TokenId,
+		TokenUri:        tokenURI,
+		ResolvedUri:     res.URI,
+		Metadata:        md,
+		Raw:             raw,
+		Cached:          res.Cached,
+		FetchedAt:       res.FetchedAt.Unix(),
+	}, nil
+}
@@ -0,0 +1,98 @@
+// Package service provides business logic services for health probes.
+package service
+
+import (
+	"context"
+	"time"
+
+	"eth-contract-service/internal/health"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthWatchInterval is how often Watch re-runs the readiness checks
+const healthWatchInterval = 5 * time.Second
+
+// HealthService implements the standard gRPC health checking protocol on top of the
+// readiness checks. The empty service name reports the whole service; a component
+// name such as ethereum reports that component only.
+type HealthService struct {
+	healthpb.UnimplementedHealthServer
+	logger *log.Helper // logger for service logging
+}
+
+// NewHealthService creates a new instance of HealthService.
+func NewHealthService(logger log.Logger) *HealthService {
+	return &HealthService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// Check reports SERVING unless the service or the requested component is down.
+func (s *HealthService) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	servingStatus, err := s.servingStatus(ctx, req.GetService())
+	if err != nil {
+		return nil, err
+	}
+	return &healthpb.HealthCheckResponse{Status: servingStatus}, nil
+}
+
+// Watch streams the serving status whenever it changes.
+func (s *HealthService) Watch(req *healthpb.HealthCheckRequest, stream grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		servingStatus, err := s.servingStatus(ctx, req.GetService())
+		if status.Code(err) == codes.NotFound {
+			servingStatus = healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		} else if err != nil {
+			return err
+		}
+		if servingStatus != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+			last = servingStatus
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// servingStatus runs the readiness checks for a service name
+func (s *HealthService) servingStatus(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	var names []string
+	if service != "" {
+		known := false
+		for _, name := range health.Components() {
+			if name == service {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return healthpb.HealthCheckResponse_UNKNOWN, status.Errorf(codes.NotFound, "unknown service: %s", service)
+		}
+		names = []string{service}
+	}
+
+	report := health.Ready(ctx, names...)
+	if service != "" && report.Checks[service].Status == health.StatusDown {
+		return healthpb.HealthCheckResponse_NOT_SERVING, nil
+	}
+	if report.Status == health.StatusDown {
+		return healthpb.HealthCheckResponse_NOT_SERVING, nil
+	}
+	return healthpb.HealthCheckResponse_SERVING, nil
+}
@@ -0,0 +1,161 @@
+// Package service provides business logic services for asynchronous job management.
+package service
+
+import (
+	"context"
+	"strconv"
+
+	pb "eth-contract-service/api/job/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/validator"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultPageSize is the page size used when none is requested
+	defaultPageSize = 20
+	// maxPageSize is the largest page size a client may request
+	maxPageSize = 100
+)
+
+// JobService implements the Job API service.
+// It provides methods for inspecting and cancelling asynchronous write jobs.
+type JobService struct {
+	pb.UnimplementedJobServer
+	logger *log.Helper
+}
+
+// NewJobService creates a new instance of JobService.
+func NewJobService(logger log.Logger) *JobService {
+	return &JobService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// GetJob returns the current state of an asynchronous job.
+func (s *JobService) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.GetJobResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.JobId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("job_id cannot be empty"))
+	}
+
+	store := job.GetStore()
+	if store == nil {
+		return nil, errors.ToGRPCError(errors.ErrJobsDisabled)
+	}
+
+	j, err := store.Get(ctx, req.JobId)
+	if err == job.ErrNotFound {
+		return nil, errors.ToGRPCError(errors.ErrJobNotFound)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get job: id=%s, error=%v", req.JobId, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get job"))
+	}
+
+	return &pb.GetJobResponse{Job: toJobInfo(j)}, nil
+}
+
+// ListJobs returns asynchronous jobs, newest first.
+func (s *JobService) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	store := job.GetStore()
+	if store == nil {
+		return nil, errors.ToGRPCError(errors.ErrJobsDisabled)
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	jobs, total, err := store.List(ctx, job.ListFilter{
+		Status:    job.Status(req.Status),
+		Operation: req.Operation,
+		Offset:    (page - 1) * pageSize,
+		Limit:     pageSize,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list jobs: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to list jobs"))
+	}
+
+	infos := make([]*pb.JobInfo, 0, len(jobs))
+	for _, j := range jobs {
+		infos = append(infos, toJobInfo(j))
+	}
+
+	return &pb.ListJobsResponse{
+		Jobs:  infos,
+		Total: total,
+	}, nil
+}
+
+// CancelJob cancels a job that has not been signed and broadcast yet.
+func (s *JobService) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.CancelJobResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.JobId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("job_id cannot be empty"))
+	}
+
+	store := job.GetStore()
+	if store == nil {
+		return nil, errors.ToGRPCError(errors.ErrJobsDisabled)
+	}
+
+	j, err := store.Transition(ctx, req.JobId, job.StatusQueued, job.StatusCancelled)
+	switch {
+	case err == job.ErrNotFound:
+		return nil, errors.ToGRPCError(errors.ErrJobNotFound)
+	case err == job.ErrConflict:
+		return nil, errors.ToGRPCError(errors.FailedPrecondition("%s: status=%s", errors.ErrJobNotCancellable.Message, j.Status))
+	case err != nil:
+		s.logger.WithContext(ctx).Errorf("failed to cancel job: id=%s, error=%v", req.JobId, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to cancel job"))
+	}
+
+	s.logger.WithContext(ctx).Infof("job cancelled: id=%s, operation=%s", j.ID, j.Operation)
+
+	return &pb.CancelJobResponse{Job: toJobInfo(j)}, nil
+}
+
+// normalizePage applies defaults and limits to pagination parameters
+func normalizePage(page, pageSize int32) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return int(page), int(pageSize)
+}
+
+// toJobInfo converts a stored job to its API representation
+func toJobInfo(j *job.Job) *pb.JobInfo {
+	info := &pb.JobInfo{
+		JobId:       j.ID,
+		Operation:   j.Operation,
+		Status:      string(j.Status),
+		FromAddress: j.FromAddress,
+		Nonce:       j.Nonce,
+		TxHash:      j.TxHash,
+		GasUsed:     j.GasUsed,
+		Attempts:    j.Attempts,
+		Error:       j.Error,
+		Result:      j.Result,
+		CreatedAt:   j.CreatedAt.Unix(),
+		UpdatedAt:   j.UpdatedAt.Unix(),
+	}
+	if j.BlockNumber > 0 {
+		info.BlockNumber = strconv.FormatUint(j.BlockNumber, 10)
+	}
+	return info
+}
@@ -0,0 +1,516 @@
+// Package service provides business logic services for managed keys.
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "eth-contract-service/api/keys/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/keys"
+	"eth-contract-service/internal/txmanager"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// transferSubmitted means the transferOwnership transaction was sent
+	transferSubmitted = "submitted"
+	// transferConfirmed means the new key owns the contract
+	transferConfirmed = "confirmed"
+	// transferFailed means the transferOwnership transaction could not be sent
+	transferFailed = "failed"
+)
+
+// KeysService implements the Keys API service.
+// It manages the keys of the keystore directory and rotates roles between them.
+type KeysService struct {
+	pb.UnimplementedKeysServer
+	logger         *log.Helper      // logger for service logging
+	contractClient *contract.Client // client for contract interactions
+}
+
+// NewKeysService creates a new instance of KeysService.
+func NewKeysService(logger log.Logger) *KeysService {
+	return &KeysService{
+		logger:         log.NewHelper(logger),
+		contractClient: contract.NewClient(logger),
+	}
+}
+
+// GenerateKey creates a new key encrypted with the request password.
+func (s *KeysService) GenerateKey(ctx context.Context, req *pb.GenerateKeyRequest) (*pb.GenerateKeyResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.Password == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("password cannot be empty"))
+	}
+
+	key, err := keys.Generate(ctx, req.Password, req.Label)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to generate key: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("key generated: address=%s, label=%s", key.Address, key.Label)
+
+	info, err := s.keyInfo(ctx, key)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.GenerateKeyResponse{Key: info}, nil
+}
+
+// ImportKey stores a raw private key or a keystore v3 JSON in the keystore directory.
+func (s *KeysService) ImportKey(ctx context.Context, req *pb.ImportKeyRequest) (*pb.ImportKeyResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if (req.PrivateKey == "") == (req.KeystoreJson == "") {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("exactly one of private_key and keystore_json must be set"))
+	}
+	if req.Password == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("password cannot be empty"))
+	}
+
+	var (
+		key *keys.Key
+		err error
+	)
+	if req.PrivateKey != "" {
+		keyBytes, verr := validator.ValidatePrivateKey(req.PrivateKey)
+		if verr != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(verr))
+		}
+		privateKey, cerr := crypto.ToECDSA(keyBytes)
+		if cerr != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(cerr, errors.CodeInvalidArgument, errors.ErrInvalidPrivateKey.Message))
+		}
+		key, err = keys.Import(ctx, privateKey, req.Password, req.Label)
+	} else {
+		newPassword := req.NewPassword
+		if newPassword == "" {
+			newPassword = req.Password
+		}
+		key, err = keys.ImportJSON(ctx, []byte(req.KeystoreJson), req.Password, newPassword, req.Label)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to import key: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("key imported: address=%s, label=%s", key.Address, key.Label)
+
+	info, err := s.keyInfo(ctx, key)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.ImportKeyResponse{Key: info}, nil
+}
+
+// ExportKey returns the keystore v3 JSON of a key re-encrypted with the new password.
+func (s *KeysService) ExportKey(ctx context.Context, req *pb.ExportKeyRequest) (*pb.ExportKeyResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	addr, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	newPassword := req.NewPassword
+	if newPassword == "" {
+		newPassword = req.Password
+	}
+
+	keyJSON, err := keys.Export(ctx, addr, req.Password, newPassword)
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to export key: address=%s, error=%v", addr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("key exported: address=%s", addr.Hex())
+
+	return &pb.ExportKeyResponse{KeystoreJson: string(keyJSON)}, nil
+}
+
+// ChangeKeyPassword re-encrypts a key with the new password.
+func (s *KeysService) ChangeKeyPassword(ctx context.Context, req *pb.ChangeKeyPasswordRequest) (*pb.ChangeKeyPasswordResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	addr, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.NewPassword == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("new_password cannot be empty"))
+	}
+
+	if err := keys.ChangePassword(ctx, addr, req.Password, req.NewPassword); err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to change key password: address=%s, error=%v", addr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("key password changed: address=%s", addr.Hex())
+
+	info, err := s.keyInfoByAddress(ctx, addr)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.ChangeKeyPasswordResponse{Key: info}, nil
+}
+
+// ListKeys returns the managed keys with their labels and roles, oldest first.
+func (s *KeysService) ListKeys(ctx context.Context, req *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	list, total, err := keys.List(ctx, keys.ListFilter{
+		Label:           req.Label,
+		IncludeDisabled: req.IncludeDisabled,
+		Offset:          (page - 1) * pageSize,
+		Limit:           pageSize,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list keys: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+	roles, err := keys.Roles(ctx)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	infos := make([]*pb.KeyInfo, 0, len(list))
+	for _, key := range list {
+		infos = append(infos, toKeyInfo(key, roles))
+	}
+
+	return &pb.ListKeysResponse{
+		Keys:  infos,
+		Total: total,
+	}, nil
+}
+
+// DisableKey disables a key so that it stops signing, or enables it again. The key
+// password is required either way.
+func (s *KeysService) DisableKey(ctx context.Context, req *pb.DisableKeyRequest) (*pb.DisableKeyResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	addr, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	key, err := keys.SetDisabled(ctx, addr, req.Password, req.Disabled)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to update key: address=%s, error=%v", addr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("key updated: address=%s, disabled=%t", key.Address, key.Disabled)
+
+	info, err := s.keyInfo(ctx, key)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.DisableKeyResponse{Key: info}, nil
+}
+
+// UnlockKey decrypts a key for signing.
+func (s *KeysService) UnlockKey(ctx context.Context, req *pb.UnlockKeyRequest) (*pb.UnlockKeyResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	addr, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.DurationSeconds < 0 {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("duration_seconds cannot be negative"))
+	}
+
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if err := keys.Unlock(ctx, addr, req.Password, duration); err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to unlock key: address=%s, error=%v", addr.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("key unlocked: address=%s, duration=%s", addr.Hex(), duration)
+
+	info, err := s.keyInfoByAddress(ctx, addr)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.UnlockKeyResponse{Key: info}, nil
+}
+
+// LockKey removes a decrypted key from memory.
+func (s *KeysService) LockKey(ctx context.Context, req *pb.LockKeyRequest) (*pb.LockKeyResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	addr, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	if err := keys.Lock(addr); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("key locked: address=%s", addr.Hex())
+
+	info, err := s.keyInfoByAddress(ctx, addr)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.LockKeyResponse{Key: info}, nil
+}
+
+// RotateRole moves a role to another managed key. With transfer_ownership set, ownership
+// of the listed contracts is first transferred from the previous holder to the new key,
+// so the previous key must be unlocked. The role only moves once every transfer was sent;
+// otherwise the partial result is returned and repeating the request resumes the rotation.
+func (s *KeysService) RotateRole(ctx context.Context, req *pb.RotateRoleRequest) (*pb.RotateRoleResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.Role == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("role cannot be empty"))
+	}
+	newAddr, err := validator.ValidateAddress(req.NewAddress, "new_address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if !req.TransferOwnership && len(req.ContractAddresses) > 0 {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("contract_addresses requires transfer_ownership"))
+	}
+	contracts := make([]common.Address, 0, len(req.ContractAddresses))
+	for _, a := range req.ContractAddresses {
+		addr, err := validator.ValidateContractAddress(a)
+		if err != nil {
+			return nil, errors.ToGRPCError(validator.ToAppError(err))
+		}
+		contracts = append(contracts, addr)
+	}
+
+	// The new key must be usable before anything is moved to it
+	if _, err := keys.Signer(ctx, newAddr); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	current, err := keys.GetRole(ctx, req.Role)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	transfer := req.TransferOwnership && len(contracts) > 0
+	if transfer && current == nil {
+		return nil, errors.ToGRPCError(errors.FailedPrecondition("role %s is not assigned, no ownership to transfer", req.Role))
+	}
+	var from string
+	if current != nil {
+		from = current.Address
+	}
+
+	// An unfinished rotation to the same key is resumed from its recorded transfers
+	rotation, recorded, err := keys.StartRotation(ctx, req.Role, from, newAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to start role rotation: role=%s, error=%v", req.Role, err)
+		return nil, errors.ToGRPCError(err)
+	}
+	resp := &pb.RotateRoleResponse{RotationId: rotation.ID}
+
+	if transfer {
+		transfers, ok, err := s.transferOwnership(ctx, common.HexToAddress(rotation.FromAddress), newAddr, contracts, rotation, recorded)
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+		resp.Transfers = transfers
+		if !ok {
+			// The role stays with the previous key until every transfer was sent
+			s.logger.WithContext(ctx).Warnf("role rotation incomplete: role=%s, rotation=%s, new=%s", req.Role, rotation.ID, newAddr.Hex())
+			resp.Role = toRoleInfo(current)
+			return resp, nil
+		}
+	}
+
+	previous, err := keys.AssignRole(ctx, req.Role, newAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to rotate role: role=%s, error=%v", req.Role, err)
+		return nil, errors.ToGRPCError(err)
+	}
+	if err := keys.CompleteRotation(ctx, rotation); err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to complete role rotation: role=%s, rotation=%s, error=%v", req.Role, rotation.ID, err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("role rotated: role=%s, previous=%s, new=%s, transfers=%d", req.Role, previous, newAddr.Hex(), len(resp.Transfers))
+
+	role, err := keys.GetRole(ctx, req.Role)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	resp.Role = toRoleInfo(role)
+	resp.PreviousAddress = previous
+	resp.Completed = true
+	return resp, nil
+}
+
+// ListRoles returns the role assignments ordered by name.
+func (s *KeysService) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	roles, err := keys.Roles(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list roles: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	infos := make([]*pb.RoleInfo, 0, len(roles))
+	for _, role := range roles {
+		infos = append(infos, toRoleInfo(role))
+	}
+	return &pb.ListRolesResponse{Roles: infos}, nil
+}
+
+// transferOwnership transfers the contracts owned by from to the new key and records each
+// transfer in the rotation. Transfers recorded by an earlier attempt are resolved first:
+// mined or pending ones are kept, failed ones are sent again. Every owner is checked
+// before the first transaction is sent. The returned flag is false when a transfer failed.
+func (s *KeysService) transferOwnership(ctx context.Context, from, to common.Address, contracts []common.Address, rotation *keys.Rotation, recorded map[common.Address]*keys.RotationTransfer) ([]*pb.OwnershipTransfer, bool, error) {
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, false, err
+	}
+
+	// transferOwnership and owner share their selectors across the Ownable bindings
+	transfers := make([]*pb.OwnershipTransfer, len(contracts))
+	tokens := make([]ownable, len(contracts))
+	for i, addr := range contracts {
+		transfers[i] = &pb.OwnershipTransfer{ContractAddress: addr.Hex()}
+		if rec := recorded[addr]; rec != nil && rec.TxHash != "" {
+			transfers[i].TxHash = rec.TxHash
+			res, err := txmanager.Resolve(ctx, common.HexToHash(rec.TxHash))
+			if err != nil && err != errors.ErrTransactionNotFound {
+				return nil, false, errors.WrapError(err, errors.CodeInternal, "failed to resolve ownership transfer of "+addr.Hex())
+			}
+			if res != nil && res.Status == txmanager.StatusPending {
+				transfers[i].Status = transferSubmitted
+				continue
+			}
+			if res != nil && res.Status == txmanager.StatusConfirmed {
+				transfers[i].TxHash = res.MinedHash.Hex()
+				transfers[i].Status = transferConfirmed
+				continue
+			}
+		}
+
+		token, err := s.contractClient.GetERC20TokenOwnable(addr)
+		if err != nil {
+			return nil, false, errors.WrapError(err, errors.CodeInternal, "failed to create contract instance")
+		}
+		owner, err := token.Owner(eth.NewCallOpts(ctx, nil))
+		if err != nil {
+			return nil, false, errors.WrapError(err, errors.CodeInternal, "failed to get owner")
+		}
+		if owner == to {
+			transfers[i].Status = transferConfirmed
+			continue
+		}
+		if owner != from {
+			return nil, false, errors.FailedPrecondition("contract %s is owned by %s, not by the previous role key %s", addr.Hex(), owner.Hex(), from.Hex())
+		}
+		tokens[i] = token
+	}
+
+	signer, err := keys.Signer(ctx, from)
+	if err != nil {
+		return nil, false, err
+	}
+	ok := true
+	for i, token := range tokens {
+		if token == nil {
+			continue
+		}
+		rec := recorded[contracts[i]]
+		if rec == nil {
+			rec = &keys.RotationTransfer{RotationID: rotation.ID, ContractAddress: contracts[i].Hex()}
+		}
+
+		auth, err := s.contractClient.CreateSignerTransactOpts(ctx, signer)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+			return nil, false, err
+		}
+		tx, err := sendWithNonce(ctx, auth, func() (*types.Transaction, error) {
+			return token.TransferOwnership(auth, to)
+		})
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to transfer ownership: contract=%s, error=%v", contracts[i].Hex(), err)
+			rec.TxHash = ""
+			rec.Error = err.Error()
+			transfers[i].TxHash = ""
+			transfers[i].Status = transferFailed
+			transfers[i].Error = rec.Error
+			ok = false
+		} else {
+			s.logger.WithContext(ctx).Infof("ownership transfer initiated: contract=%s, from=%s, to=%s, tx=%s",
+				contracts[i].Hex(), from.Hex(), to.Hex(), tx.Hash().Hex())
+			rec.TxHash = tx.Hash().Hex()
+			rec.Error = ""
+			transfers[i].TxHash = rec.TxHash
+			transfers[i].Status = transferSubmitted
+		}
+		if err := keys.SaveTransfer(ctx, rec); err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to record ownership transfer: contract=%s, tx=%s, error=%v", contracts[i].Hex(), rec.TxHash, err)
+			return nil, false, errors.WrapError(err, errors.CodeInternal, "failed to record ownership transfer")
+		}
+	}
+	return transfers, ok, nil
+}
+
+// keyInfoByAddress loads a key with its roles
+func (s *KeysService) keyInfoByAddress(ctx context.Context, addr common.Address) (*pb.KeyInfo, error) {
+	key, err := keys.Get(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return s.keyInfo(ctx, key)
+}
+
+// keyInfo loads the roles of a key and converts it to its API representation
+func (s *KeysService) keyInfo(ctx context.Context, key *keys.Key) (*pb.KeyInfo, error) {
+	roles, err := keys.Roles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toKeyInfo(key, roles), nil
+}
+
+// toKeyInfo converts a managed key and the roles it holds to its API representation
+func toKeyInfo(key *keys.Key, roles []*keys.Role) *pb.KeyInfo {
+	held := make([]string, 0)
+	for _, role := range roles {
+		if role.Address == key.Address {
+			held = append(held, role.Name)
+		}
+	}
+	return &pb.KeyInfo{
+		Address:   key.Address,
+		Label:     key.Label,
+		Disabled:  key.Disabled,
+		Roles:     held,
+		Signer:    keys.SignerKeyPrefix + key.Address,
+		CreatedAt: key.CreatedAt.Unix(),
+	}
+}
+
+// toRoleInfo converts a role assignment to its API representation
+func toRoleInfo(role *keys.Role) *pb.RoleInfo {
+	return &pb.RoleInfo{
+		Name:      role.Name,
+		Address:   role.Address,
+		Signer:    keys.SignerRolePrefix + role.Name,
+		UpdatedAt: role.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,242 @@
+// Package service provides business logic services for native currency transfers.
+package service
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	pb "eth-contract-service/api/native/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NativeService implements the Native API service.
+// It transfers the chain's native currency and queries native balances.
+type NativeService struct {
+	pb.UnimplementedNativeServer
+	contractClient *contract.Client // contract client for transaction signing and fees
+	logger         *log.Helper      // logger for service logging
+}
+
+// NewNativeService creates a new instance of NativeService.
+func NewNativeService(logger log.Logger) *NativeService {
+	return &NativeService{
+		contractClient: contract.NewClient(logger),
+		logger:         log.NewHelper(logger),
+	}
+}
+
+// GetNativeBalance returns the native balance of an address at a block.
+func (s *NativeService) GetNativeBalance(ctx context.Context, req *pb.GetNativeBalanceRequest) (*pb.GetNativeBalanceResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	addr, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	block, err := parseBlock(req.Block)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.ToGRPCError(errors.ErrClientNotInitialized)
+	}
+
+	resp := &pb.GetNativeBalanceResponse{Address: addr.Hex()}
+	if number, ok := block.Number(); ok && number == rpc.PendingBlockNumber {
+		balance, err := client.PendingBalanceAt(ctx, addr)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to get pending balance: address=%s, error=%v", addr.Hex(), err)
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get balance"))
+		}
+		resp.Balance = balance.String()
+		return resp, nil
+	}
+
+	// Resolve the block first so that the response names the block the balance was read at
+	var header *types.Header
+	if hash, ok := block.Hash(); ok {
+		header, err = client.HeaderByHash(ctx, hash)
+	} else {
+		number, _ := block.Number()
+		header, err = client.HeaderByNumber(ctx, big.NewInt(number.Int64()))
+	}
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeNotFound, "block not found: "+block.String()))
+	}
+
+	balance, err := client.BalanceAtHash(ctx, addr, header.Hash())
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get balance: address=%s, block=%s, error=%v", addr.Hex(), header.Number, err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get balance"))
+	}
+
+	resp.Balance = balance.String()
+	resp.BlockNumber = header.Number.String()
+	resp.BlockHash = header.Hash().Hex()
+	return resp, nil
+}
+
+// TransferNative sends native currency from the signer to the specified address.
+func (s *NativeService) TransferNative(ctx context.Context, req *pb.TransferNativeRequest) (*pb.TransferNativeResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	toAddr, err := validator.ValidateAddress(req.ToAddress, "to_address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	amount, err := validator.ValidateAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	signer, err := walletSigner(req.PrivateKey, req.UseAdmin, req.Signer)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	auth, err := s.contractClient.CreateSignerTransactOpts(ctx, signer)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+	fromAddr := auth.From
+
+	transfer, err := s.contractClient.PrepareNativeTransfer(auth, toAddr, amount)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare native transfer: from=%s, to=%s, amount=%s, error=%v",
+			fromAddr.Hex(), toAddr.Hex(), amount.String(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to prepare transfer"))
+	}
+	tx, err := s.contractClient.SendNativeTransfer(auth, transfer)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to transfer native currency: from=%s, to=%s, amount=%s, error=%v",
+			fromAddr.Hex(), toAddr.Hex(), amount.String(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to transfer native currency"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("native transfer initiated: from=%s, to=%s, amount=%s, tx=%s",
+		fromAddr.Hex(), toAddr.Hex(), amount.String(), txHash.Hex())
+
+	return &pb.TransferNativeResponse{
+		TxHash:      txHash.Hex(),
+		FromAddress: fromAddr.Hex(),
+		ToAddress:   toAddr.Hex(),
+		Amount:      amount.String(),
+		MaxFee:      transfer.MaxFee().String(),
+	}, nil
+}
+
+// SweepNative sends the whole balance of the signer minus the transaction fee.
+// The fee is reserved at the max fee per gas, so the part of it that is not charged
+// stays with the sender.
+func (s *NativeService) SweepNative(ctx context.Context, req *pb.SweepNativeRequest) (*pb.SweepNativeResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	toAddr, err := validator.ValidateAddress(req.ToAddress, "to_address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	signer, err := walletSigner(req.PrivateKey, req.UseAdmin, req.Signer)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.ToGRPCError(errors.ErrClientNotInitialized)
+	}
+
+	auth, err := s.contractClient.CreateSignerTransactOpts(ctx, signer)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+	fromAddr := auth.From
+
+	// A Safe proposal sweeps the Safe, whose executor pays the fee
+	sender := contract.SenderFromContext(ctx, fromAddr)
+	balance, err := client.PendingBalanceAt(ctx, sender)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get balance"))
+	}
+	if balance.Sign() == 0 {
+		return nil, errors.ToGRPCError(errors.ErrInsufficientBalance)
+	}
+
+	transfer, err := s.contractClient.PrepareNativeTransfer(auth, toAddr, balance)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to prepare native sweep: from=%s, to=%s, error=%v", sender.Hex(), toAddr.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to prepare sweep"))
+	}
+	if sender == fromAddr {
+		maxFee := transfer.MaxFee()
+		if balance.Cmp(maxFee) <= 0 {
+			return nil, errors.ToGRPCError(errors.ErrInsufficientBalance)
+		}
+		transfer.Value = new(big.Int).Sub(balance, maxFee)
+	}
+
+	tx, err := s.contractClient.SendNativeTransfer(auth, transfer)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to sweep native currency: from=%s, to=%s, amount=%s, error=%v",
+			sender.Hex(), toAddr.Hex(), transfer.Value.String(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to sweep native currency"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("native sweep initiated: from=%s, to=%s, amount=%s, tx=%s",
+		sender.Hex(), toAddr.Hex(), transfer.Value.String(), txHash.Hex())
+
+	resp := &pb.SweepNativeResponse{
+		TxHash:      txHash.Hex(),
+		FromAddress: sender.Hex(),
+		ToAddress:   toAddr.Hex(),
+		Amount:      transfer.Value.String(),
+	}
+	if sender == fromAddr {
+		resp.MaxFee = transfer.MaxFee().String()
+	}
+	return resp, nil
+}
+
+// parseBlock parses a block number, block hash or block tag, defaulting to latest
+func parseBlock(block string) (rpc.BlockNumberOrHash, error) {
+	block = strings.TrimSpace(block)
+	if block == "" {
+		return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil
+	}
+	var bnh rpc.BlockNumberOrHash
+	if err := bnh.UnmarshalJSON([]byte(`"` + block + `"`)); err == nil {
+		return bnh, nil
+	}
+	if n, ok := new(big.Int).SetString(block, 10); ok && n.IsInt64() && n.Sign() >= 0 {
+		return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(n.Int64())), nil
+	}
+	return rpc.BlockNumberOrHash{}, errors.InvalidArgument("invalid block: %s", block)
+}
@@ -0,0 +1,129 @@
+// Package service provides business logic services for address portfolios.
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	pb "eth-contract-service/api/portfolio/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/portfolio"
+	"eth-contract-service/internal/validator"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PortfolioService implements the Portfolio API service.
+// It reads the holdings of an address across many token contracts in one call.
+type PortfolioService struct {
+	pb.UnimplementedPortfolioServer
+	logger *log.Helper // logger for service logging
+}
+
+// NewPortfolioService creates a new instance of PortfolioService.
+func NewPortfolioService(logger log.Logger) *PortfolioService {
+	return &PortfolioService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// GetPortfolio returns the native balance and the token holdings of an address.
+func (s *PortfolioService) GetPortfolio(ctx context.Context, req *pb.GetPortfolioRequest) (*pb.GetPortfolioResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	owner, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	contracts := portfolio.ConfiguredContracts()
+	if len(req.ContractAddresses) > 0 {
+		contracts = make([]common.Address, 0, len(req.ContractAddresses))
+		for i, a := range req.ContractAddresses {
+			addr, err := validator.ValidateAddress(a, fmt.Sprintf("contract_addresses[%d]", i))
+			if err != nil {
+				return nil, errors.ToGRPCError(validator.ToAppError(err))
+			}
+			if !slices.Contains(contracts, addr) {
+				contracts = append(contracts, addr)
+			}
+		}
+	}
+	if len(contracts) > portfolio.MaxContracts {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("too many contracts: %d (max %d)", len(contracts), portfolio.MaxContracts))
+	}
+
+	p, err := portfolio.Get(ctx, owner, contracts)
+	if err != nil {
+		if errors.IsAppError(err) {
+			return nil, errors.ToGRPCError(err)
+		}
+		s.logger.WithContext(ctx).Errorf("failed to get portfolio: address=%s, contracts=%d, error=%v", owner.Hex(), len(contracts), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get portfolio"))
+	}
+
+	resp := &pb.GetPortfolioResponse{
+		Address:                p.Address.Hex(),
+		BlockNumber:            p.BlockNumber.String(),
+		BlockHash:              p.BlockHash.Hex(),
+		NativeBalance:          p.NativeBalance.String(),
+		NativeBalanceFormatted: validator.FormatTokenAmount(p.NativeBalance, 18),
+		Erc20:                  make([]*pb.ERC20Holding, 0, len(p.ERC20)),
+		Erc721:                 make([]*pb.ERC721Holding, 0, len(p.ERC721)),
+		Erc1155:                make([]*pb.ERC1155Holding, 0, len(p.ERC1155)),
+	}
+	for _, h := range p.ERC20 {
+		holding := &pb.ERC20Holding{
+			ContractAddress: h.Contract.Hex(),
+			Name:            h.Name,
+			Symbol:          h.Symbol,
+			Decimals:        uint32(h.Decimals),
+			Error:           h.Error,
+		}
+		if h.Balance != nil && h.Error == "" {
+			holding.Balance = h.Balance.String()
+			holding.BalanceFormatted = validator.FormatTokenAmount(h.Balance, h.Decimals)
+		}
+		resp.Erc20 = append(resp.Erc20, holding)
+	}
+	for _, h := range p.ERC721 {
+		holding := &pb.ERC721Holding{
+			ContractAddress:   h.Contract.Hex(),
+			Name:              h.Name,
+			Symbol:            h.Symbol,
+			TokenIds:          make([]string, 0, len(h.TokenIDs)),
+			TokenIdsTruncated: h.Truncated,
+			Error:             h.Error,
+		}
+		if h.Balance != nil {
+			holding.Balance = h.Balance.String()
+		}
+		for _, id := range h.TokenIDs {
+			holding.TokenIds = append(holding.TokenIds, id.String())
+		}
+		resp.Erc721 = append(resp.Erc721, holding)
+	}
+	for _, h := range p.ERC1155 {
+		holding := &pb.ERC1155Holding{
+			ContractAddress: h.Contract.Hex(),
+			Tokens:          make([]*pb.ERC1155TokenBalance, 0, len(h.Tokens)),
+			TokensTruncated: h.Truncated,
+			Error:           h.Error,
+		}
+		for _, t := range h.Tokens {
+			holding.Tokens = append(holding.Tokens, &pb.ERC1155TokenBalance{TokenId: t.ID.String(), Balance: t.Balance.String()})
+		}
+		resp.Erc1155 = append(resp.Erc1155, holding)
+	}
+	for _, addr := range p.Unsupported {
+		resp.UnsupportedContracts = append(resp.UnsupportedContracts, addr.Hex())
+	}
+
+	s.logger.WithContext(ctx).Infof("portfolio queried: address=%s, block=%s, erc20=%d, erc721=%d, erc1155=%d, unsupported=%d",
+		owner.Hex(), resp.BlockNumber, len(p.ERC20), len(p.ERC721), len(p.ERC1155), len(p.Unsupported))
+	return resp, nil
+}
@@ -0,0 +1,290 @@
+// Package service provides business logic services for the ERC-2771 meta-transaction relayer.
+package service
+
+import (
+	"context"
+	"math/big"
+
+	pb "eth-contract-service/api/relayer/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/relayer"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/contract/forwarder"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// RelayerService implements the Relayer API service.
+// It submits ERC-2771 forward requests signed by end users through the configured
+// MinimalForwarder, paying the gas from a keystore relayer key.
+type RelayerService struct {
+	pb.UnimplementedRelayerServer
+	logger         *log.Helper      // logger for service logging
+	contractClient *contract.Client // client for contract interactions
+}
+
+// NewRelayerService creates a new instance of RelayerService.
+func NewRelayerService(logger log.Logger) *RelayerService {
+	return &RelayerService{
+		logger:         log.NewHelper(logger),
+		contractClient: contract.NewClient(logger),
+	}
+}
+
+// Relay verifies the signature, nonce and quota of a forward request, simulates execute()
+// and submits it from the relayer key.
+func (s *RelayerService) Relay(ctx context.Context, req *pb.RelayRequest) (*pb.RelayResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if !relayer.Enabled() {
+		return nil, errors.ToGRPCError(errors.ErrRelayerDisabled)
+	}
+
+	// Validate forward request and signature
+	fwdReq, sig, err := verifyRelay(req)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Resolve relayer signer
+	relayerSigner, err := resolveSigner(relayer.GetSettings().Signer)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	relayerAddr := relayerSigner.Address()
+
+	// Create forwarder contract instance
+	forwarderAddr := relayer.GetSettings().Forwarder
+	fwd, err := s.contractClient.GetForwarder(forwarderAddr)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get forwarder: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Check the nonce and let the forwarder verify the request as it will on execute()
+	callOpts := eth.NewCallOpts(ctx, nil)
+	callOpts.From = relayerAddr
+	nonce, err := fwd.GetNonce(callOpts, fwdReq.From)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get forwarder nonce: forwarder=%s, from=%s, error=%v", forwarderAddr.Hex(), fwdReq.From.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get forwarder nonce"))
+	}
+	if nonce.Cmp(fwdReq.Nonce) != 0 {
+		return nil, errors.ToGRPCError(errors.WrapError(errors.ErrRelayNonceMismatch, errors.CodeFailedPrecondition,
+			"expected nonce "+nonce.String()))
+	}
+	ok, err := fwd.Verify(callOpts, *fwdReq, sig)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to verify forward request: forwarder=%s, from=%s, error=%v", forwarderAddr.Hex(), fwdReq.From.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to verify forward request"))
+	}
+	if !ok {
+		return nil, errors.ToGRPCError(errors.WrapError(errors.ErrInvalidSignature, errors.CodeInvalidArgument,
+			"forwarder rejected the request, check the EIP-712 domain"))
+	}
+
+	// Simulate execute() so that reverting calls do not cost the relayer gas
+	var out []interface{}
+	raw := &forwarder.MinimalForwarderRaw{Contract: fwd}
+	if err := raw.Call(callOpts, &out, "execute", *fwdReq, sig); err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeFailedPrecondition, errors.ErrRelayReverted.Message))
+	}
+	if success, _ := out[0].(bool); !success {
+		return nil, errors.ToGRPCError(errors.ErrRelayReverted)
+	}
+
+	// Consume quota; a job took it once when it was enqueued and keeps it across attempts
+	jobID, inJob := job.IDFromContext(ctx)
+	var remaining int64
+	if inJob {
+		remaining, err = relayer.TakeForJob(ctx, jobID, fwdReq.From)
+	} else {
+		remaining, err = relayer.Take(ctx, fwdReq.From)
+	}
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	refund := func() {
+		if !inJob {
+			relayer.Refund(ctx, fwdReq.From)
+		}
+	}
+
+	// Create transaction options
+	auth, err := s.contractClient.CreateSignerTransactOpts(ctx, relayerSigner)
+	if err != nil {
+		refund()
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Submit forward request
+	tx, err := fwd.Execute(auth, *fwdReq, sig)
+	if err != nil {
+		refund()
+		s.logger.WithContext(ctx).Errorf("failed to relay forward request: forwarder=%s, from=%s, to=%s, nonce=%s, error=%v",
+			forwarderAddr.Hex(), fwdReq.From.Hex(), fwdReq.To.Hex(), fwdReq.Nonce.String(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to relay forward request"))
+	}
+
+	txHash := tx.Hash()
+	s.logger.WithContext(ctx).Infof("relay initiated: forwarder=%s, from=%s, to=%s, nonce=%s, relayer=%s, tx=%s",
+		forwarderAddr.Hex(), fwdReq.From.Hex(), fwdReq.To.Hex(), fwdReq.Nonce.String(), relayerAddr.Hex(), txHash.Hex())
+
+	return &pb.RelayResponse{
+		TxHash:         txHash.Hex(),
+		Forwarder:      forwarderAddr.Hex(),
+		FromAddress:    fwdReq.From.Hex(),
+		ToAddress:      fwdReq.To.Hex(),
+		RelayerAddress: relayerAddr.Hex(),
+		Nonce:          fwdReq.Nonce.String(),
+		RemainingQuota: remaining,
+	}, nil
+}
+
+// AdmitRelay verifies an asynchronous relay request when it is enqueued and takes the
+// sender's quota once for the job, so that retried attempts do not take it again and a
+// request with a bad signature cannot use up the quota of its claimed sender.
+func (s *RelayerService) AdmitRelay(ctx context.Context, id string, req *pb.RelayRequest) (func(), error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, validator.ToAppError(err)
+	}
+	if !relayer.Enabled() {
+		return nil, errors.ErrRelayerDisabled
+	}
+	fwdReq, _, err := verifyRelay(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := relayer.TakeForJob(ctx, id, fwdReq.From); err != nil {
+		return nil, err
+	}
+	return func() { relayer.RefundJob(context.WithoutCancel(ctx), id, fwdReq.From) }, nil
+}
+
+// GetRelayNonce returns what a client needs to build and sign the next forward request of a sender.
+func (s *RelayerService) GetRelayNonce(ctx context.Context, req *pb.GetRelayNonceRequest) (*pb.GetRelayNonceResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if !relayer.Enabled() {
+		return nil, errors.ToGRPCError(errors.ErrRelayerDisabled)
+	}
+
+	// Validate address
+	address, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	settings := relayer.GetSettings()
+	fwd, err := s.contractClient.GetForwarder(settings.Forwarder)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get forwarder: %v", err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	nonce, err := fwd.GetNonce(eth.NewCallOpts(ctx, nil), address)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get forwarder nonce: forwarder=%s, from=%s, error=%v", settings.Forwarder.Hex(), address.Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get forwarder nonce"))
+	}
+
+	domain, err := relayer.Domain()
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return &pb.GetRelayNonceResponse{
+		Address:        address.Hex(),
+		Nonce:          nonce.String(),
+		Forwarder:      settings.Forwarder.Hex(),
+		DomainName:     domain.Name,
+		DomainVersion:  domain.Version,
+		ChainId:        (*big.Int)(domain.ChainId).Int64(),
+		RemainingQuota: relayer.Remaining(ctx, address),
+		MaxGas:         settings.MaxGas,
+	}, nil
+}
+
+// verifyRelay parses a relay request and checks it against the relayer settings and the
+// signature of its sender
+func verifyRelay(req *pb.RelayRequest) (*forwarder.MinimalForwarderForwardRequest, []byte, error) {
+	fwdReq, err := forwardRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := relayer.Check(fwdReq); err != nil {
+		return nil, nil, err
+	}
+	sig, err := keystore.ParseSignature(req.Signature)
+	if err != nil {
+		return nil, nil, errors.WrapError(err, errors.CodeInvalidArgument, errors.ErrInvalidSignature.Message)
+	}
+	if err := relayer.Verify(fwdReq, sig); err != nil {
+		return nil, nil, err
+	}
+	return fwdReq, sig, nil
+}
+
+// forwardRequest parses the ForwardRequest of a relay request
+func forwardRequest(req *pb.RelayRequest) (*forwarder.MinimalForwarderForwardRequest, error) {
+	from, err := validator.ValidateAddress(req.From, "from")
+	if err != nil {
+		return nil, validator.ToAppError(err)
+	}
+	to, err := validator.ValidateAddress(req.To, "to")
+	if err != nil {
+		return nil, validator.ToAppError(err)
+	}
+
+	// The relayer pays msg.value of execute(), so relayed calls cannot carry value
+	value := new(big.Int)
+	if req.Value != "" {
+		value, err = validator.ValidateAmount(req.Value, "value")
+		if err != nil {
+			return nil, validator.ToAppError(err)
+		}
+		if value.Sign() != 0 {
+			return nil, errors.InvalidArgument("relayed calls cannot carry value")
+		}
+	}
+
+	nonce, err := validator.ValidateAmount(req.Nonce, "nonce")
+	if err != nil {
+		return nil, validator.ToAppError(err)
+	}
+
+	var data []byte
+	if req.Data != "" {
+		data, err = hexutil.Decode(req.Data)
+		if err != nil {
+			return nil, errors.InvalidArgument("invalid data: %v", err)
+		}
+	}
+
+	return &forwarder.MinimalForwarderForwardRequest{
+		From:  from,
+		To:    to,
+		Value: value,
+		Gas:   new(big.Int).SetUint64(req.Gas),
+		Nonce: nonce,
+		Data:  data,
+	}, nil
+}
@@ -0,0 +1,290 @@
+// Package service provides business logic services for Safe multisig proposals.
+package service
+
+import (
+	"context"
+
+	pb "eth-contract-service/api/safe/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/safe"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SafeService implements the Safe API service.
+// It collects owner approvals for Safe proposals and executes them once the threshold is met.
+type SafeService struct {
+	pb.UnimplementedSafeServer
+	logger         *log.Helper      // logger for service logging
+	contractClient *contract.Client // client for contract interactions
+}
+
+// NewSafeService creates a new instance of SafeService.
+func NewSafeService(logger log.Logger) *SafeService {
+	return &SafeService{
+		logger:         log.NewHelper(logger),
+		contractClient: contract.NewClient(logger),
+	}
+}
+
+// GetSafeProposal returns a proposal with its collected approvals.
+func (s *SafeService) GetSafeProposal(ctx context.Context, req *pb.GetSafeProposalRequest) (*pb.GetSafeProposalResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.ProposalId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("proposal_id cannot be empty"))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	info, err := s.proposalInfo(ctx, req.ProposalId)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.GetSafeProposalResponse{Proposal: info}, nil
+}
+
+// ListSafeProposals returns proposals, newest first.
+func (s *SafeService) ListSafeProposals(ctx context.Context, req *pb.ListSafeProposalsRequest) (*pb.ListSafeProposalsResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	filter := safe.ListFilter{Status: safe.Status(req.Status)}
+	if req.SafeAddress != "" {
+		if !common.IsHexAddress(req.SafeAddress) {
+			return nil, errors.ToGRPCError(errors.InvalidArgument("invalid safe_address: %s", req.SafeAddress))
+		}
+		filter.SafeAddress = common.HexToAddress(req.SafeAddress).Hex()
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	filter.Offset = (page - 1) * pageSize
+	filter.Limit = pageSize
+
+	proposals, total, err := safe.List(ctx, filter)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list Safe proposals: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to list Safe proposals"))
+	}
+
+	infos := make([]*pb.SafeProposal, 0, len(proposals))
+	for _, p := range proposals {
+		_, sigs, err := safe.Get(ctx, p.ID)
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+		infos = append(infos, toSafeProposal(p, sigs, 0))
+	}
+
+	return &pb.ListSafeProposalsResponse{
+		Proposals: infos,
+		Total:     total,
+	}, nil
+}
+
+// ApproveSafeProposal adds an owner signature over the safeTxHash of a proposal.
+// The signature is either produced by a keystore signer, which AdminAuth only allows with
+// an admin API token, or supplied by an owner that signed elsewhere and verified against
+// the Safe owners. With auto_execute enabled the proposal is executed as soon as the
+// approvals meet the Safe threshold.
+func (s *SafeService) ApproveSafeProposal(ctx context.Context, req *pb.ApproveSafeProposalRequest) (*pb.ApproveSafeProposalResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.ProposalId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("proposal_id cannot be empty"))
+	}
+	if (req.Signer == "") == (req.Signature == "") {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("exactly one of signer and signature must be set"))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	p, _, err := safe.Get(ctx, req.ProposalId)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	// Sign the safeTxHash or take the supplied signature
+	var sig []byte
+	if req.Signer != "" {
+		keySigner, err := resolveSigner(req.Signer)
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+		td, err := p.TypedData()
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+		sig, err = keySigner.SignTypedData(ctx, *td)
+		if err != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to sign safeTxHash"))
+		}
+	} else {
+		sig, err = keystore.ParseSignature(req.Signature)
+		if err != nil {
+			return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInvalidArgument, errors.ErrInvalidSignature.Message))
+		}
+	}
+
+	signer, err := safe.Approve(ctx, p.ID, sig)
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("Safe proposal approval rejected: id=%s, error=%v", p.ID, err)
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("Safe proposal approved: id=%s, safe=%s, signer=%s", p.ID, p.SafeAddress, signer.Hex())
+
+	if safe.GetSettings().AutoExecute {
+		if err := s.autoExecute(ctx, p.ID); err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+	}
+
+	info, err := s.proposalInfo(ctx, p.ID)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.ApproveSafeProposalResponse{
+		Proposal:      info,
+		SignerAddress: signer.Hex(),
+	}, nil
+}
+
+// ExecuteSafeProposal sends execTransaction from the configured executor once the owner
+// threshold is met.
+func (s *SafeService) ExecuteSafeProposal(ctx context.Context, req *pb.ExecuteSafeProposalRequest) (*pb.ExecuteSafeProposalResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.ProposalId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("proposal_id cannot be empty"))
+	}
+
+	// Validate client
+	if err := s.contractClient.ValidateClient(); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	if err := s.execute(ctx, req.ProposalId); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	info, err := s.proposalInfo(ctx, req.ProposalId)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.ExecuteSafeProposalResponse{Proposal: info}, nil
+}
+
+// RejectSafeProposal withdraws a pending proposal. AdminAuth requires an admin API token.
+func (s *SafeService) RejectSafeProposal(ctx context.Context, req *pb.RejectSafeProposalRequest) (*pb.RejectSafeProposalResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if req.ProposalId == "" {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("proposal_id cannot be empty"))
+	}
+
+	p, err := safe.Reject(ctx, req.ProposalId)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	s.logger.WithContext(ctx).Infof("Safe proposal rejected: id=%s, safe=%s, nonce=%d", p.ID, p.SafeAddress, p.SafeNonce)
+
+	_, sigs, err := safe.Get(ctx, p.ID)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	return &pb.RejectSafeProposalResponse{Proposal: toSafeProposal(p, sigs, 0)}, nil
+}
+
+// autoExecute executes a proposal once its approvals meet the Safe threshold
+func (s *SafeService) autoExecute(ctx context.Context, id string) error {
+	p, sigs, err := safe.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	threshold, err := safe.Threshold(ctx, common.HexToAddress(p.SafeAddress))
+	if err != nil {
+		return err
+	}
+	if uint64(len(sigs)) < threshold {
+		return nil
+	}
+	return s.execute(ctx, id)
+}
+
+// execute sends execTransaction for a proposal from the configured executor
+func (s *SafeService) execute(ctx context.Context, id string) error {
+	executor, err := resolveSigner(safe.GetSettings().Executor)
+	if err != nil {
+		return err
+	}
+
+	// Create transaction options
+	auth, err := s.contractClient.CreateSignerTransactOpts(ctx, executor)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to create transaction options: %v", err)
+		return err
+	}
+
+	tx, err := safe.Execute(ctx, id, auth)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to execute Safe proposal: id=%s, error=%v", id, err)
+		return err
+	}
+
+	s.logger.WithContext(ctx).Infof("Safe proposal execution initiated: id=%s, executor=%s, tx=%s",
+		id, executor.Address().Hex(), tx.Hash().Hex())
+	return nil
+}
+
+// proposalInfo loads a proposal with its approvals and the current Safe threshold
+func (s *SafeService) proposalInfo(ctx context.Context, id string) (*pb.SafeProposal, error) {
+	p, sigs, err := safe.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := safe.Threshold(ctx, common.HexToAddress(p.SafeAddress))
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get Safe threshold: safe=%s, error=%v", p.SafeAddress, err)
+		return nil, err
+	}
+	return toSafeProposal(p, sigs, threshold), nil
+}
+
+// toSafeProposal converts a stored proposal to its API representation
+func toSafeProposal(p *safe.Proposal, sigs []*safe.Signature, threshold uint64) *pb.SafeProposal {
+	approvals := make([]string, 0, len(sigs))
+	for _, sig := range sigs {
+		approvals = append(approvals, sig.Signer)
+	}
+	return &pb.SafeProposal{
+		ProposalId:  p.ID,
+		SafeAddress: p.SafeAddress,
+		Operation:   p.Operation,
+		Proposer:    p.Proposer,
+		To:          p.To,
+		Value:       p.Value,
+		Data:        p.Data,
+		SafeNonce:   p.SafeNonce,
+		SafeTxHash:  p.SafeTxHash,
+		Status:      string(p.Status),
+		Approvals:   approvals,
+		Threshold:   threshold,
+		ExecTxHash:  p.ExecTxHash,
+		CreatedAt:   p.CreatedAt.Unix(),
+		UpdatedAt:   p.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,212 @@
+// Package service provides business logic services for off-chain message signing.
+package service
+
+import (
+	"context"
+
+	pb "eth-contract-service/api/signing/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/signing"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SigningService implements the Signing API service.
+// It signs EIP-712 typed data and EIP-191 personal messages with keystore signers
+// and recovers the signers of existing signatures.
+type SigningService struct {
+	pb.UnimplementedSigningServer
+	logger *log.Helper // logger for service logging
+}
+
+// NewSigningService creates a new instance of SigningService.
+func NewSigningService(logger log.Logger) *SigningService {
+	return &SigningService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// SignTypedData signs an EIP-712 typed data document after the signing policies accepted its domain.
+// AdminAuth requires an admin API token because the signer is held by the server.
+func (s *SigningService) SignTypedData(ctx context.Context, req *pb.SignTypedDataRequest) (*pb.SignTypedDataResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate typed data
+	td, err := signing.ParseTypedData(req.TypedData)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInvalidArgument, "invalid typed_data"))
+	}
+	digest, err := signing.HashTypedData(td)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInvalidArgument, "invalid typed_data"))
+	}
+
+	// Resolve signer
+	keySigner, err := resolveSigner(req.Signer)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	signer := keySigner.Address()
+
+	// Apply signing policies
+	if err := signing.Check(ctx, &signing.Request{Kind: signing.KindTypedData, Signer: signer, TypedData: td}); err != nil {
+		s.logger.WithContext(ctx).Warnf("typed data signing rejected: signer=%s, domain=%s, verifying_contract=%s, error=%v",
+			signer.Hex(), td.Domain.Name, td.Domain.VerifyingContract, err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	sig, err := keySigner.SignTypedData(ctx, *td)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to sign typed data"))
+	}
+
+	s.logger.WithContext(ctx).Infof("typed data signed: signer=%s, domain=%s, verifying_contract=%s, primary_type=%s, digest=%s",
+		signer.Hex(), td.Domain.Name, td.Domain.VerifyingContract, td.PrimaryType, digest.Hex())
+
+	return &pb.SignTypedDataResponse{
+		SignerAddress:     signer.Hex(),
+		Signature:         hexutil.Encode(sig),
+		Digest:            digest.Hex(),
+		DomainName:        td.Domain.Name,
+		VerifyingContract: td.Domain.VerifyingContract,
+	}, nil
+}
+
+// SignPersonalMessage signs an EIP-191 personal message after the signing policies accepted it.
+// AdminAuth requires an admin API token because the signer is held by the server.
+func (s *SigningService) SignPersonalMessage(ctx context.Context, req *pb.SignPersonalMessageRequest) (*pb.SignPersonalMessageResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate message
+	message, err := signing.DecodeMessage(req.Message, req.IsHex)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInvalidArgument, "invalid message"))
+	}
+	if len(message) == 0 {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("message cannot be empty"))
+	}
+
+	// Resolve signer
+	keySigner, err := resolveSigner(req.Signer)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	signer := keySigner.Address()
+
+	// Apply signing policies
+	if err := signing.Check(ctx, &signing.Request{Kind: signing.KindPersonalMessage, Signer: signer, Message: message}); err != nil {
+		s.logger.WithContext(ctx).Warnf("personal message signing rejected: signer=%s, error=%v", signer.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	digest := signing.HashPersonalMessage(message)
+	sig, err := keySigner.SignText(ctx, message)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to sign message"))
+	}
+
+	s.logger.WithContext(ctx).Infof("personal message signed: signer=%s, length=%d, digest=%s", signer.Hex(), len(message), digest.Hex())
+
+	return &pb.SignPersonalMessageResponse{
+		SignerAddress: signer.Hex(),
+		Signature:     hexutil.Encode(sig),
+		Digest:        digest.Hex(),
+	}, nil
+}
+
+// VerifySignature checks that a signature over typed data or a personal message was produced by an address.
+// A signature from another address is reported as invalid rather than as an error.
+func (s *SigningService) VerifySignature(ctx context.Context, req *pb.VerifySignatureRequest) (*pb.VerifySignatureResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	// Validate address
+	address, err := validator.ValidateAddress(req.Address, "address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	recovered, digest, err := recoverSigner(req.Signature, req.TypedData, req.Message, req.IsHex)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return &pb.VerifySignatureResponse{
+		Valid:            recovered == address,
+		RecoveredAddress: recovered.Hex(),
+		Digest:           digest.Hex(),
+	}, nil
+}
+
+// RecoverAddress returns the address that produced a signature over typed data or a personal message.
+func (s *SigningService) RecoverAddress(ctx context.Context, req *pb.RecoverAddressRequest) (*pb.RecoverAddressResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	recovered, digest, err := recoverSigner(req.Signature, req.TypedData, req.Message, req.IsHex)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return &pb.RecoverAddressResponse{
+		Address: recovered.Hex(),
+		Digest:  digest.Hex(),
+	}, nil
+}
+
+// resolveSigner returns the keystore signer of a signer name
+func resolveSigner(name string) (keystore.Signer, error) {
+	signer, err := keystore.GetSigner(name)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.CodeFailedPrecondition, errors.ErrSignerNotFound.Message)
+	}
+	return signer, nil
+}
+
+// recoverSigner computes the digest of typed data or a personal message and recovers the
+// address that signed it. Exactly one of typedData and message must be set.
+func recoverSigner(signature, typedData, message string, isHex bool) (common.Address, common.Hash, error) {
+	sig, err := keystore.ParseSignature(signature)
+	if err != nil {
+		return common.Address{}, common.Hash{}, errors.WrapError(err, errors.CodeInvalidArgument, errors.ErrInvalidSignature.Message)
+	}
+
+	var digest common.Hash
+	switch {
+	case typedData != "" && message != "":
+		return common.Address{}, common.Hash{}, errors.InvalidArgument("typed_data and message cannot be used together")
+	case typedData != "":
+		td, err := signing.ParseTypedData(typedData)
+		if err != nil {
+			return common.Address{}, common.Hash{}, errors.WrapError(err, errors.CodeInvalidArgument, "invalid typed_data")
+		}
+		digest, err = signing.HashTypedData(td)
+		if err != nil {
+			return common.Address{}, common.Hash{}, errors.WrapError(err, errors.CodeInvalidArgument, "invalid typed_data")
+		}
+	case message != "":
+		data, err := signing.DecodeMessage(message, isHex)
+		if err != nil {
+			return common.Address{}, common.Hash{}, errors.WrapError(err, errors.CodeInvalidArgument, "invalid message")
+		}
+		digest = signing.HashPersonalMessage(data)
+	default:
+		return common.Address{}, common.Hash{}, errors.InvalidArgument("typed_data or message is required")
+	}
+
+	recovered, err := keystore.RecoverSigner(digest, sig)
+	if err != nil {
+		return common.Address{}, common.Hash{}, errors.WrapError(err, errors.CodeInvalidArgument, errors.ErrInvalidSignature.Message)
+	}
+	return recovered, digest, nil
+}
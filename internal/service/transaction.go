@@ -0,0 +1,165 @@
+// Package service provides business logic services for pending transaction management.
+package service
+
+import (
+	"context"
+
+	pb "eth-contract-service/api/tx/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/txmanager"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TransactionService implements the Transaction API service.
+// It provides methods for speeding up, cancelling and resolving pending transactions.
+type TransactionService struct {
+	pb.UnimplementedTransactionServer
+	logger *log.Helper
+}
+
+// NewTransactionService creates a new instance of TransactionService.
+//
+// Parameters:
+//   - logger: Logger instance for service logging
+//
+// Returns:
+//   - *TransactionService: A new service instance
+func NewTransactionService(logger log.Logger) *TransactionService {
+	return &TransactionService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// SpeedUpTransaction resubmits a pending transaction with the same nonce and bumped fees.
+func (s *TransactionService) SpeedUpTransaction(ctx context.Context, req *pb.SpeedUpTransactionRequest) (*pb.SpeedUpTransactionResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	hash, signer, err := s.parseReplaceRequest(req.TxHash, req.PrivateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	result, err := txmanager.Replace(ctx, hash, signer, txmanager.KindSpeedUp, req.FeeBumpPercent)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to speed up transaction: tx=%s, error=%v", hash.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	s.logger.WithContext(ctx).Infof("transaction sped up: original=%s, replaced=%s, from=%s, nonce=%d, tx=%s",
+		result.Original.Hex(), result.Replaced.Hex(), result.From.Hex(), result.Tx.Nonce(), result.Tx.Hash().Hex())
+
+	resp := &pb.SpeedUpTransactionResponse{
+		TxHash:         result.Tx.Hash().Hex(),
+		OriginalTxHash: result.Original.Hex(),
+		ReplacedTxHash: result.Replaced.Hex(),
+		FromAddress:    result.From.Hex(),
+		Nonce:          result.Tx.Nonce(),
+	}
+	resp.GasPrice, resp.MaxFeePerGas, resp.MaxPriorityFeePerGas = feeFields(result.Tx)
+	return resp, nil
+}
+
+// CancelTransaction replaces a pending transaction with a zero-value self-transfer.
+func (s *TransactionService) CancelTransaction(ctx context.Context, req *pb.CancelTransactionRequest) (*pb.CancelTransactionResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	hash, signer, err := s.parseReplaceRequest(req.TxHash, req.PrivateKey)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	result, err := txmanager.Replace(ctx, hash, signer, txmanager.KindCancel, req.FeeBumpPercent)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to cancel transaction: tx=%s, error=%v", hash.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	s.logger.WithContext(ctx).Infof("transaction cancel submitted: original=%s, replaced=%s, from=%s, nonce=%d, tx=%s",
+		result.Original.Hex(), result.Replaced.Hex(), result.From.Hex(), result.Tx.Nonce(), result.Tx.Hash().Hex())
+
+	resp := &pb.CancelTransactionResponse{
+		TxHash:         result.Tx.Hash().Hex(),
+		OriginalTxHash: result.Original.Hex(),
+		ReplacedTxHash: result.Replaced.Hex(),
+		FromAddress:    result.From.Hex(),
+		Nonce:          result.Tx.Nonce(),
+	}
+	resp.GasPrice, resp.MaxFeePerGas, resp.MaxPriorityFeePerGas = feeFields(result.Tx)
+	return resp, nil
+}
+
+// GetTransactionStatus resolves a transaction and its replacements to the hash that was mined.
+func (s *TransactionService) GetTransactionStatus(ctx context.Context, req *pb.GetTransactionStatusRequest) (*pb.GetTransactionStatusResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	hash, err := validator.ValidateTxHash(req.TxHash, "tx_hash")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	res, err := txmanager.Resolve(ctx, hash)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to resolve transaction: tx=%s, error=%v", hash.Hex(), err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	resp := &pb.GetTransactionStatusResponse{
+		OriginalTxHash: res.Chain.Original.Hex(),
+		Status:         string(res.Status),
+		Replacements:   make([]*pb.ReplacementInfo, 0, len(res.Chain.Replacements)),
+	}
+	if res.Receipt != nil {
+		resp.MinedTxHash = res.MinedHash.Hex()
+		resp.BlockNumber = res.Receipt.BlockNumber.String()
+		resp.GasUsed = res.Receipt.GasUsed
+	}
+	for _, r := range res.Chain.Replacements {
+		resp.Replacements = append(resp.Replacements, &pb.ReplacementInfo{
+			TxHash:         r.TxHash,
+			ReplacedTxHash: r.ReplacedHash,
+			Kind:           string(r.Kind),
+			CreatedAt:      r.CreatedAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
+// parseReplaceRequest validates the transaction hash and private key of a replacement request
+func (s *TransactionService) parseReplaceRequest(txHash, privateKey string) (common.Hash, keystore.Signer, error) {
+	hash, err := validator.ValidateTxHash(txHash, "tx_hash")
+	if err != nil {
+		return common.Hash{}, nil, validator.ToAppError(err)
+	}
+
+	keyBytes, err := validator.ValidatePrivateKey(privateKey)
+	if err != nil {
+		return common.Hash{}, nil, validator.ToAppError(err)
+	}
+	key, err := crypto.ToECDSA(keyBytes)
+	if err != nil {
+		return common.Hash{}, nil, errors.WrapError(err, errors.CodeInvalidArgument, errors.ErrInvalidPrivateKey.Message)
+	}
+
+	return hash, keystore.NewKeySigner(key), nil
+}
+
+// feeFields returns the fee fields of a transaction as decimal strings
+func feeFields(tx *types.Transaction) (gasPrice, maxFeePerGas, maxPriorityFeePerGas string) {
+	if tx.Type() == types.DynamicFeeTxType {
+		return "", tx.GasFeeCap().String(), tx.GasTipCap().String()
+	}
+	return tx.GasPrice().String(), "", ""
+}
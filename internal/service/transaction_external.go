@@ -0,0 +1,226 @@
+// Package service provides business logic services for transactions signed outside of the service.
+package service
+
+import (
+	"context"
+	"strings"
+
+	pb "eth-contract-service/api/tx/v1"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/job"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// BuildUnsignedTransaction builds the transaction of a write operation for an external signer.
+// The operation runs as it would with async=true, except that the transaction is built for
+// from_address with its pending nonce and is returned unsigned instead of being sent.
+func (s *TransactionService) BuildUnsignedTransaction(ctx context.Context, req *pb.BuildUnsignedTransactionRequest) (*pb.BuildUnsignedTransactionResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	fromAddr, err := validator.ValidateAddress(req.FromAddress, "from_address")
+	if err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	opReq, placeholder, err := decodeOperationRequest(req.Operation, req.Request)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.ToGRPCError(errors.NodeUnavailable(eth.RetryAfter(), eth.LastError()))
+	}
+
+	// Build the call for the external signer without signing or sending it
+	capture := contract.NewTxCapture(func(ctx context.Context, from common.Address) (uint64, error) {
+		return client.PendingNonceAt(ctx, from)
+	}).Unsigned(fromAddr)
+	opResp, err := job.Call(contract.WithTxCapture(ctx, capture), req.Operation, opReq)
+	if err != nil {
+		return nil, err
+	}
+	tx := capture.Transaction()
+	if tx == nil {
+		return nil, errors.ToGRPCError(errors.NewError(errors.CodeInternal, "operation did not produce a transaction"))
+	}
+
+	unsigned, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to encode transaction"))
+	}
+	// The response of the operation carries the hash of the unsigned transaction, which
+	// changes once it is signed, and names the placeholder key as sender
+	clearField(opResp, "tx_hash")
+	replaceAddress(opResp, placeholder, fromAddr)
+	result, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(opResp)
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("failed to encode operation result: operation=%s, error=%v", req.Operation, err)
+	}
+
+	chainID := eth.GetChainID()
+	resp := &pb.BuildUnsignedTransactionResponse{
+		FromAddress:         fromAddr.Hex(),
+		Value:               tx.Value().String(),
+		Data:                hexutil.Encode(tx.Data()),
+		Nonce:               tx.Nonce(),
+		Gas:                 tx.Gas(),
+		ChainId:             chainID.String(),
+		Type:                uint32(tx.Type()),
+		SigningHash:         types.LatestSignerForChainID(chainID).Hash(tx).Hex(),
+		UnsignedTransaction: hexutil.Encode(unsigned),
+		Result:              string(result),
+	}
+	if tx.To() != nil {
+		resp.ToAddress = tx.To().Hex()
+	}
+	resp.GasPrice, resp.MaxFeePerGas, resp.MaxPriorityFeePerGas = feeFields(tx)
+
+	s.logger.WithContext(ctx).Infof("unsigned transaction built: operation=%s, from=%s, nonce=%d, signing_hash=%s",
+		req.Operation, fromAddr.Hex(), tx.Nonce(), resp.SigningHash)
+	return resp, nil
+}
+
+// BroadcastRawTransaction broadcasts a transaction signed outside of the service.
+// The transaction is tracked like the transactions sent by the service: it shows up in
+// the transaction metrics, its status can be resolved with GetTransactionStatus and, when
+// jobs are enabled, it is recorded as a job that is settled by receipt.
+func (s *TransactionService) BroadcastRawTransaction(ctx context.Context, req *pb.BroadcastRawTransactionRequest) (*pb.BroadcastRawTransactionResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	raw, err := hexutil.Decode(strings.TrimSpace(req.RawTransaction))
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("invalid raw_transaction: %v", err))
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("invalid raw_transaction: %v", err))
+	}
+
+	chainID := eth.GetChainID()
+	if chainID == nil {
+		return nil, errors.ToGRPCError(errors.ErrChainIDNotConfigured)
+	}
+	if !tx.Protected() {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("transaction is not replay protected, sign it with chain ID %s", chainID))
+	}
+	if tx.ChainId().Cmp(chainID) != 0 {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("transaction chain ID %s does not match chain ID %s", tx.ChainId(), chainID))
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, errors.ToGRPCError(errors.InvalidArgument("invalid transaction signature: %v", err))
+	}
+
+	if err := eth.SendTransaction(ctx, tx); err != nil && !eth.IsKnownTransaction(err) {
+		if !eth.Connected() {
+			return nil, errors.ToGRPCError(errors.NodeUnavailable(eth.RetryAfter(), eth.LastError()))
+		}
+		s.logger.WithContext(ctx).Errorf("failed to broadcast raw transaction: from=%s, nonce=%d, tx=%s, error=%v",
+			from.Hex(), tx.Nonce(), tx.Hash().Hex(), err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeFailedPrecondition, "failed to broadcast transaction"))
+	}
+	// The sender may also send through the service, whose local nonce no longer matches
+	eth.ResetNonce(from)
+
+	s.logger.WithContext(ctx).Infof("raw transaction broadcast: from=%s, nonce=%d, tx=%s", from.Hex(), tx.Nonce(), tx.Hash().Hex())
+
+	resp := &pb.BroadcastRawTransactionResponse{
+		TxHash:      tx.Hash().Hex(),
+		FromAddress: from.Hex(),
+		Value:       tx.Value().String(),
+		Nonce:       tx.Nonce(),
+	}
+	if tx.To() != nil {
+		resp.ToAddress = tx.To().Hex()
+	}
+	resp.GasPrice, resp.MaxFeePerGas, resp.MaxPriorityFeePerGas = feeFields(tx)
+
+	// Record the transaction as a job so that its status can be queried with GetJob; a
+	// retry after a failure here broadcasts the same transaction again and records it then
+	if job.Enabled() {
+		j, err := job.Track(ctx, pb.OperationTransactionBroadcastRawTransaction, from, tx, resp)
+		if err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to record raw transaction: tx=%s, error=%v", tx.Hash().Hex(), err)
+			return nil, errors.ToGRPCError(err)
+		}
+		resp.JobId = j.ID
+	}
+	return resp, nil
+}
+
+// decodeOperationRequest decodes the request of a write operation for an unsigned build.
+// Requests that are signed with a request key get a throwaway key, which only satisfies
+// the validation of the operation; the transaction is built for the external signer.
+// The address of the throwaway key is returned, or the zero address when none was set.
+func decodeOperationRequest(operation string, request *structpb.Struct) (proto.Message, common.Address, error) {
+	opReq, ok := job.NewRequest(operation)
+	if !ok {
+		return nil, common.Address{}, errors.InvalidArgument("operation %s cannot be built for an external signer", operation)
+	}
+	if request != nil {
+		data, err := protojson.Marshal(request)
+		if err != nil {
+			return nil, common.Address{}, errors.InvalidArgument("invalid request: %v", err)
+		}
+		if err := protojson.Unmarshal(data, opReq); err != nil {
+			return nil, common.Address{}, errors.InvalidArgument("invalid request for %s: %v", operation, err)
+		}
+	}
+
+	msg := opReq.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	if fd := fields.ByName("async"); fd != nil && fd.Kind() == protoreflect.BoolKind && msg.Get(fd).Bool() {
+		return nil, common.Address{}, errors.InvalidArgument("async cannot be combined with an unsigned build")
+	}
+	keyField, useAdmin := fields.ByName("private_key"), fields.ByName("use_admin")
+	if keyField == nil || keyField.Kind() != protoreflect.StringKind || msg.Get(keyField).String() != "" {
+		return opReq, common.Address{}, nil
+	}
+	if useAdmin != nil && useAdmin.Kind() == protoreflect.BoolKind && msg.Get(useAdmin).Bool() {
+		return opReq, common.Address{}, nil
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, common.Address{}, errors.WrapError(err, errors.CodeInternal, "failed to generate placeholder key")
+	}
+	msg.Set(keyField, protoreflect.ValueOfString(hexutil.Encode(crypto.FromECDSA(key))))
+	return opReq, crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+// replaceAddress replaces an address in the string fields of a message
+func replaceAddress(m proto.Message, old, replacement common.Address) {
+	if old == (common.Address{}) {
+		return
+	}
+	msg := m.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() &&
+			strings.EqualFold(msg.Get(fd).String(), old.Hex()) {
+			msg.Set(fd, protoreflect.ValueOfString(replacement.Hex()))
+		}
+	}
+}
+
+// clearField clears a string field of a message if it has one
+func clearField(m proto.Message, name protoreflect.Name) {
+	msg := m.ProtoReflect()
+	if fd := msg.Descriptor().Fields().ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind {
+		msg.Clear(fd)
+	}
+}
@@ -0,0 +1,134 @@
+// Package service provides business logic services for signer balance monitoring.
+package service
+
+import (
+	"context"
+	"math/big"
+
+	pb "eth-contract-service/api/treasury/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/treasury"
+	"eth-contract-service/internal/validator"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TreasuryService implements the Treasury API service.
+// It reports the signer balances checked by the treasury monitor and its top-ups.
+type TreasuryService struct {
+	pb.UnimplementedTreasuryServer
+	logger *log.Helper // logger for service logging
+}
+
+// NewTreasuryService creates a new instance of TreasuryService.
+func NewTreasuryService(logger log.Logger) *TreasuryService {
+	return &TreasuryService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// GetSignerBalances returns the last checked signer balances, or checks them now when
+// refresh is set.
+func (s *TreasuryService) GetSignerBalances(ctx context.Context, req *pb.GetSignerBalancesRequest) (*pb.GetSignerBalancesResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if !treasury.Enabled() {
+		return nil, errors.ToGRPCError(errors.ErrTreasuryDisabled)
+	}
+
+	balances := treasury.Balances()
+	if req.Refresh {
+		var err error
+		if balances, err = treasury.Refresh(ctx); err != nil {
+			s.logger.WithContext(ctx).Errorf("failed to check signer balances: %v", err)
+			return nil, errors.ToGRPCError(err)
+		}
+	}
+	remaining, err := treasury.DailyTopUpRemaining(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to get remaining daily top-up: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to get remaining daily top-up"))
+	}
+
+	settings := treasury.GetSettings()
+	resp := &pb.GetSignerBalancesResponse{
+		Balances:            make([]*pb.SignerBalance, 0, len(balances)),
+		FundingSigner:       settings.FundingSigner,
+		DailyTopUpCap:       weiString(settings.DailyTopUpCap),
+		DailyTopUpRemaining: weiString(remaining),
+	}
+	for _, b := range balances {
+		resp.Balances = append(resp.Balances, toSignerBalance(b))
+	}
+	return resp, nil
+}
+
+// ListTopUps returns the top-ups sent by the funding signer, newest first.
+func (s *TreasuryService) ListTopUps(ctx context.Context, req *pb.ListTopUpsRequest) (*pb.ListTopUpsResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	switch treasury.TopUpStatus(req.Status) {
+	case "", treasury.TopUpPending, treasury.TopUpConfirmed, treasury.TopUpFailed:
+	default:
+		return nil, errors.ToGRPCError(errors.InvalidArgument("invalid status: %s", req.Status))
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	topUps, total, err := treasury.ListTopUps(ctx, treasury.ListFilter{
+		Signer: req.Signer,
+		Status: treasury.TopUpStatus(req.Status),
+		Offset: (page - 1) * pageSize,
+		Limit:  pageSize,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list top-ups: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to list top-ups"))
+	}
+
+	infos := make([]*pb.TopUp, 0, len(topUps))
+	for _, t := range topUps {
+		infos = append(infos, &pb.TopUp{
+			Id:        t.ID,
+			Signer:    t.Signer,
+			Address:   t.Address,
+			Funder:    t.Funder,
+			Amount:    t.Amount,
+			TxHash:    t.TxHash,
+			Status:    string(t.Status),
+			Error:     t.Error,
+			CreatedAt: t.CreatedAt.Unix(),
+		})
+	}
+	return &pb.ListTopUpsResponse{
+		TopUps: infos,
+		Total:  total,
+	}, nil
+}
+
+// toSignerBalance converts a checked balance to its API representation
+func toSignerBalance(b *treasury.Balance) *pb.SignerBalance {
+	info := &pb.SignerBalance{
+		Signer:        b.Signer,
+		Balance:       weiString(b.Balance),
+		MinBalance:    weiString(b.MinBalance),
+		TargetBalance: weiString(b.TargetBalance),
+		Low:           b.Low,
+		Error:         b.Error,
+		CheckedAt:     b.CheckedAt.Unix(),
+	}
+	if b.Address != (common.Address{}) {
+		info.Address = b.Address.Hex()
+	}
+	return info
+}
+
+// weiString formats an optional wei amount, empty when nil
+func weiString(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
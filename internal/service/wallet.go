@@ -0,0 +1,98 @@
+// Package service provides business logic services for HD wallet accounts.
+package service
+
+import (
+	"context"
+	"strconv"
+
+	pb "eth-contract-service/api/wallet/v1"
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/internal/validator"
+	"eth-contract-service/internal/wallet"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WalletService implements the Wallet API service.
+// It derives HD wallet accounts on demand and lists the accounts handed out so far.
+type WalletService struct {
+	pb.UnimplementedWalletServer
+	logger *log.Helper // logger for service logging
+}
+
+// NewWalletService creates a new instance of WalletService.
+func NewWalletService(logger log.Logger) *WalletService {
+	return &WalletService{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// DeriveAddress derives the account at the requested index, or at the next unused index,
+// and records it with its label.
+func (s *WalletService) DeriveAddress(ctx context.Context, req *pb.DeriveAddressRequest) (*pb.DeriveAddressResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+
+	var (
+		account *wallet.Account
+		err     error
+	)
+	if req.Next {
+		account, err = wallet.DeriveNext(ctx, req.Label)
+	} else {
+		account, err = wallet.Derive(ctx, req.Index, req.Label)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to derive HD account: index=%d, next=%t, error=%v", req.Index, req.Next, err)
+		return nil, errors.ToGRPCError(err)
+	}
+
+	s.logger.WithContext(ctx).Infof("HD account derived: index=%d, address=%s, label=%s", account.Index, account.Address, account.Label)
+
+	return &pb.DeriveAddressResponse{Account: toDerivedAccount(account)}, nil
+}
+
+// ListDerivedAccounts returns the recorded HD wallet accounts ordered by index.
+func (s *WalletService) ListDerivedAccounts(ctx context.Context, req *pb.ListDerivedAccountsRequest) (*pb.ListDerivedAccountsResponse, error) {
+	if err := validator.ValidateRequest(req); err != nil {
+		return nil, errors.ToGRPCError(validator.ToAppError(err))
+	}
+	if !keystore.IsHDInitialized() {
+		return nil, errors.ToGRPCError(errors.ErrHDWalletDisabled)
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	accounts, total, err := wallet.List(ctx, wallet.ListFilter{
+		Label:  req.Label,
+		Offset: (page - 1) * pageSize,
+		Limit:  pageSize,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("failed to list HD accounts: %v", err)
+		return nil, errors.ToGRPCError(errors.WrapError(err, errors.CodeInternal, "failed to list HD accounts"))
+	}
+
+	infos := make([]*pb.DerivedAccount, 0, len(accounts))
+	for _, a := range accounts {
+		infos = append(infos, toDerivedAccount(a))
+	}
+
+	return &pb.ListDerivedAccountsResponse{
+		Accounts: infos,
+		Total:    total,
+	}, nil
+}
+
+// toDerivedAccount converts a recorded account to its API representation
+func toDerivedAccount(a *wallet.Account) *pb.DerivedAccount {
+	return &pb.DerivedAccount{
+		Index:     a.Index,
+		Address:   a.Address,
+		Path:      a.Path,
+		Signer:    keystore.SignerHDPrefix + strconv.FormatUint(uint64(a.Index), 10),
+		Label:     a.Label,
+		CreatedAt: a.CreatedAt.Unix(),
+	}
+}
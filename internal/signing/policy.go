@@ -0,0 +1,119 @@
+package signing
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Kind is the kind of payload a signature is requested for
+type Kind string
+
+const (
+	// KindTypedData is an EIP-712 typed data signature
+	KindTypedData Kind = "typed_data"
+	// KindPersonalMessage is an EIP-191 personal message signature
+	KindPersonalMessage Kind = "personal_message"
+)
+
+// Request describes a signature request checked by the policies
+type Request struct {
+	// Kind is the kind of payload
+	Kind Kind
+	// Signer is the address of the key that would sign
+	Signer common.Address
+	// TypedData is the typed data for KindTypedData
+	TypedData *apitypes.TypedData
+	// Message is the raw message for KindPersonalMessage
+	Message []byte
+}
+
+// Policy decides whether a signature request may be signed.
+// A non-nil error rejects the request.
+type Policy func(ctx context.Context, req *Request) error
+
+var (
+	// policies holds the registered policies by name
+	policies = make(map[string]Policy)
+	// policyOrder keeps the registration order of policies
+	policyOrder []string
+	policiesMu  sync.RWMutex
+)
+
+// RegisterPolicy registers a policy that every signature request must pass.
+// Registering a name again replaces the previous policy.
+func RegisterPolicy(name string, policy Policy) {
+	policiesMu.Lock()
+	defer policiesMu.Unlock()
+	if _, ok := policies[name]; !ok {
+		policyOrder = append(policyOrder, name)
+	}
+	policies[name] = policy
+}
+
+// Check runs the registered policies in registration order and returns the first rejection
+func Check(ctx context.Context, req *Request) error {
+	policiesMu.RLock()
+	defer policiesMu.RUnlock()
+	for _, name := range policyOrder {
+		if err := policies[name](ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// allowlist is the built-in policy applying the configured allow-lists
+func allowlist(_ context.Context, req *Request) error {
+	switch req.Kind {
+	case KindPersonalMessage:
+		if !GetSettings().AllowPersonalMessages {
+			return appErrors.ErrSigningNotAllowed
+		}
+		return nil
+	case KindTypedData:
+		return checkDomain(req.TypedData.Domain)
+	default:
+		return appErrors.ErrSigningNotAllowed
+	}
+}
+
+// checkDomain checks an EIP-712 domain against the allow-lists and the configured chain
+func checkDomain(domain apitypes.TypedDataDomain) error {
+	settings := GetSettings()
+	if len(settings.AllowedDomains) == 0 && len(settings.AllowedVerifyingContracts) == 0 {
+		return appErrors.ErrSigningNotAllowed
+	}
+	if len(settings.AllowedDomains) > 0 && !settings.AllowedDomains[domain.Name] {
+		return appErrors.WrapError(appErrors.ErrSigningNotAllowed, appErrors.CodePermissionDenied,
+			"EIP-712 domain "+domain.Name+" is not allow-listed")
+	}
+	if len(settings.AllowedVerifyingContracts) > 0 {
+		if !common.IsHexAddress(domain.VerifyingContract) ||
+			!settings.AllowedVerifyingContracts[common.HexToAddress(domain.VerifyingContract)] {
+			return appErrors.WrapError(appErrors.ErrSigningNotAllowed, appErrors.CodePermissionDenied,
+				"verifying contract "+domain.VerifyingContract+" is not allow-listed")
+		}
+	}
+
+	// A signature without a chain, or for another chain, could be replayed there
+	if domain.ChainId == nil {
+		return appErrors.WrapError(appErrors.ErrSigningNotAllowed, appErrors.CodePermissionDenied,
+			"EIP-712 domain chainId is required")
+	}
+	chainID := (*big.Int)(domain.ChainId)
+	if configured := eth.GetChainID(); configured != nil && chainID.Cmp(configured) == 0 {
+		return nil
+	}
+	if !chainID.IsUint64() || !settings.AllowedChainIDs[chainID.Uint64()] {
+		return appErrors.WrapError(appErrors.ErrSigningNotAllowed, appErrors.CodePermissionDenied,
+			"EIP-712 domain chainId "+chainID.String()+" does not match the configured chain")
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Package signing hashes, signs and recovers EIP-712 typed data and EIP-191 personal messages.
+// Every signature request is checked against the registered policies before a key is used.
+package signing
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Settings holds the effective signing policy settings
+type Settings struct {
+	// AllowedDomains are the EIP-712 domain names that may be signed
+	AllowedDomains map[string]bool
+	// AllowedVerifyingContracts are the EIP-712 verifying contracts that may be signed
+	AllowedVerifyingContracts map[common.Address]bool
+	// AllowPersonalMessages enables EIP-191 personal message signing
+	AllowPersonalMessages bool
+	// AllowedChainIDs are the EIP-712 domain chain IDs that may be signed besides the configured chain
+	AllowedChainIDs map[uint64]bool
+}
+
+var (
+	// settings stores the effective signing policy settings
+	settings = Settings{
+		AllowedDomains:            make(map[string]bool),
+		AllowedVerifyingContracts: make(map[common.Address]bool),
+		AllowedChainIDs:           make(map[uint64]bool),
+	}
+	// settingsMu guards settings, which can be replaced by a configuration reload
+	settingsMu sync.RWMutex
+	// initOnce ensures the settings are applied only once
+	initOnce sync.Once
+)
+
+// Init applies the signing policy settings and registers the allow-list policy.
+// Typed data signing stays disabled until at least one domain or verifying contract
+// is allow-listed.
+//
+// Parameters:
+//   - cfg: Signing configuration (optional)
+//   - logger: Logger instance for signing logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Init(cfg *conf.Signing, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		s, err := newSettings(cfg)
+		if err != nil {
+			initErr = err
+			return
+		}
+		setSettings(s)
+
+		RegisterPolicy("allowlist", allowlist)
+
+		log.NewHelper(logger).Infof("signing policy initialized: domains=%d, verifying_contracts=%d, personal_messages=%t",
+			len(s.AllowedDomains), len(s.AllowedVerifyingContracts), s.AllowPersonalMessages)
+	})
+	return initErr
+}
+
+// Validate checks a signing configuration without applying it
+func Validate(cfg *conf.Signing) error {
+	_, err := newSettings(cfg)
+	return err
+}
+
+// Reload validates and applies a changed signing configuration.
+// The allow-lists are only replaced when the whole section is valid.
+//
+// Parameters:
+//   - cfg: Signing configuration (optional)
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Reload(cfg *conf.Signing) error {
+	s, err := newSettings(cfg)
+	if err != nil {
+		return err
+	}
+	setSettings(s)
+	return nil
+}
+
+// GetSettings returns the effective signing policy settings
+func GetSettings() Settings {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return settings
+}
+
+// setSettings replaces the effective signing policy settings
+func setSettings(s Settings) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	settings = s
+}
+
+// newSettings builds signing policy settings from the configuration
+func newSettings(cfg *conf.Signing) (Settings, error) {
+	s := Settings{
+		AllowedDomains:            make(map[string]bool),
+		AllowedVerifyingContracts: make(map[common.Address]bool),
+		AllowPersonalMessages:     cfg.GetAllowPersonalMessages(),
+		AllowedChainIDs:           make(map[uint64]bool),
+	}
+	for _, name := range cfg.GetAllowedDomains() {
+		if name = strings.TrimSpace(name); name != "" {
+			s.AllowedDomains[name] = true
+		}
+	}
+	for _, addr := range cfg.GetAllowedVerifyingContracts() {
+		if !common.IsHexAddress(addr) {
+			return Settings{}, errors.Errorf("invalid signing.allowed_verifying_contracts entry: %s", addr)
+		}
+		s.AllowedVerifyingContracts[common.HexToAddress(addr)] = true
+	}
+	for _, id := range cfg.GetAllowedChainIds() {
+		s.AllowedChainIDs[id] = true
+	}
+	return s, nil
+}
+
+// ParseTypedData decodes an EIP-712 JSON document as accepted by eth_signTypedData_v4
+func ParseTypedData(data string) (*apitypes.TypedData, error) {
+	var td apitypes.TypedData
+	if err := json.Unmarshal([]byte(data), &td); err != nil {
+		return nil, errors.Wrap(err, "failed to decode typed data")
+	}
+	if td.PrimaryType == "" {
+		return nil, errors.New("typed data primaryType is required")
+	}
+	if _, ok := td.Types["EIP712Domain"]; !ok {
+		return nil, errors.New("typed data types must include EIP712Domain")
+	}
+	return &td, nil
+}
+
+// HashTypedData returns the EIP-712 digest of typed data
+func HashTypedData(td *apitypes.TypedData) (common.Hash, error) {
+	digest, _, err := apitypes.TypedDataAndHash(*td)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to hash typed data")
+	}
+	return common.BytesToHash(digest), nil
+}
+
+// HashPersonalMessage returns the EIP-191 version 0x45 digest of a message
+func HashPersonalMessage(message []byte) common.Hash {
+	return common.BytesToHash(accounts.TextHash(message))
+}
+
+// DecodeMessage returns the bytes of a personal message.
+// Hex messages must be 0x prefixed, other messages are signed as UTF-8 text.
+func DecodeMessage(message string, isHex bool) ([]byte, error) {
+	if !isHex {
+		return []byte(message), nil
+	}
+	data, err := hexutil.Decode(message)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid hex message")
+	}
+	return data, nil
+}
@@ -0,0 +1,113 @@
+package treasury
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// webhookTimeout bounds the delivery of an alert to the webhook
+const webhookTimeout = 10 * time.Second
+
+// Event identifies the kind of an alert
+type Event string
+
+const (
+	// EventLowBalance fires when a signer drops below its minimum balance
+	EventLowBalance Event = "low_balance"
+	// EventBalanceRecovered fires when a low signer is back above its minimum balance
+	EventBalanceRecovered Event = "balance_recovered"
+	// EventTopUpSent fires when a top-up transaction was sent
+	EventTopUpSent Event = "top_up_sent"
+	// EventTopUpFailed fires when a top-up could not be sent or reverted
+	EventTopUpFailed Event = "top_up_failed"
+	// EventDailyCapReached fires once per UTC day when the daily top-up cap is used up
+	EventDailyCapReached Event = "daily_cap_reached"
+	// EventFundingInsufficient fires once per UTC day when the funding signer cannot pay a top-up
+	EventFundingInsufficient Event = "funding_insufficient"
+)
+
+// Alert is a treasury event delivered to the log, the metrics and the webhook
+type Alert struct {
+	Event     Event  `json:"event"`
+	Signer    string `json:"signer"`
+	Address   string `json:"address,omitempty"`
+	Balance   string `json:"balance,omitempty"`   // wei
+	Threshold string `json:"threshold,omitempty"` // wei
+	Amount    string `json:"amount,omitempty"`    // wei
+	TxHash    string `json:"tx_hash,omitempty"`
+	Message   string `json:"message"`
+	Time      int64  `json:"time"`
+}
+
+var (
+	// webhookClient delivers alerts to the webhook
+	webhookClient = &http.Client{Timeout: webhookTimeout}
+	// notifiedOn stores the UTC day a once-per-day alert was last sent, by event and signer
+	notifiedOn = make(map[string]string)
+	// notifiedMu guards notifiedOn
+	notifiedMu sync.Mutex
+)
+
+// notify logs an alert, counts it and posts it to the webhook in the background
+func notify(a Alert) {
+	a.Time = time.Now().Unix()
+	switch a.Event {
+	case EventBalanceRecovered, EventTopUpSent:
+		logger.Infof("treasury %s: signer=%s, address=%s, balance=%s, threshold=%s, amount=%s, tx=%s: %s",
+			a.Event, a.Signer, a.Address, a.Balance, a.Threshold, a.Amount, a.TxHash, a.Message)
+	default:
+		logger.Warnf("treasury %s: signer=%s, address=%s, balance=%s, threshold=%s, amount=%s, tx=%s: %s",
+			a.Event, a.Signer, a.Address, a.Balance, a.Threshold, a.Amount, a.TxHash, a.Message)
+	}
+	alertsTotal.Add(context.Background(), 1, metric.WithAttributes(attribute.String("event", string(a.Event))))
+
+	webhookURL := GetSettings().AlertWebhookURL
+	if webhookURL == "" {
+		return
+	}
+	go func() {
+		if err := postWebhook(webhookURL, a); err != nil {
+			logger.Errorf("failed to deliver treasury alert %s to webhook: %v", a.Event, err)
+		}
+	}()
+}
+
+// notifyOncePerDay sends an alert unless the same event was sent for the signer today
+func notifyOncePerDay(a Alert) {
+	key := string(a.Event) + ":" + a.Signer
+	day := time.Now().UTC().Format("20060102")
+
+	notifiedMu.Lock()
+	if notifiedOn[key] == day {
+		notifiedMu.Unlock()
+		return
+	}
+	notifiedOn[key] = day
+	notifiedMu.Unlock()
+	notify(a)
+}
+
+// postWebhook posts an alert as JSON
+func postWebhook(webhookURL string, a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	resp, err := webhookClient.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
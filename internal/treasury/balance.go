@@ -0,0 +1,136 @@
+package treasury
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Balance is the last checked native balance of a monitored signer
+type Balance struct {
+	Threshold
+	// Signer is the signer name, e.g. admin or role:erc721-minter
+	Signer string
+	// Address is the signer address, zero when the signer could not be resolved
+	Address common.Address
+	// Balance is the balance in wei, nil when the check failed
+	Balance *big.Int
+	// Low reports whether the balance is below the minimum balance
+	Low bool
+	// Error is why the check failed (optional)
+	Error string
+	// CheckedAt is the time of the check
+	CheckedAt time.Time
+}
+
+var (
+	// balances stores the last checked balance of each monitored signer by signer name
+	balances = make(map[string]*Balance)
+	// balancesMu guards balances
+	balancesMu sync.RWMutex
+)
+
+// Balances returns the last checked balances ordered by signer name
+func Balances() []*Balance {
+	balancesMu.RLock()
+	defer balancesMu.RUnlock()
+
+	list := make([]*Balance, 0, len(balances))
+	for _, b := range balances {
+		c := *b
+		list = append(list, &c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Signer < list[j].Signer })
+	return list
+}
+
+// Refresh checks the balances of the monitored signers and alerts on signers that
+// dropped below or recovered above their minimum balance. The monitored signers are the
+// configured signers, the admin keystore, the signers listed in the thresholds and the
+// funding signer.
+//
+// Parameters:
+//   - ctx: Context for the balance queries
+//
+// Returns:
+//   - []*Balance: The checked balances ordered by signer name
+//   - error: Error if the Ethereum client is not connected
+func Refresh(ctx context.Context) ([]*Balance, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, appErrors.NodeUnavailable(eth.RetryAfter(), eth.LastError())
+	}
+	s := GetSettings()
+
+	checked := make(map[string]*Balance)
+	for name, addr := range monitoredSigners(s) {
+		b := &Balance{Threshold: s.ThresholdOf(name), Signer: name, Address: addr, CheckedAt: time.Now()}
+		checked[name] = b
+		if addr == (common.Address{}) {
+			b.Error = "signer not available"
+			continue
+		}
+		balance, err := client.BalanceAt(ctx, addr, nil)
+		if err != nil {
+			b.Error = err.Error()
+			continue
+		}
+		b.Balance = balance
+		b.Low = b.MinBalance != nil && balance.Cmp(b.MinBalance) < 0
+	}
+
+	balancesMu.Lock()
+	previous := balances
+	balances = checked
+	balancesMu.Unlock()
+
+	for name, b := range checked {
+		wasLow := previous[name] != nil && previous[name].Low
+		switch {
+		case b.Balance == nil:
+			// Keep the previous state until the balance can be read again
+			b.Low = wasLow
+		case b.Low && !wasLow:
+			notify(Alert{Event: EventLowBalance, Signer: name, Address: b.Address.Hex(),
+				Balance: b.Balance.String(), Threshold: b.MinBalance.String(),
+				Message: "signer balance below minimum"})
+		case !b.Low && wasLow:
+			notify(Alert{Event: EventBalanceRecovered, Signer: name, Address: b.Address.Hex(),
+				Balance: b.Balance.String(), Threshold: b.MinBalance.String(),
+				Message: "signer balance back above minimum"})
+		}
+	}
+	return Balances(), nil
+}
+
+// monitoredSigners resolves the addresses of the monitored signers by signer name.
+// A signer that cannot be resolved, e.g. a locked managed key, maps to the zero address.
+func monitoredSigners(s Settings) map[string]common.Address {
+	addrs := keystore.SignerAddresses()
+	names := make([]string, 0, len(s.Thresholds)+1)
+	for name := range s.Thresholds {
+		names = append(names, name)
+	}
+	if s.FundingSigner != "" {
+		names = append(names, s.FundingSigner)
+	}
+	for _, name := range names {
+		if _, ok := addrs[name]; ok {
+			continue
+		}
+		if signer, err := keystore.GetSigner(name); err == nil {
+			addrs[name] = signer.Address()
+		} else {
+			addrs[name] = common.Address{}
+		}
+	}
+	return addrs
+}
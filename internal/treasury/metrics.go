@@ -0,0 +1,76 @@
+package treasury
+
+import (
+	"context"
+	"math/big"
+
+	"eth-contract-service/provider/metrics"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+var (
+	// alertsTotal counts alerts by event
+	alertsTotal metric.Int64Counter = noop.Int64Counter{}
+	// topUpsTotal counts top-ups by signer and status
+	topUpsTotal metric.Int64Counter = noop.Int64Counter{}
+	// topUpEther sums the ether sent by top-ups by signer
+	topUpEther metric.Float64Counter = noop.Float64Counter{}
+)
+
+// initMetrics creates the treasury instruments; they stay no-ops while metrics are disabled
+func initMetrics() error {
+	meter := metrics.Meter()
+
+	var err error
+	if alertsTotal, err = meter.Int64Counter("treasury_alerts_total",
+		metric.WithDescription("Treasury alerts by event")); err != nil {
+		return errors.Wrap(err, "failed to create treasury metrics")
+	}
+	if topUpsTotal, err = meter.Int64Counter("treasury_top_ups_total",
+		metric.WithDescription("Top-ups from the funding signer by signer and status")); err != nil {
+		return errors.Wrap(err, "failed to create treasury metrics")
+	}
+	if topUpEther, err = meter.Float64Counter("treasury_top_up_ether_total",
+		metric.WithDescription("Ether sent by top-ups by signer")); err != nil {
+		return errors.Wrap(err, "failed to create treasury metrics")
+	}
+
+	balance, _ := meter.Float64ObservableGauge("treasury_signer_balance_ether",
+		metric.WithDescription("Last checked native balance of monitored signers"))
+	minBalance, _ := meter.Float64ObservableGauge("treasury_signer_min_balance_ether",
+		metric.WithDescription("Alert threshold of monitored signers"))
+	low, _ := meter.Int64ObservableGauge("treasury_signer_low_balance",
+		metric.WithDescription("Whether a monitored signer is below its minimum balance"))
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, b := range Balances() {
+			attrs := metric.WithAttributes(attribute.String("signer", b.Signer), attribute.String("address", b.Address.Hex()))
+			if b.Balance != nil {
+				o.ObserveFloat64(balance, toEther(b.Balance), attrs)
+			}
+			if b.MinBalance != nil {
+				o.ObserveFloat64(minBalance, toEther(b.MinBalance), attrs)
+			}
+			var v int64
+			if b.Low {
+				v = 1
+			}
+			o.ObserveInt64(low, v, attrs)
+		}
+		return nil
+	}, balance, minBalance, low)
+	if err != nil {
+		return errors.Wrap(err, "failed to register treasury balance metrics")
+	}
+	return nil
+}
+
+// toEther converts a wei amount to ether for metrics
+func toEther(wei *big.Int) float64 {
+	v, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Float64()
+	return v
+}
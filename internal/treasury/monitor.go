@@ -0,0 +1,105 @@
+package treasury
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/tracing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var _ transport.Server = (*Monitor)(nil)
+
+// Monitor runs the periodic balance checks and top-ups.
+// It implements transport.Server so that its lifecycle is managed by the kratos app.
+// It keeps running while monitoring is disabled, so that a configuration reload can
+// enable it.
+type Monitor struct {
+	logger *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewMonitor creates a treasury monitor.
+//
+// Parameters:
+//   - logger: Logger instance for monitor logging
+//
+// Returns:
+//   - *Monitor: A monitor ready to be started
+func NewMonitor(logger log.Logger) *Monitor {
+	return &Monitor{
+		logger: log.NewHelper(logger),
+	}
+}
+
+// Start launches the check loop.
+// It returns immediately; the loop runs until Stop is called.
+func (m *Monitor) Start(ctx context.Context) error {
+	ctx, m.cancel = context.WithCancel(context.Background())
+	m.wg.Add(1)
+	go m.run(ctx)
+
+	m.logger.Infof("treasury monitor started: enabled=%t", Enabled())
+	return nil
+}
+
+// Stop stops the check loop and waits for a running check to finish.
+func (m *Monitor) Stop(ctx context.Context) error {
+	if m.cancel == nil {
+		return nil
+	}
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		m.logger.Infof("treasury monitor stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run checks the balances every check interval until the context is cancelled
+func (m *Monitor) run(ctx context.Context) {
+	defer m.wg.Done()
+
+	for {
+		s := GetSettings()
+		if s.Enabled && eth.Connected() {
+			m.check(ctx, s.CheckInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.CheckInterval):
+		}
+	}
+}
+
+// check runs one balance check bounded by the check interval
+func (m *Monitor) check(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ctx, span := tracing.Start(ctx, "treasury.Check")
+	defer span.End()
+
+	if err := Check(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		m.logger.WithContext(ctx).Errorf("treasury check failed: %v", err)
+	}
+}
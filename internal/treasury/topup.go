@@ -0,0 +1,371 @@
+package treasury
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/cache"
+	"eth-contract-service/provider/db"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// lockKey is the Redis key that lets a single instance send top-ups per check interval
+const lockKey = "treasury:top-up-lock"
+
+// TopUpStatus represents the state of a top-up
+type TopUpStatus string
+
+const (
+	// TopUpPending means the top-up transaction was sent and is not mined yet
+	TopUpPending TopUpStatus = "pending"
+	// TopUpConfirmed means the top-up transaction was mined successfully
+	TopUpConfirmed TopUpStatus = "confirmed"
+	// TopUpFailed means the top-up was never broadcast, reverted or was dropped
+	TopUpFailed TopUpStatus = "failed"
+)
+
+// TopUp is a transfer from the funding signer to a low signer
+type TopUp struct {
+	ID        uint64      `gorm:"primaryKey" json:"id"`
+	Signer    string      `gorm:"size:128;index" json:"signer"`
+	Address   string      `gorm:"size:42;index" json:"address"`
+	Funder    string      `gorm:"size:42" json:"funder"`
+	Amount    string      `gorm:"size:78" json:"amount"` // wei
+	Nonce     uint64      `json:"nonce"`
+	TxHash    string      `gorm:"size:66" json:"tx_hash"`
+	Status    TopUpStatus `gorm:"size:16;index" json:"status"`
+	Error     string      `gorm:"size:512" json:"error"`
+	CreatedAt time.Time   `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// TableName returns the table name for top-ups
+func (TopUp) TableName() string {
+	return "treasury_top_ups"
+}
+
+// AmountInt returns the amount of the top-up in wei
+func (t *TopUp) AmountInt() *big.Int {
+	v, ok := new(big.Int).SetString(t.Amount, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return v
+}
+
+// ListFilter restricts the top-ups returned by ListTopUps
+type ListFilter struct {
+	Signer string
+	Status TopUpStatus
+	Limit  int
+	Offset int
+}
+
+// ListTopUps returns the top-ups matching the filter, newest first, and the total count
+func ListTopUps(ctx context.Context, filter ListFilter) ([]*TopUp, int64, error) {
+	q := db.Get().WithContext(ctx).Model(&TopUp{})
+	if filter.Signer != "" {
+		q = q.Where("signer = ?", filter.Signer)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count top-ups")
+	}
+	var list []*TopUp
+	if err := q.Order("id DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&list).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list top-ups")
+	}
+	return list, total, nil
+}
+
+// DailyTopUpRemaining returns the wei the funding signer may still send today, nil when
+// top-ups are disabled
+func DailyTopUpRemaining(ctx context.Context) (*big.Int, error) {
+	s := GetSettings()
+	if !s.TopUpsEnabled() {
+		return nil, nil
+	}
+	spent, err := spentToday(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remaining := new(big.Int).Sub(s.DailyTopUpCap, spent)
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+	return remaining, nil
+}
+
+// Check refreshes the balances and tops up the low signers that have a target balance.
+// With Redis, only one instance sends top-ups per check interval.
+//
+// Parameters:
+//   - ctx: Context for the balance queries and transactions
+//
+// Returns:
+//   - error: Error if the balances cannot be checked
+func Check(ctx context.Context) error {
+	if _, err := Refresh(ctx); err != nil {
+		return err
+	}
+	s := GetSettings()
+	if !s.TopUpsEnabled() || !acquireLock(ctx, s.CheckInterval) {
+		return nil
+	}
+
+	if err := reconcile(ctx); err != nil {
+		return err
+	}
+	for _, b := range Balances() {
+		if b.Low && b.TargetBalance != nil && b.Signer != s.FundingSigner {
+			if err := topUp(ctx, s, b); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// acquireLock reports whether this instance may send top-ups in the current interval.
+// Without Redis every instance may, so a single instance is expected to enable top-ups.
+func acquireLock(ctx context.Context, ttl time.Duration) bool {
+	client := cache.GetRedisClient()
+	if client == nil {
+		return true
+	}
+	// The lock expires slightly before the next check so that its holder keeps it
+	ok, err := client.SetNX(ctx, lockKey, time.Now().Unix(), ttl*9/10).Result()
+	if err != nil {
+		logger.Warnf("failed to acquire treasury top-up lock, skipping top-ups: %v", err)
+		return false
+	}
+	return ok
+}
+
+// topUp sends the missing balance of a low signer from the funding signer, limited by
+// the remaining daily cap. A signer with a pending top-up is skipped.
+func topUp(ctx context.Context, s Settings, b *Balance) error {
+	var pending int64
+	if err := db.Get().WithContext(ctx).Model(&TopUp{}).
+		Where("address = ? AND status = ?", b.Address.Hex(), TopUpPending).Count(&pending).Error; err != nil {
+		return errors.Wrap(err, "failed to query pending top-ups")
+	}
+	if pending > 0 {
+		return nil
+	}
+
+	amount := new(big.Int).Sub(b.TargetBalance, b.Balance)
+	remaining, err := DailyTopUpRemaining(ctx)
+	if err != nil {
+		return err
+	}
+	if remaining.Sign() == 0 {
+		notifyOncePerDay(Alert{Event: EventDailyCapReached, Signer: b.Signer, Address: b.Address.Hex(),
+			Balance: b.Balance.String(), Amount: amount.String(),
+			Message: "daily top-up cap of " + s.DailyTopUpCap.String() + " wei reached"})
+		return nil
+	}
+	if amount.Cmp(remaining) > 0 {
+		amount = remaining
+	}
+
+	funder, err := keystore.GetSigner(s.FundingSigner)
+	if err != nil {
+		failTopUp(ctx, b, amount, errors.Wrap(err, "funding signer not available"))
+		return nil
+	}
+	client := eth.GetClient()
+	if client == nil {
+		return appErrors.NodeUnavailable(eth.RetryAfter(), eth.LastError())
+	}
+
+	// The local nonce tracker is shared with the other senders of the funding signer
+	auth, err := contractClient.CreateSignerTransactOpts(ctx, funder)
+	if err != nil {
+		failTopUp(ctx, b, amount, errors.Wrap(err, "failed to create transaction options"))
+		return nil
+	}
+	transfer, err := contractClient.PrepareNativeTransfer(auth, b.Address, amount)
+	if err != nil {
+		failTopUp(ctx, b, amount, errors.Wrap(err, "failed to prepare top-up"))
+		return nil
+	}
+
+	// The funding signer pays the gas on top of the amount
+	funds, err := client.BalanceAt(ctx, funder.Address(), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get funding signer balance")
+	}
+	required := new(big.Int).Add(amount, transfer.MaxFee())
+	if funds.Cmp(required) < 0 {
+		notifyOncePerDay(Alert{Event: EventFundingInsufficient, Signer: b.Signer, Address: funder.Address().Hex(),
+			Balance: funds.String(), Amount: required.String(),
+			Message: "funding signer " + s.FundingSigner + " cannot pay the top-up"})
+		return nil
+	}
+
+	// Sign first and record the top-up as pending before broadcasting, so that a sent
+	// top-up is never missing from the daily cap
+	auth.NoSend = true
+	tx, err := contractClient.SendNativeTransfer(auth, transfer)
+	if err != nil {
+		failTopUp(ctx, b, amount, errors.Wrap(err, "failed to sign top-up"))
+		return nil
+	}
+	record := &TopUp{
+		Signer:  b.Signer,
+		Address: b.Address.Hex(),
+		Funder:  funder.Address().Hex(),
+		Amount:  amount.String(),
+		Nonce:   tx.Nonce(),
+		TxHash:  tx.Hash().Hex(),
+		Status:  TopUpPending,
+	}
+	if err := db.Get().WithContext(ctx).Create(record).Error; err != nil {
+		eth.ReleaseNonce(funder.Address(), tx.Nonce())
+		return errors.Wrapf(err, "failed to record top-up %s", record.TxHash)
+	}
+
+	if err := eth.SendTransaction(ctx, tx); err != nil {
+		// The transaction may still have reached the node, so the top-up stays pending and
+		// counts against the daily cap until reconcile settles it by receipt or nonce
+		if eth.IsNonceTooLow(err) {
+			eth.ResetNonce(funder.Address())
+		}
+		cause := errors.Wrap(err, "failed to send top-up")
+		if err := db.Get().WithContext(ctx).Model(record).Update("error", truncate(cause.Error(), 512)).Error; err != nil {
+			return errors.Wrapf(err, "failed to update top-up %s", record.TxHash)
+		}
+		notifyOncePerDay(Alert{Event: EventTopUpFailed, Signer: b.Signer, Address: b.Address.Hex(), Balance: b.Balance.String(),
+			Amount: amount.String(), TxHash: record.TxHash, Message: cause.Error()})
+		return nil
+	}
+	topUpsTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("signer", b.Signer), attribute.String("status", "sent")))
+	topUpEther.Add(ctx, toEther(amount), metric.WithAttributes(attribute.String("signer", b.Signer)))
+	notify(Alert{Event: EventTopUpSent, Signer: b.Signer, Address: b.Address.Hex(), Balance: b.Balance.String(),
+		Threshold: b.MinBalance.String(), Amount: amount.String(), TxHash: record.TxHash,
+		Message: "top-up sent from " + s.FundingSigner})
+	return nil
+}
+
+// failTopUp reports a top-up that could not be sent. It is retried on every check, so
+// the alert is sent once a day.
+func failTopUp(ctx context.Context, b *Balance, amount *big.Int, cause error) {
+	topUpsTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("signer", b.Signer), attribute.String("status", "failed")))
+	notifyOncePerDay(Alert{Event: EventTopUpFailed, Signer: b.Signer, Address: b.Address.Hex(), Balance: b.Balance.String(),
+		Amount: amount.String(), Message: cause.Error()})
+}
+
+// reconcile settles pending top-ups: mined ones are confirmed or failed by their receipt
+// status, and unmined ones are failed once their nonce is used or known to be unused
+func reconcile(ctx context.Context) error {
+	var pending []*TopUp
+	if err := db.Get().WithContext(ctx).Where("status = ?", TopUpPending).Find(&pending).Error; err != nil {
+		return errors.Wrap(err, "failed to query pending top-ups")
+	}
+	client := eth.GetClient()
+	if client == nil || len(pending) == 0 {
+		return nil
+	}
+
+	for _, t := range pending {
+		var status TopUpStatus
+		var reason string
+		receipt, err := client.TransactionReceipt(ctx, common.HexToHash(t.TxHash))
+		switch {
+		case err == nil && receipt.Status == 1:
+			status = TopUpConfirmed
+		case err == nil:
+			status, reason = TopUpFailed, "top-up transaction reverted"
+		case errors.Is(err, ethereum.NotFound):
+			var settled bool
+			status, reason, settled = settleUnmined(ctx, client, t)
+			if !settled {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if err := db.Get().WithContext(ctx).Model(t).Updates(map[string]any{"status": status, "error": reason}).Error; err != nil {
+			return errors.Wrapf(err, "failed to update top-up %s", t.TxHash)
+		}
+		if status == TopUpFailed {
+			topUpsTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("signer", t.Signer), attribute.String("status", "failed")))
+			notify(Alert{Event: EventTopUpFailed, Signer: t.Signer, Address: t.Address, Amount: t.Amount,
+				TxHash: t.TxHash, Message: reason})
+		}
+	}
+	return nil
+}
+
+// settleUnmined settles a pending top-up without a receipt. It failed when another
+// transaction used its nonce, or when its broadcast failed and the node neither knows the
+// transaction nor has used the nonce; otherwise it may still be mined and stays pending.
+func settleUnmined(ctx context.Context, client *ethclient.Client, t *TopUp) (TopUpStatus, string, bool) {
+	funder := common.HexToAddress(t.Funder)
+	confirmed, err := client.NonceAt(ctx, funder, nil)
+	if err != nil {
+		return "", "", false
+	}
+	if confirmed > t.Nonce {
+		return TopUpFailed, "top-up transaction replaced or dropped", true
+	}
+	if t.Error == "" {
+		return "", "", false
+	}
+
+	// Only a top-up whose broadcast failed is checked for an unused nonce
+	if _, _, err := client.TransactionByHash(ctx, common.HexToHash(t.TxHash)); !errors.Is(err, ethereum.NotFound) {
+		return "", "", false
+	}
+	next, err := client.PendingNonceAt(ctx, funder)
+	if err != nil || next > t.Nonce {
+		return "", "", false
+	}
+	// The nonce is free again, so the local tracker must hand it out to the next sender
+	eth.ResetNonce(funder)
+	return TopUpFailed, "top-up transaction was not broadcast", true
+}
+
+// spentToday returns the wei sent by top-ups since the start of the UTC day that did
+// not fail
+func spentToday(ctx context.Context) (*big.Int, error) {
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var amounts []string
+	if err := db.Get().WithContext(ctx).Model(&TopUp{}).
+		Where("created_at >= ? AND status <> ?", day, TopUpFailed).Pluck("amount", &amounts).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to sum today's top-ups")
+	}
+	spent := new(big.Int)
+	for _, a := range amounts {
+		if v, ok := new(big.Int).SetString(a, 10); ok {
+			spent.Add(spent, v)
+		}
+	}
+	return spent, nil
+}
+
+// truncate shortens s to at most n bytes
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
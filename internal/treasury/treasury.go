@@ -0,0 +1,245 @@
+// Package treasury monitors the native balances of the signers that pay for
+// transactions. It alerts when a signer drops below its threshold and, when a funding
+// signer is configured, tops up hot keys to their target balance within a daily cap.
+package treasury
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/internal/contract"
+	"eth-contract-service/provider/db"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// defaultCheckInterval is the balance check interval used when none is configured
+const defaultCheckInterval = time.Minute
+
+// Threshold holds the balance limits of a signer
+type Threshold struct {
+	// MinBalance is the balance in wei below which the signer is low (nil disables alerts)
+	MinBalance *big.Int
+	// TargetBalance is the balance in wei a low signer is topped up to (nil disables top-ups)
+	TargetBalance *big.Int
+}
+
+// Settings holds the effective treasury settings
+type Settings struct {
+	// Enabled checks the signer balances periodically
+	Enabled bool
+	// CheckInterval is how often balances are checked
+	CheckInterval time.Duration
+	// MinBalance is the alert threshold in wei of signers without their own threshold
+	MinBalance *big.Int
+	// Thresholds are the limits of individual signers by signer name
+	Thresholds map[string]Threshold
+	// FundingSigner is the signer that pays top-ups, empty when top-ups are disabled
+	FundingSigner string
+	// DailyTopUpCap is the total wei the funding signer sends per UTC day
+	DailyTopUpCap *big.Int
+	// AlertWebhookURL receives alerts as JSON POST requests (optional)
+	AlertWebhookURL string
+}
+
+// ThresholdOf returns the limits of a signer, falling back to the default alert threshold
+func (s Settings) ThresholdOf(signer string) Threshold {
+	t, ok := s.Thresholds[signer]
+	if !ok || t.MinBalance == nil {
+		t.MinBalance = s.MinBalance
+	}
+	return t
+}
+
+// TopUpsEnabled reports whether low signers with a target balance are topped up
+func (s Settings) TopUpsEnabled() bool {
+	return s.FundingSigner != "" && s.DailyTopUpCap != nil
+}
+
+var (
+	// settings stores the effective treasury settings
+	settings = defaultSettings()
+	// settingsMu guards settings, which can be replaced by a configuration reload
+	settingsMu sync.RWMutex
+	// logger logs balance checks, alerts and top-ups
+	logger = log.NewHelper(log.DefaultLogger)
+	// contractClient signs and sends top-ups
+	contractClient = contract.NewClient(log.DefaultLogger)
+	// initOnce ensures the top-up table is migrated only once
+	initOnce sync.Once
+)
+
+// Init applies the treasury settings and migrates the top-up table.
+//
+// Parameters:
+//   - ctx: Context for the migration
+//   - cfg: Treasury configuration (optional, monitoring stays disabled without it)
+//   - logKratos: Logger instance for treasury logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid or the migration fails
+func Init(ctx context.Context, cfg *conf.Treasury, logKratos log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		logger = log.NewHelper(logKratos)
+		contractClient = contract.NewClient(logKratos)
+		s, err := newSettings(cfg)
+		if err != nil {
+			initErr = err
+			return
+		}
+		setSettings(s)
+
+		if err := db.Get().WithContext(ctx).AutoMigrate(&TopUp{}); err != nil {
+			initErr = errors.Wrap(err, "failed to migrate treasury top-up table")
+			return
+		}
+		if err := initMetrics(); err != nil {
+			initErr = err
+			return
+		}
+
+		if !s.Enabled {
+			logger.Infof("treasury monitor disabled")
+			return
+		}
+		logger.Infof("treasury monitor initialized: check_interval=%v, thresholds=%d, funding_signer=%s, daily_top_up_cap=%v",
+			s.CheckInterval, len(s.Thresholds), s.FundingSigner, s.DailyTopUpCap)
+	})
+	return initErr
+}
+
+// Validate checks a treasury configuration without applying it
+func Validate(cfg *conf.Treasury) error {
+	_, err := newSettings(cfg)
+	return err
+}
+
+// Reload validates and applies a changed treasury configuration, e.g. new thresholds or
+// a new daily cap. The settings are only replaced when the whole section is valid.
+//
+// Parameters:
+//   - cfg: Treasury configuration (optional)
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Reload(cfg *conf.Treasury) error {
+	s, err := newSettings(cfg)
+	if err != nil {
+		return err
+	}
+	setSettings(s)
+	return nil
+}
+
+// GetSettings returns the effective treasury settings
+func GetSettings() Settings {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return settings
+}
+
+// Enabled reports whether signer balances are monitored
+func Enabled() bool {
+	return GetSettings().Enabled
+}
+
+// setSettings replaces the effective treasury settings
+func setSettings(s Settings) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	settings = s
+}
+
+// defaultSettings returns the treasury settings used without configuration
+func defaultSettings() Settings {
+	return Settings{
+		CheckInterval: defaultCheckInterval,
+		Thresholds:    make(map[string]Threshold),
+	}
+}
+
+// newSettings validates a treasury configuration and returns its effective settings
+func newSettings(cfg *conf.Treasury) (Settings, error) {
+	s := defaultSettings()
+	s.Enabled = cfg.GetEnabled()
+	if d := cfg.GetCheckInterval(); d != nil && d.AsDuration() > 0 {
+		s.CheckInterval = d.AsDuration()
+	}
+
+	var err error
+	if s.MinBalance, err = parseWei(cfg.GetMinBalance(), "treasury.min_balance"); err != nil {
+		return Settings{}, err
+	}
+
+	hasTargets := false
+	for _, t := range cfg.GetThresholds() {
+		if t.GetSigner() == "" {
+			return Settings{}, errors.New("treasury.thresholds signer cannot be empty")
+		}
+		if _, ok := s.Thresholds[t.Signer]; ok {
+			return Settings{}, errors.Errorf("duplicate treasury threshold for signer %s", t.Signer)
+		}
+		var threshold Threshold
+		if threshold.MinBalance, err = parseWei(t.MinBalance, "treasury.thresholds."+t.Signer+".min_balance"); err != nil {
+			return Settings{}, err
+		}
+		if threshold.TargetBalance, err = parseWei(t.TargetBalance, "treasury.thresholds."+t.Signer+".target_balance"); err != nil {
+			return Settings{}, err
+		}
+		if threshold.TargetBalance != nil {
+			minBalance := threshold.MinBalance
+			if minBalance == nil {
+				minBalance = s.MinBalance
+			}
+			if minBalance == nil {
+				return Settings{}, errors.Errorf("treasury threshold of signer %s sets target_balance without min_balance", t.Signer)
+			}
+			if threshold.TargetBalance.Cmp(minBalance) <= 0 {
+				return Settings{}, errors.Errorf("treasury threshold of signer %s: target_balance must be above min_balance", t.Signer)
+			}
+			if t.Signer == cfg.GetFundingSigner() {
+				return Settings{}, errors.Errorf("treasury funding signer %s cannot be topped up", t.Signer)
+			}
+			hasTargets = true
+		}
+		s.Thresholds[t.Signer] = threshold
+	}
+
+	s.FundingSigner = cfg.GetFundingSigner()
+	if s.DailyTopUpCap, err = parseWei(cfg.GetDailyTopUpCap(), "treasury.daily_top_up_cap"); err != nil {
+		return Settings{}, err
+	}
+	if hasTargets && s.FundingSigner == "" {
+		return Settings{}, errors.New("treasury.funding_signer is required when a threshold sets target_balance")
+	}
+	if s.FundingSigner != "" && s.DailyTopUpCap == nil {
+		return Settings{}, errors.New("treasury.daily_top_up_cap is required when treasury.funding_signer is set")
+	}
+
+	s.AlertWebhookURL = cfg.GetAlertWebhookUrl()
+	if s.AlertWebhookURL != "" {
+		u, err := url.Parse(s.AlertWebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return Settings{}, errors.Errorf("invalid treasury.alert_webhook_url: %s", s.AlertWebhookURL)
+		}
+	}
+	return s, nil
+}
+
+// parseWei parses an optional non-negative wei amount, nil when empty
+func parseWei(v, field string) (*big.Int, error) {
+	if v == "" {
+		return nil, nil
+	}
+	n, ok := new(big.Int).SetString(v, 10)
+	if !ok || n.Sign() < 0 {
+		return nil, errors.Errorf("invalid %s: %s", field, v)
+	}
+	return n, nil
+}
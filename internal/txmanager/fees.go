@@ -0,0 +1,111 @@
+package txmanager
+
+import (
+	"context"
+	"math/big"
+
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/provider/eth"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	pkgErrors "github.com/pkg/errors"
+)
+
+// bump increases a fee by the given percentage, rounding up
+func bump(fee *big.Int, percent uint32) *big.Int {
+	v := new(big.Int).Mul(fee, big.NewInt(int64(100+percent)))
+	v.Add(v, big.NewInt(99))
+	return v.Div(v, big.NewInt(100))
+}
+
+// maxBig returns the larger of two values
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// applyCap lowers fee to limit and reports whether it still reaches the required minimum
+func applyCap(fee, limit, required *big.Int) (*big.Int, bool) {
+	if limit != nil && fee.Cmp(limit) > 0 {
+		fee = new(big.Int).Set(limit)
+	}
+	return fee, fee.Cmp(required) >= 0
+}
+
+// buildReplacement creates an unsigned transaction with the same nonce as tx and bumped fees.
+// A cancel replacement is a zero-value transfer from the sender to itself.
+func buildReplacement(ctx context.Context, tx *types.Transaction, from common.Address, percent uint32, cancel bool) (types.TxData, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.ErrClientNotInitialized
+	}
+
+	caps := GetSettings()
+	to, value, data, gas, accessList := tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.AccessList()
+	if cancel {
+		to, value, data, gas, accessList = &from, new(big.Int), nil, params.TxGas, nil
+	}
+
+	switch tx.Type() {
+	case types.LegacyTxType, types.AccessListTxType:
+		required := bump(tx.GasPrice(), percent)
+		suggested, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, "failed to get suggested gas price")
+		}
+		gasPrice, ok := applyCap(maxBig(required, suggested), caps.MaxFeePerGas, required)
+		if !ok {
+			return nil, errors.ErrFeeCapReached
+		}
+
+		if tx.Type() == types.LegacyTxType {
+			return &types.LegacyTx{Nonce: tx.Nonce(), GasPrice: gasPrice, Gas: gas, To: to, Value: value, Data: data}, nil
+		}
+		return &types.AccessListTx{
+			ChainID: tx.ChainId(), Nonce: tx.Nonce(), GasPrice: gasPrice, Gas: gas,
+			To: to, Value: value, Data: data, AccessList: accessList,
+		}, nil
+
+	case types.DynamicFeeTxType:
+		requiredTip := bump(tx.GasTipCap(), percent)
+		requiredFeeCap := bump(tx.GasFeeCap(), percent)
+
+		suggestedTip, err := client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, "failed to get suggested gas tip cap")
+		}
+		head, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, "failed to get latest header")
+		}
+
+		tip := maxBig(requiredTip, suggestedTip)
+		feeCap := requiredFeeCap
+		if head.BaseFee != nil {
+			// Same headroom as the contract bindings: two base fees plus the tip
+			feeCap = maxBig(feeCap, new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), tip))
+		}
+
+		var ok bool
+		if feeCap, ok = applyCap(feeCap, caps.MaxFeePerGas, requiredFeeCap); !ok {
+			return nil, errors.ErrFeeCapReached
+		}
+		if tip, ok = applyCap(tip, caps.MaxPriorityFeePerGas, requiredTip); !ok {
+			return nil, errors.ErrFeeCapReached
+		}
+		if tip, ok = applyCap(tip, feeCap, requiredTip); !ok {
+			return nil, errors.ErrFeeCapReached
+		}
+
+		return &types.DynamicFeeTx{
+			ChainID: tx.ChainId(), Nonce: tx.Nonce(), GasTipCap: tip, GasFeeCap: feeCap, Gas: gas,
+			To: to, Value: value, Data: data, AccessList: accessList,
+		}, nil
+	}
+
+	return nil, errors.FailedPrecondition("transaction type %d cannot be replaced", tx.Type())
+}
@@ -0,0 +1,236 @@
+package txmanager
+
+import (
+	"context"
+	"time"
+
+	"eth-contract-service/internal/errors"
+	"eth-contract-service/provider/eth"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	pkgErrors "github.com/pkg/errors"
+)
+
+// Status is the resolved state of a replacement chain
+type Status string
+
+const (
+	// StatusPending means no transaction of the chain has been mined yet
+	StatusPending Status = "pending"
+	// StatusConfirmed means a transaction of the chain was mined successfully
+	StatusConfirmed Status = "confirmed"
+	// StatusFailed means the mined transaction reverted
+	StatusFailed Status = "failed"
+	// StatusCancelled means the cancelling self-transfer was mined
+	StatusCancelled Status = "cancelled"
+	// StatusDropped means the nonce was used by a transaction outside the chain
+	StatusDropped Status = "dropped"
+)
+
+// Result describes a replacement that was signed and sent
+type Result struct {
+	// Original is the hash of the first transaction of the chain
+	Original common.Hash
+	// Replaced is the hash of the transaction that was replaced
+	Replaced common.Hash
+	// From is the sender address
+	From common.Address
+	// Tx is the signed replacement transaction
+	Tx *types.Transaction
+}
+
+// Resolution is the outcome of resolving a replacement chain
+type Resolution struct {
+	// Chain is the replacement chain
+	Chain *Chain
+	// Status is the resolved status
+	Status Status
+	// MinedHash is the hash that was mined (zero while pending)
+	MinedHash common.Hash
+	// Receipt is the receipt of the mined transaction (nil while pending)
+	Receipt *types.Receipt
+}
+
+// Replace signs and sends a replacement for a pending transaction.
+// The newest transaction of the chain is replaced with bumped fees, or with a
+// zero-value self-transfer when kind is KindCancel.
+//
+// Parameters:
+//   - ctx: Context for node queries and broadcasting
+//   - hash: Hash of the original transaction or any of its replacements
+//   - sender: Signer of the transaction sender
+//   - kind: Kind of replacement to send
+//   - percent: Fee increase in percent (0 uses the configured default)
+//
+// Returns:
+//   - *Result: The sent replacement
+//   - error: Error if the transaction is unknown, already mined, capped or cannot be sent
+func Replace(ctx context.Context, hash common.Hash, sender keystore.Signer, kind Kind, percent uint32) (*Result, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.ErrClientNotInitialized
+	}
+	chainID := eth.GetChainID()
+	if chainID == nil {
+		return nil, errors.ErrChainIDNotConfigured
+	}
+	if percent == 0 {
+		percent = GetSettings().FeeBumpPercent
+	}
+	if percent < minFeeBumpPercent {
+		percent = minFeeBumpPercent
+	}
+
+	chain, err := LoadChain(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	current, err := latestTransaction(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	from, err := types.Sender(signer, current)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to recover transaction sender")
+	}
+	if sender.Address() != from {
+		return nil, errors.ErrSenderMismatch
+	}
+
+	// A nonce below the confirmed account nonce has already been mined
+	confirmed, err := client.NonceAt(ctx, from, nil)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to get account nonce")
+	}
+	if confirmed > current.Nonce() {
+		return nil, errors.ErrTransactionMined
+	}
+
+	txData, err := buildReplacement(ctx, current, from, percent, kind == KindCancel)
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := sender.SignTx(ctx, types.NewTx(txData), chainID)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to sign replacement transaction")
+	}
+	raw, err := replacement.MarshalBinary()
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to encode replacement transaction")
+	}
+
+	if err := eth.SendTransaction(ctx, replacement); err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to send replacement transaction")
+	}
+
+	// Record the replacement only after the node accepted it
+	err = saveReplacement(ctx, &Replacement{
+		OriginalHash: chain.Original.Hex(),
+		TxHash:       replacement.Hash().Hex(),
+		ReplacedHash: current.Hash().Hex(),
+		FromAddress:  from.Hex(),
+		Nonce:        replacement.Nonce(),
+		Kind:         kind,
+		RawTx:        raw,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Original: chain.Original,
+		Replaced: current.Hash(),
+		From:     from,
+		Tx:       replacement,
+	}, nil
+}
+
+// Resolve determines which transaction of a replacement chain was mined.
+//
+// Parameters:
+//   - ctx: Context for node queries
+//   - hash: Hash of the original transaction or any of its replacements
+//
+// Returns:
+//   - *Resolution: The chain and its resolved status
+//   - error: Error if the transaction is unknown or the node cannot be queried
+func Resolve(ctx context.Context, hash common.Hash) (*Resolution, error) {
+	client := eth.GetClient()
+	if client == nil {
+		return nil, errors.ErrClientNotInitialized
+	}
+
+	chain, err := LoadChain(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	res := &Resolution{Chain: chain, Status: StatusPending}
+
+	for _, h := range chain.Hashes() {
+		receipt, err := client.TransactionReceipt(ctx, h)
+		if err == ethereum.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, pkgErrors.Wrapf(err, "failed to get receipt for %s", h.Hex())
+		}
+
+		res.MinedHash = h
+		res.Receipt = receipt
+		switch {
+		case receipt.Status != types.ReceiptStatusSuccessful:
+			res.Status = StatusFailed
+		case isCancel(chain, h):
+			res.Status = StatusCancelled
+		default:
+			res.Status = StatusConfirmed
+		}
+		return res, nil
+	}
+
+	// Nothing mined: the nonce may have been used by an unrelated transaction
+	current, err := latestTransaction(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(eth.GetChainID()), current)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to recover transaction sender")
+	}
+	confirmed, err := client.NonceAt(ctx, from, nil)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to get account nonce")
+	}
+	if confirmed > current.Nonce() {
+		res.Status = StatusDropped
+	}
+	return res, nil
+}
+
+// latestTransaction returns the newest transaction of the chain
+func latestTransaction(ctx context.Context, chain *Chain) (*types.Transaction, error) {
+	if latest := chain.Latest(); latest != nil {
+		return latest.Transaction()
+	}
+
+	tx, _, err := eth.GetClient().TransactionByHash(ctx, chain.Original)
+	if err == ethereum.NotFound {
+		return nil, errors.ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to get transaction")
+	}
+	return tx, nil
+}
+
+// isCancel reports whether the hash is a cancelling replacement of the chain
+func isCancel(chain *Chain, hash common.Hash) bool {
+	r := chain.Find(hash)
+	return r != nil && r.Kind == KindCancel
+}
@@ -0,0 +1,135 @@
+package txmanager
+
+import (
+	"context"
+	"time"
+
+	"eth-contract-service/provider/db"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// Kind describes why a replacement was sent
+type Kind string
+
+const (
+	// KindSpeedUp is a replacement with bumped fees requested through the API
+	KindSpeedUp Kind = "speed_up"
+	// KindCancel is a zero-value self-transfer requested through the API
+	KindCancel Kind = "cancel"
+	// KindAutoSpeedUp is a replacement with bumped fees sent by the job tracker
+	KindAutoSpeedUp Kind = "auto_speed_up"
+)
+
+// Replacement is a persisted transaction that replaced an earlier one with the same nonce
+type Replacement struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	OriginalHash string    `gorm:"size:66;index" json:"original_hash"`
+	TxHash       string    `gorm:"size:66;uniqueIndex" json:"tx_hash"`
+	ReplacedHash string    `gorm:"size:66" json:"replaced_hash"`
+	FromAddress  string    `gorm:"size:42" json:"from_address"`
+	Nonce        uint64    `json:"nonce"`
+	Kind         Kind      `gorm:"size:16" json:"kind"`
+	RawTx        []byte    `json:"raw_tx"` // RLP encoded signed transaction
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for transaction replacements
+func (Replacement) TableName() string {
+	return "tx_replacements"
+}
+
+// Transaction decodes the stored signed transaction
+func (r *Replacement) Transaction() (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(r.RawTx); err != nil {
+		return nil, errors.Wrap(err, "failed to decode stored transaction")
+	}
+	return tx, nil
+}
+
+// Chain is a transaction together with all replacements sharing its nonce
+type Chain struct {
+	// Original is the hash of the first transaction
+	Original common.Hash
+	// Replacements are the replacements in submission order
+	Replacements []*Replacement
+}
+
+// Hashes returns every hash of the chain, newest first
+func (c *Chain) Hashes() []common.Hash {
+	hashes := make([]common.Hash, 0, len(c.Replacements)+1)
+	for i := len(c.Replacements) - 1; i >= 0; i-- {
+		hashes = append(hashes, common.HexToHash(c.Replacements[i].TxHash))
+	}
+	return append(hashes, c.Original)
+}
+
+// Latest returns the most recent replacement, or nil if the transaction was never replaced
+func (c *Chain) Latest() *Replacement {
+	if len(c.Replacements) == 0 {
+		return nil
+	}
+	return c.Replacements[len(c.Replacements)-1]
+}
+
+// Find returns the replacement with the given hash, or nil
+func (c *Chain) Find(hash common.Hash) *Replacement {
+	for _, r := range c.Replacements {
+		if common.HexToHash(r.TxHash) == hash {
+			return r
+		}
+	}
+	return nil
+}
+
+// Count returns the number of replacements of the given kind
+func (c *Chain) Count(kind Kind) int {
+	n := 0
+	for _, r := range c.Replacements {
+		if r.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// LoadChain returns the replacement chain containing the given hash.
+// The hash may be the original transaction or any of its replacements.
+//
+// Parameters:
+//   - ctx: Context for the database query
+//   - hash: Any transaction hash of the chain
+//
+// Returns:
+//   - *Chain: The replacement chain (without replacements if none were recorded)
+//   - error: Error if the database query fails
+func LoadChain(ctx context.Context, hash common.Hash) (*Chain, error) {
+	gdb := db.Get().WithContext(ctx)
+
+	chain := &Chain{Original: hash}
+	var rec Replacement
+	err := gdb.Where("tx_hash = ?", hash.Hex()).Limit(1).Find(&rec).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query transaction replacement")
+	}
+	if rec.ID != 0 {
+		chain.Original = common.HexToHash(rec.OriginalHash)
+	}
+
+	err = gdb.Where("original_hash = ?", chain.Original.Hex()).Order("id ASC").Find(&chain.Replacements).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query transaction replacements")
+	}
+	return chain, nil
+}
+
+// saveReplacement records a sent replacement
+func saveReplacement(ctx context.Context, rec *Replacement) error {
+	if err := db.Get().WithContext(ctx).Create(rec).Error; err != nil {
+		return errors.Wrap(err, "failed to save transaction replacement")
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Package txmanager provides replacement of pending transactions.
+// It bumps fees for transactions stuck in the mempool, cancels them with zero-value
+// self-transfers and records the replacement chain so that the original transaction
+// can be resolved to whichever hash was eventually mined.
+package txmanager
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/provider/db"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// minFeeBumpPercent is the minimum fee increase accepted by geth for replacements
+	minFeeBumpPercent = 10
+	// defaultBumpAfter is the default wait before an automatic bump
+	defaultBumpAfter = 3 * time.Minute
+	// defaultMaxAutoBumps is the default number of automatic bumps per transaction
+	defaultMaxAutoBumps = 5
+)
+
+// Settings holds the effective replacement settings
+type Settings struct {
+	// FeeBumpPercent is the fee increase applied by a replacement
+	FeeBumpPercent uint32
+	// MaxFeePerGas caps the gas price / max fee per gas (nil means no cap)
+	MaxFeePerGas *big.Int
+	// MaxPriorityFeePerGas caps the max priority fee per gas (nil means no cap)
+	MaxPriorityFeePerGas *big.Int
+	// AutoBump enables automatic bumping of asynchronous job transactions
+	AutoBump bool
+	// BumpAfter is the wait for a receipt before an automatic bump
+	BumpAfter time.Duration
+	// MaxAutoBumps limits automatic bumps per transaction
+	MaxAutoBumps int
+}
+
+var (
+	// settings stores the effective replacement settings
+	settings = defaultSettings()
+	// settingsMu guards settings, which can be replaced by a configuration reload
+	settingsMu sync.RWMutex
+	// initOnce ensures the transaction manager is initialized only once
+	initOnce sync.Once
+)
+
+// Init initializes the transaction manager.
+// It migrates the replacement table and applies the configured fee caps.
+//
+// Parameters:
+//   - ctx: Context for the initialization operation
+//   - cfg: Transaction replacement configuration (optional)
+//   - logger: Logger instance for transaction manager logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid or the migration fails
+func Init(ctx context.Context, cfg *conf.Transactions, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		s, err := newSettings(cfg)
+		if err != nil {
+			initErr = err
+			return
+		}
+
+		if err := db.Get().WithContext(ctx).AutoMigrate(&Replacement{}); err != nil {
+			initErr = errors.Wrap(err, "failed to migrate transaction replacements table")
+			return
+		}
+		setSettings(s)
+
+		log.NewHelper(logger).Infof("transaction manager initialized: fee_bump_percent=%d, auto_bump=%v, bump_after=%v",
+			s.FeeBumpPercent, s.AutoBump, s.BumpAfter)
+	})
+
+	return initErr
+}
+
+// Validate checks a transactions configuration without applying it
+func Validate(cfg *conf.Transactions) error {
+	_, err := newSettings(cfg)
+	return err
+}
+
+// Reload validates and applies a changed transactions configuration.
+// The settings are only replaced when the whole section is valid.
+//
+// Parameters:
+//   - cfg: Transaction replacement configuration (optional)
+//
+// Returns:
+//   - error: Error if the configuration is invalid
+func Reload(cfg *conf.Transactions) error {
+	s, err := newSettings(cfg)
+	if err != nil {
+		return err
+	}
+	setSettings(s)
+	return nil
+}
+
+// GetSettings returns the effective replacement settings.
+func GetSettings() Settings {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return settings
+}
+
+// setSettings replaces the effective replacement settings
+func setSettings(s Settings) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	settings = s
+}
+
+// defaultSettings returns the replacement settings used without configuration
+func defaultSettings() Settings {
+	return Settings{
+		FeeBumpPercent: minFeeBumpPercent,
+		BumpAfter:      defaultBumpAfter,
+		MaxAutoBumps:   defaultMaxAutoBumps,
+	}
+}
+
+// newSettings builds replacement settings from the configuration
+func newSettings(cfg *conf.Transactions) (Settings, error) {
+	s := defaultSettings()
+	if cfg == nil {
+		return s, nil
+	}
+	if cfg.FeeBumpPercent > minFeeBumpPercent {
+		s.FeeBumpPercent = cfg.FeeBumpPercent
+	}
+	if cfg.MaxFeePerGas != "" {
+		v, ok := new(big.Int).SetString(cfg.MaxFeePerGas, 10)
+		if !ok || v.Sign() <= 0 {
+			return Settings{}, errors.Errorf("invalid transactions.max_fee_per_gas: %s", cfg.MaxFeePerGas)
+		}
+		s.MaxFeePerGas = v
+	}
+	if cfg.MaxPriorityFeePerGas != "" {
+		v, ok := new(big.Int).SetString(cfg.MaxPriorityFeePerGas, 10)
+		if !ok || v.Sign() <= 0 {
+			return Settings{}, errors.Errorf("invalid transactions.max_priority_fee_per_gas: %s", cfg.MaxPriorityFeePerGas)
+		}
+		s.MaxPriorityFeePerGas = v
+	}
+	s.AutoBump = cfg.AutoBump
+	if cfg.BumpAfter != nil && cfg.BumpAfter.AsDuration() > 0 {
+		s.BumpAfter = cfg.BumpAfter.AsDuration()
+	}
+	if cfg.MaxAutoBumps > 0 {
+		s.MaxAutoBumps = int(cfg.MaxAutoBumps)
+	}
+	return s, nil
+}
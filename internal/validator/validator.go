@@ -48,6 +48,92 @@ func ValidateAmount(amountStr string, fieldName string) (*big.Int, error) {
 	return amount, nil
 }
 
+const (
+	// UnitBase denotes amounts in the smallest unit of a token (wei for 18 decimals)
+	UnitBase = "base"
+	// UnitWhole denotes amounts in whole tokens, e.g. "12.5"
+	UnitWhole = "whole"
+)
+
+// ValidateUnit validates the unit of an amount; empty selects the unit from the amount
+func ValidateUnit(unit string) error {
+	switch unit {
+	case "", UnitBase, UnitWhole:
+		return nil
+	}
+	return pkgErrors.Errorf("invalid unit: %s (must be %s or %s)", unit, UnitBase, UnitWhole)
+}
+
+// IsWholeAmount reports whether an amount is given in whole tokens: with unit whole, or
+// with a decimal point when no unit is set
+func IsWholeAmount(amountStr, unit string) bool {
+	if unit == "" {
+		return strings.Contains(amountStr, ".")
+	}
+	return unit == UnitWhole
+}
+
+// ValidateTokenAmount validates an amount given in base units or whole tokens and
+// converts it to base units. Whole amounts may not have more decimal places than the
+// token has decimals.
+//
+// Parameters:
+//   - amountStr: The amount, e.g. "1500000" in base units or "1.5" in whole tokens
+//   - unit: UnitBase, UnitWhole or empty to use whole tokens only when amountStr has a decimal point
+//   - decimals: Decimals of the token, only used for whole amounts
+//   - fieldName: Field name for error messages
+//
+// Returns:
+//   - *big.Int: The amount in base units
+//   - error: Error if validation fails
+func ValidateTokenAmount(amountStr, unit string, decimals uint8, fieldName string) (*big.Int, error) {
+	if err := ValidateUnit(unit); err != nil {
+		return nil, err
+	}
+	if !IsWholeAmount(amountStr, unit) {
+		if strings.Contains(amountStr, ".") {
+			return nil, pkgErrors.Errorf("invalid %s: %s (amounts in base units must be integers)", fieldName, amountStr)
+		}
+		return ValidateAmount(amountStr, fieldName)
+	}
+	if amountStr == "" {
+		return nil, pkgErrors.Errorf("%s cannot be empty", fieldName)
+	}
+
+	whole, frac, _ := strings.Cut(amountStr, ".")
+	if whole == "" && frac == "" || strings.TrimLeft(whole+frac, "0123456789") != "" {
+		return nil, pkgErrors.Errorf("invalid %s: %s (must be a decimal number)", fieldName, amountStr)
+	}
+	trimmed := strings.TrimRight(frac, "0")
+	if len(trimmed) > int(decimals) {
+		return nil, pkgErrors.Errorf("invalid %s: %s has more than %d decimal places", fieldName, amountStr, decimals)
+	}
+
+	amount, _ := new(big.Int).SetString(whole+trimmed+strings.Repeat("0", int(decimals)-len(trimmed)), 10)
+	return amount, nil
+}
+
+// FormatTokenAmount formats an amount in base units as whole tokens, e.g. "12.5" for
+// 12500000 with 6 decimals
+func FormatTokenAmount(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		return ""
+	}
+	digits := new(big.Int).Abs(amount).String()
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(decimals)
+	formatted := digits[:point]
+	if frac := strings.TrimRight(digits[point:], "0"); frac != "" {
+		formatted += "." + frac
+	}
+	if amount.Sign() < 0 {
+		formatted = "-" + formatted
+	}
+	return formatted
+}
+
 // ValidatePrivateKey validates and parses a hex-encoded private key
 //
 // Supported formats:
@@ -81,6 +167,20 @@ func ValidatePrivateKey(keyStr string) ([]byte, error) {
 	return privateKey, nil
 }
 
+// ValidateTxHash validates a transaction hash
+func ValidateTxHash(hash string, fieldName string) (common.Hash, error) {
+	if hash == "" {
+		return common.Hash{}, pkgErrors.Errorf("%s cannot be empty", fieldName)
+	}
+
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X"))
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, pkgErrors.Errorf("%s is not a valid transaction hash: %s", fieldName, hash)
+	}
+
+	return common.BytesToHash(b), nil
+}
+
 // ValidateDecimals validates token decimals
 func ValidateDecimals(decimals uint32) error {
 	if decimals == 0 || decimals > 18 {
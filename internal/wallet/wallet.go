@@ -0,0 +1,175 @@
+// Package wallet keeps track of the HD wallet accounts handed out as deposit addresses.
+// Accounts are derived on demand from the HD wallet loaded by provider/keystore; the
+// table only records which indices are in use and their labels, never key material.
+package wallet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	appErrors "eth-contract-service/internal/errors"
+	"eth-contract-service/provider/db"
+	"eth-contract-service/provider/keystore"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// initOnce ensures the table is migrated only once
+var initOnce sync.Once
+
+// Account is a derived HD wallet account
+type Account struct {
+	Index     uint32    `gorm:"column:account_index;primaryKey;autoIncrement:false" json:"index"`
+	Address   string    `gorm:"size:42;uniqueIndex" json:"address"`
+	Path      string    `gorm:"size:64" json:"path"`
+	Label     string    `gorm:"size:128;index" json:"label"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for derived accounts
+func (Account) TableName() string {
+	return "hd_accounts"
+}
+
+// ListFilter restricts the accounts returned by List
+type ListFilter struct {
+	Label  string
+	Limit  int
+	Offset int
+}
+
+// Init migrates the derived account table.
+//
+// Parameters:
+//   - ctx: Context for the migration
+//   - logger: Logger instance for wallet logging
+//
+// Returns:
+//   - error: Error if the migration fails
+func Init(ctx context.Context, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if err := db.Get().WithContext(ctx).AutoMigrate(&Account{}); err != nil {
+			initErr = errors.Wrap(err, "failed to migrate HD account table")
+			return
+		}
+		log.NewHelper(logger).Infof("HD account table initialized")
+	})
+	return initErr
+}
+
+// Derive derives the account at the given index and records it. Deriving an index that
+// is already recorded returns the recorded account and updates its label when one is set.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - index: Child index of the account chain
+//   - label: Label of the account, e.g. a customer ID (optional)
+//
+// Returns:
+//   - *Account: The derived account
+//   - error: Error if the wallet is not loaded or the index is invalid
+func Derive(ctx context.Context, index uint32, label string) (*Account, error) {
+	if !keystore.IsHDInitialized() {
+		return nil, appErrors.ErrHDWalletDisabled
+	}
+	var account *Account
+	err := db.Get().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		account, err = record(tx, index, label)
+		return err
+	})
+	return account, err
+}
+
+// DeriveNext derives and records the account following the highest recorded index.
+//
+// Parameters:
+//   - ctx: Context for the database operations
+//   - label: Label of the account, e.g. a customer ID (optional)
+//
+// Returns:
+//   - *Account: The derived account
+//   - error: Error if the wallet is not loaded
+func DeriveNext(ctx context.Context, label string) (*Account, error) {
+	if !keystore.IsHDInitialized() {
+		return nil, appErrors.ErrHDWalletDisabled
+	}
+	var account *Account
+	err := db.Get().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var last Account
+		res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Order("account_index DESC").Limit(1).Find(&last)
+		if res.Error != nil {
+			return errors.Wrap(res.Error, "failed to find last HD account")
+		}
+		var index uint32
+		if res.RowsAffected > 0 {
+			index = last.Index + 1
+		}
+		var err error
+		account, err = record(tx, index, label)
+		return err
+	})
+	return account, err
+}
+
+// List returns recorded accounts matching the filter, ordered by index, and the total count
+func List(ctx context.Context, filter ListFilter) ([]*Account, int64, error) {
+	q := db.Get().WithContext(ctx).Model(&Account{})
+	if filter.Label != "" {
+		q = q.Where("label = ?", filter.Label)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count HD accounts")
+	}
+	var accounts []*Account
+	if err := q.Order("account_index ASC").Limit(filter.Limit).Offset(filter.Offset).Find(&accounts).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list HD accounts")
+	}
+	return accounts, total, nil
+}
+
+// record derives the account at index and inserts or relabels its row
+func record(tx *gorm.DB, index uint32, label string) (*Account, error) {
+	addr, err := keystore.DeriveHDAddress(index)
+	if err != nil {
+		return nil, appErrors.WrapError(err, appErrors.CodeInvalidArgument, "failed to derive HD account")
+	}
+
+	var account Account
+	res := tx.Where("account_index = ?", index).Limit(1).Find(&account)
+	if res.Error != nil {
+		return nil, errors.Wrap(res.Error, "failed to get HD account")
+	}
+	now := time.Now()
+	if res.RowsAffected > 0 {
+		if label != "" && label != account.Label {
+			account.Label = label
+			account.UpdatedAt = now
+			if err := tx.Save(&account).Error; err != nil {
+				return nil, errors.Wrap(err, "failed to update HD account")
+			}
+		}
+		return &account, nil
+	}
+
+	account = Account{
+		Index:     index,
+		Address:   addr.Hex(),
+		Path:      keystore.HDPath(index).String(),
+		Label:     label,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := tx.Create(&account).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to store HD account")
+	}
+	return &account, nil
+}
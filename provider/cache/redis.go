@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/pkg/errors"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -52,6 +53,12 @@ func InitRedis(ctx context.Context, cfg *conf.Data_Redis, logger log.Logger) err
 			redisc = nil // Reset client on error
 			return
 		}
+
+		// Record a span for every command; spans are dropped while tracing is disabled
+		if err := redisotel.InstrumentTracing(redisc); err != nil {
+			initErr = errors.Wrap(err, "redis tracing error")
+			return
+		}
 	})
 
 	if initErr != nil {
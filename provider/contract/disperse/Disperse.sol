@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+
+/**
+ * @title Disperse
+ * @dev 批量转账合约，与 disperse.app 部署的合约接口一致
+ * @notice 代币批量转账前需要先授权本合约使用发送方的代币
+ */
+contract Disperse {
+    /**
+     * @dev 批量转账 ETH，多余的 ETH 退回给调用者
+     * @param recipients 接收地址列表
+     * @param values 对应的转账金额列表
+     */
+    function disperseEther(address[] calldata recipients, uint256[] calldata values) external payable {
+        for (uint256 i = 0; i < recipients.length; i++) {
+            payable(recipients[i]).transfer(values[i]);
+        }
+        uint256 balance = address(this).balance;
+        if (balance > 0) {
+            payable(msg.sender).transfer(balance);
+        }
+    }
+
+    /**
+     * @dev 批量转账代币，先将总额转入本合约再逐一转出
+     * @param token 代币合约地址
+     * @param recipients 接收地址列表
+     * @param values 对应的转账金额列表
+     */
+    function disperseToken(IERC20 token, address[] calldata recipients, uint256[] calldata values) external {
+        uint256 total = 0;
+        for (uint256 i = 0; i < recipients.length; i++) {
+            total += values[i];
+        }
+        require(token.transferFrom(msg.sender, address(this), total));
+        for (uint256 i = 0; i < recipients.length; i++) {
+            require(token.transfer(recipients[i], values[i]));
+        }
+    }
+
+    /**
+     * @dev 批量转账代币，直接从调用者转给每个接收者
+     * @param token 代币合约地址
+     * @param recipients 接收地址列表
+     * @param values 对应的转账金额列表
+     */
+    function disperseTokenSimple(IERC20 token, address[] calldata recipients, uint256[] calldata values) external {
+        for (uint256 i = 0; i < recipients.length; i++) {
+            require(token.transferFrom(msg.sender, recipients[i], values[i]));
+        }
+    }
+}
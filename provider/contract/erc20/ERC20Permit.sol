@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.27;
+
+/**
+ * @title ERC20Permit
+ * @dev EIP-2612 permit 接口（OpenZeppelin ERC20Permit 实现此接口）
+ * @notice 用于签名和提交链下授权，eip712Domain 来自 EIP-5267，旧合约可能未实现
+ */
+interface ERC20Permit {
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    function nonces(address owner) external view returns (uint256);
+
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() external view returns (bytes32);
+
+    function eip712Domain()
+        external
+        view
+        returns (
+            bytes1 fields,
+            string memory name,
+            string memory version,
+            uint256 chainId,
+            address verifyingContract,
+            bytes32 salt,
+            uint256[] memory extensions
+        );
+
+    function name() external view returns (string memory);
+
+    function transferFrom(address from, address to, uint256 value) external returns (bool);
+}
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.27;
+
+/**
+ * @title ERC721URIMintable
+ * @dev OpenZeppelin Wizard 生成的 ERC721URIStorage 合约的铸造接口
+ * @notice 批量铸造时指定了 URI 的条目通过该接口铸造，合约需实现此方法
+ */
+interface ERC721URIMintable {
+    function safeMint(address to, uint256 tokenId, string memory uri) external;
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/**
+ * @title MinimalForwarder
+ * @dev ERC-2771 可信转发合约接口（与 OpenZeppelin MinimalForwarder 一致）
+ * @notice 用户离线签署 ForwardRequest，由中继账户调用 execute 并支付 Gas
+ */
+interface MinimalForwarder {
+    struct ForwardRequest {
+        address from;
+        address to;
+        uint256 value;
+        uint256 gas;
+        uint256 nonce;
+        bytes data;
+    }
+
+    function getNonce(address from) external view returns (uint256);
+
+    function verify(ForwardRequest calldata req, bytes calldata signature) external view returns (bool);
+
+    function execute(ForwardRequest calldata req, bytes calldata signature)
+        external
+        payable
+        returns (bool, bytes memory);
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.20;
+
+/**
+ * @title Safe
+ * @dev Safe（原 Gnosis Safe）多签钱包接口，与 Safe v1.3.0 及以上版本一致
+ * @notice 用于构建 SafeTx、收集所有者签名并调用 execTransaction 执行
+ */
+interface Safe {
+    function VERSION() external view returns (string memory);
+
+    function nonce() external view returns (uint256);
+
+    function getThreshold() external view returns (uint256);
+
+    function getOwners() external view returns (address[] memory);
+
+    function isOwner(address owner) external view returns (bool);
+
+    function domainSeparator() external view returns (bytes32);
+
+    function getTransactionHash(
+        address to,
+        uint256 value,
+        bytes calldata data,
+        uint8 operation,
+        uint256 safeTxGas,
+        uint256 baseGas,
+        uint256 gasPrice,
+        address gasToken,
+        address refundReceiver,
+        uint256 _nonce
+    ) external view returns (bytes32);
+
+    function execTransaction(
+        address to,
+        uint256 value,
+        bytes calldata data,
+        uint8 operation,
+        uint256 safeTxGas,
+        uint256 baseGas,
+        uint256 gasPrice,
+        address gasToken,
+        address payable refundReceiver,
+        bytes memory signatures
+    ) external payable returns (bool success);
+}
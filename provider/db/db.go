@@ -17,6 +17,7 @@ import (
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
 )
 
 var (
@@ -79,6 +80,11 @@ func Init(ctx context.Context, cfg *conf.Data_Database, logKratos log.Logger) er
 				retryDelay *= 2 // Exponential backoff
 			}
 		}
+
+		// Record a span for every statement; spans are dropped while tracing is disabled
+		if initErr == nil {
+			initErr = gdb.Use(gormtracing.NewPlugin(gormtracing.WithoutMetrics(), gormtracing.WithoutQueryVariables()))
+		}
 	})
 
 	if initErr != nil {
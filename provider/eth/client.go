@@ -7,7 +7,6 @@ import (
 	"crypto/ecdsa"
 	"math/big"
 	"sync"
-	"time"
 
 	"eth-contract-service/internal/conf"
 
@@ -15,26 +14,33 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
-	// client is the global Ethereum client instance
+	// client is the global Ethereum client instance, nil while disconnected
 	client *ethclient.Client
 	// initClientOnce ensures the client is initialized only once (thread-safe)
 	initClientOnce sync.Once
 	// config stores the Ethereum configuration
 	config *conf.Ethereum
+	// configMu guards config, whose contracts map can be replaced by a configuration reload
+	configMu sync.RWMutex
 	// logger stores the logger instance
 	logger log.Logger
 )
 
 // Init initializes the Ethereum client connection.
-// It uses sync.Once to ensure the client is initialized only once.
+// It uses sync.Once to ensure the client is initialized only once. When the first
+// connection fails the client keeps reconnecting in the background with backoff, and a
+// connected client is re-established whenever its connection drops.
 //
 // Parameters:
 //   - ctx: Context for the initialization operation
@@ -42,7 +48,7 @@ var (
 //   - logKratos: Logger instance for Ethereum client logging
 //
 // Returns:
-//   - error: Error if initialization or connection fails
+//   - error: Error if the first connection fails
 func Init(ctx context.Context, cfg *conf.Ethereum, logKratos log.Logger) error {
 	if cfg == nil {
 		return errors.New("ethereum config cannot be nil")
@@ -50,54 +56,64 @@ func Init(ctx context.Context, cfg *conf.Ethereum, logKratos log.Logger) error {
 
 	var initErr error
 	initClientOnce.Do(func() {
+		configMu.Lock()
 		config = cfg
+		configMu.Unlock()
 		logger = logKratos
 
-		timeout := 30 * time.Second
-		if cfg.Timeout != nil {
-			timeout = cfg.Timeout.AsDuration()
+		initErr = connect(ctx, cfg)
+		if initErr == nil {
+			log.NewHelper(logger).Infof("Ethereum client initialized: chain_id=%d, rpc_url=%s", cfg.ChainId, cfg.RpcUrl)
 		}
-
-		ctx, cancel := context.WithTimeout(ctx, timeout)
-		defer cancel()
-
-		ethClient, err := ethclient.DialContext(ctx, cfg.RpcUrl)
-		if err != nil {
-			initErr = errors.Wrap(err, "failed to connect to Ethereum node")
-			return
-		}
-
-		// Verify connection by getting chain ID
-		chainID, err := ethClient.ChainID(ctx)
-		if err != nil {
-			initErr = errors.Wrap(err, "failed to get chain ID")
-			return
-		}
-
-		expectedChainID := big.NewInt(cfg.ChainId)
-		if chainID.Cmp(expectedChainID) != 0 {
-			initErr = errors.Errorf("chain ID mismatch: expected %d, got %d", cfg.ChainId, chainID.Int64())
-			return
-		}
-
-		client = ethClient
-		log.NewHelper(logger).Infof("Ethereum client initialized: chain_id=%d, rpc_url=%s", cfg.ChainId, cfg.RpcUrl)
+		// Reconnect in the background when the node is not reachable yet or drops later
+		go supervise(cfg)
 	})
 
 	return initErr
 }
 
-// GetClient returns the global Ethereum client instance.
-// Returns nil if the client has not been initialized.
-func GetClient() *ethclient.Client {
-	return client
-}
-
 // GetConfig returns the Ethereum configuration.
 func GetConfig() *conf.Ethereum {
+	configMu.RLock()
+	defer configMu.RUnlock()
 	return config
 }
 
+// ReloadContracts validates and applies a changed contract addresses map.
+// The map is only replaced when every address is valid; connection settings such as
+// the RPC URL and chain ID require a restart.
+//
+// Parameters:
+//   - contracts: Contract addresses map, e.g., erc20: 0xXXXXX
+//
+// Returns:
+//   - error: Error if the client is not initialized or an address is invalid
+func ReloadContracts(contracts map[string]string) error {
+	if err := ValidateContracts(contracts); err != nil {
+		return err
+	}
+
+	configMu.Lock()
+	defer configMu.Unlock()
+	if config == nil {
+		return errors.New("ethereum client not initialized")
+	}
+	updated := proto.Clone(config).(*conf.Ethereum)
+	updated.Contracts = contracts
+	config = updated
+	return nil
+}
+
+// ValidateContracts checks the addresses of a contract addresses map
+func ValidateContracts(contracts map[string]string) error {
+	for name, addr := range contracts {
+		if addr != "" && !common.IsHexAddress(addr) {
+			return errors.Errorf("invalid ethereum.contracts.%s: %s", name, addr)
+		}
+	}
+	return nil
+}
+
 // GetContractAddress returns the contract address for the given contract name.
 // Returns empty address if the contract is not found in the configuration.
 //
@@ -107,6 +123,7 @@ func GetConfig() *conf.Ethereum {
 // Returns:
 //   - common.Address: The contract address, or zero address if not found
 func GetContractAddress(contractName string) common.Address {
+	config := GetConfig()
 	if config == nil || config.Contracts == nil {
 		return common.Address{}
 	}
@@ -121,6 +138,7 @@ func GetContractAddress(contractName string) common.Address {
 
 // GetChainID returns the chain ID as a big.Int.
 func GetChainID() *big.Int {
+	config := GetConfig()
 	if config == nil {
 		return nil
 	}
@@ -139,8 +157,9 @@ func GetChainID() *big.Int {
 //   - *bind.TransactOpts: Transaction options configured for the current chain
 //   - error: Error if configuration fails
 func NewTransactOpts(ctx context.Context, from common.Address, privateKey []byte) (*bind.TransactOpts, error) {
+	client := GetClient()
 	if client == nil {
-		return nil, errors.New("Ethereum client not initialized")
+		return nil, errors.Wrap(LastError(), "Ethereum client not connected")
 	}
 
 	chainID := GetChainID()
@@ -206,8 +225,9 @@ func NewCallOpts(ctx context.Context, blockNumber *big.Int) *bind.CallOpts {
 //   - []byte: The return data from the contract call
 //   - error: Error if the call fails
 func CallContract(ctx context.Context, contractAddr common.Address, input []byte, blockNumber *big.Int) ([]byte, error) {
+	client := GetClient()
 	if client == nil {
-		return nil, errors.New("Ethereum client not initialized")
+		return nil, errors.Wrap(LastError(), "Ethereum client not connected")
 	}
 
 	msg := ethereum.CallMsg{
@@ -223,6 +243,64 @@ func CallContract(ctx context.Context, contractAddr common.Address, input []byte
 	return result, nil
 }
 
+// maxBatchCalls is the number of calls sent per JSON-RPC batch, below the batch limit of
+// common node implementations
+const maxBatchCalls = 100
+
+// Call is a read-only contract call of a batch
+type Call struct {
+	// To is the contract address
+	To common.Address
+	// Data is the encoded function call data
+	Data []byte
+	// Result is the return data, set by BatchCallContract
+	Result []byte
+	// Err is the error of the call, set by BatchCallContract
+	Err error
+}
+
+// BatchCallContract performs read-only contract calls in JSON-RPC batches at the same block.
+// Calls that fail, e.g. because they revert, report their error in Err without failing
+// the other calls.
+//
+// Parameters:
+//   - ctx: Context for the calls
+//   - calls: The calls to perform; Result and Err are set on each call
+//   - blockNumber: Block number to query (nil for latest)
+//
+// Returns:
+//   - error: Error if a batch cannot be sent
+func BatchCallContract(ctx context.Context, calls []*Call, blockNumber *big.Int) error {
+	client := GetClient()
+	if client == nil {
+		return errors.Wrap(LastError(), "Ethereum client not connected")
+	}
+
+	block := "latest"
+	if blockNumber != nil {
+		block = hexutil.EncodeBig(blockNumber)
+	}
+	for start := 0; start < len(calls); start += maxBatchCalls {
+		chunk := calls[start:min(start+maxBatchCalls, len(calls))]
+		elems := make([]rpc.BatchElem, len(chunk))
+		results := make([]hexutil.Bytes, len(chunk))
+		for i, call := range chunk {
+			elems[i] = rpc.BatchElem{
+				Method: "eth_call",
+				Args:   []any{map[string]any{"to": call.To, "data": hexutil.Bytes(call.Data)}, block},
+				Result: &results[i],
+			}
+		}
+		if err := client.Client().BatchCallContext(ctx, elems); err != nil {
+			return errors.Wrap(err, "failed to call contracts")
+		}
+		for i, call := range chunk {
+			call.Result, call.Err = results[i], elems[i].Error
+		}
+	}
+	return nil
+}
+
 // SendTransaction sends a signed transaction to the network.
 //
 // Parameters:
@@ -232,8 +310,9 @@ func CallContract(ctx context.Context, contractAddr common.Address, input []byte
 // Returns:
 //   - error: Error if the transaction fails
 func SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	client := GetClient()
 	if client == nil {
-		return errors.New("Ethereum client not initialized")
+		return errors.Wrap(LastError(), "Ethereum client not connected")
 	}
 
 	return client.SendTransaction(ctx, tx)
@@ -249,8 +328,9 @@ func SendTransaction(ctx context.Context, tx *types.Transaction) error {
 //   - *types.Receipt: The transaction receipt
 //   - error: Error if waiting fails or transaction reverts
 func WaitMined(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	client := GetClient()
 	if client == nil {
-		return nil, errors.New("Ethereum client not initialized")
+		return nil, errors.Wrap(LastError(), "Ethereum client not connected")
 	}
 
 	// Get transaction by hash
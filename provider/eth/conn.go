@@ -0,0 +1,218 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/provider/tracing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// defaultDialTimeout bounds a connection attempt when no timeout is configured
+	defaultDialTimeout = 30 * time.Second
+	// defaultReconnectInterval is the delay before the first reconnection attempt
+	defaultReconnectInterval = time.Second
+	// defaultMaxReconnectInterval caps the reconnection delay
+	defaultMaxReconnectInterval = 30 * time.Second
+	// defaultHeartbeatInterval is how often a connected client verifies the node
+	defaultHeartbeatInterval = 15 * time.Second
+)
+
+// ErrNotConnected is reported while the client has not connected to the node yet
+var ErrNotConnected = errors.New("ethereum client not connected")
+
+var (
+	// clientMu guards client, connErr and nextAttempt
+	clientMu sync.RWMutex
+	// connErr is the error of the last failed connection attempt or heartbeat
+	connErr error
+	// nextAttempt is the time of the next reconnection attempt while disconnected
+	nextAttempt time.Time
+)
+
+// GetClient returns the global Ethereum client instance.
+// Returns nil while the client is not connected; a disconnected client is reconnected
+// in the background, so callers must not keep the instance across requests.
+func GetClient() *ethclient.Client {
+	clientMu.RLock()
+	defer clientMu.RUnlock()
+	return client
+}
+
+// Connected reports whether the client is connected to the node
+func Connected() bool {
+	return GetClient() != nil
+}
+
+// LastError returns why the client is not connected, or nil while it is connected
+func LastError() error {
+	clientMu.RLock()
+	defer clientMu.RUnlock()
+	if client != nil {
+		return nil
+	}
+	if connErr == nil {
+		return ErrNotConnected
+	}
+	return connErr
+}
+
+// RetryAfter returns how long until the next reconnection attempt, at least one second
+// while the client is disconnected and zero while it is connected
+func RetryAfter() time.Duration {
+	clientMu.RLock()
+	defer clientMu.RUnlock()
+	if client != nil {
+		return 0
+	}
+	if wait := time.Until(nextAttempt); wait > time.Second {
+		return wait.Round(time.Second)
+	}
+	return time.Second
+}
+
+// connect dials the node and installs the client once its chain ID is verified
+func connect(ctx context.Context, cfg *conf.Ethereum) error {
+	ctx, span := tracing.Start(ctx, "eth.Dial", trace.WithAttributes(attribute.Int64("eth.chain_id", cfg.ChainId)))
+	defer span.End()
+
+	ethClient, err := dial(ctx, cfg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		disconnect(err)
+		return err
+	}
+
+	clientMu.Lock()
+	previous := client
+	client, connErr = ethClient, nil
+	clientMu.Unlock()
+	if previous != nil {
+		previous.Close()
+	}
+
+	// Pending transactions may have been dropped by a restarted node, so the nonces
+	// are read from the node again
+	resetNonces()
+	return nil
+}
+
+// dial connects to the node and verifies that it serves the configured chain
+func dial(ctx context.Context, cfg *conf.Ethereum) (*ethclient.Client, error) {
+	timeout := defaultDialTimeout
+	if cfg.Timeout != nil {
+		timeout = cfg.Timeout.AsDuration()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The HTTP client records per-method latency and errors once metrics are enabled
+	rpcClient, err := rpc.DialOptions(ctx, cfg.RpcUrl, rpc.WithHTTPClient(newHTTPClient()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Ethereum node")
+	}
+	ethClient := ethclient.NewClient(rpcClient)
+
+	if err := verifyChainID(ctx, ethClient, cfg.ChainId); err != nil {
+		ethClient.Close()
+		return nil, err
+	}
+	return ethClient, nil
+}
+
+// verifyChainID checks that the node serves the expected chain
+func verifyChainID(ctx context.Context, ethClient *ethclient.Client, expected int64) error {
+	chainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain ID")
+	}
+	if chainID.Cmp(big.NewInt(expected)) != 0 {
+		return errors.Errorf("chain ID mismatch: expected %d, got %s", expected, chainID)
+	}
+	return nil
+}
+
+// disconnect drops the client after a failed connection attempt or heartbeat.
+// Requests holding the dropped client fail and are retried by their callers.
+func disconnect(err error) {
+	clientMu.Lock()
+	previous := client
+	client, connErr = nil, err
+	clientMu.Unlock()
+	if previous != nil {
+		previous.Close()
+	}
+}
+
+// supervise keeps the client connected for the lifetime of the process.
+// While disconnected it reconnects with exponential backoff; while connected it checks
+// the node and its chain ID every heartbeat interval and drops the client when the
+// check fails, so that a dropped HTTP or websocket connection is re-established.
+func supervise(cfg *conf.Ethereum) {
+	l := log.NewHelper(logger)
+	minDelay := durationOr(cfg.GetReconnectInterval().AsDuration(), defaultReconnectInterval)
+	maxDelay := durationOr(cfg.GetMaxReconnectInterval().AsDuration(), defaultMaxReconnectInterval)
+	heartbeat := durationOr(cfg.GetHeartbeatInterval().AsDuration(), defaultHeartbeatInterval)
+	if maxDelay < minDelay {
+		maxDelay = minDelay
+	}
+
+	delay := minDelay
+	attempt := 0
+	for {
+		if ethClient := GetClient(); ethClient != nil {
+			time.Sleep(heartbeat)
+			if err := checkConnection(ethClient, cfg); err != nil {
+				l.Warnf("Ethereum node connection lost, reconnecting: %v", err)
+				disconnect(err)
+				delay, attempt = minDelay, 0
+			}
+			continue
+		}
+
+		clientMu.Lock()
+		nextAttempt = time.Now().Add(delay)
+		clientMu.Unlock()
+		time.Sleep(delay)
+
+		attempt++
+		if err := connect(context.Background(), cfg); err != nil {
+			delay = min(delay*2, maxDelay)
+			l.Warnf("Ethereum node reconnection attempt %d failed, retrying in %v: %v", attempt, delay, err)
+			continue
+		}
+		l.Infof("Ethereum client reconnected after %d attempts: chain_id=%d, rpc_url=%s", attempt, cfg.ChainId, cfg.RpcUrl)
+		delay, attempt = minDelay, 0
+	}
+}
+
+// checkConnection verifies that a connected node still answers and serves the chain
+func checkConnection(ethClient *ethclient.Client, cfg *conf.Ethereum) error {
+	timeout := defaultDialTimeout
+	if cfg.Timeout != nil {
+		timeout = cfg.Timeout.AsDuration()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return verifyChainID(ctx, ethClient, cfg.ChainId)
+}
+
+// durationOr returns d, or def when d is not positive
+func durationOr(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
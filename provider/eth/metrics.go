@@ -0,0 +1,393 @@
+package eth
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"eth-contract-service/provider/contract/disperse"
+	"eth-contract-service/provider/contract/erc1155"
+	"eth-contract-service/provider/contract/erc20"
+	"eth-contract-service/provider/contract/erc721"
+	"eth-contract-service/provider/contract/forwarder"
+	"eth-contract-service/provider/contract/safe"
+	"eth-contract-service/provider/keystore"
+	"eth-contract-service/provider/tracing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// metricsRPCTimeout bounds the node queries made by the receipt poller and gauges
+const metricsRPCTimeout = 5 * time.Second
+
+var (
+	// metricsEnabled switches on request instrumentation and transaction tracking
+	metricsEnabled atomic.Bool
+
+	// rpcDuration records JSON-RPC request latency by method
+	rpcDuration metric.Float64Histogram
+	// rpcErrors counts failed JSON-RPC requests by method and error kind
+	rpcErrors metric.Int64Counter
+	// txSent counts transactions broadcast by contract and method
+	txSent metric.Int64Counter
+	// txMined counts successfully mined transactions by contract and method
+	txMined metric.Int64Counter
+	// txReverted counts reverted transactions by contract and method
+	txReverted metric.Int64Counter
+	// txReplaced counts transactions whose nonce was taken by another transaction
+	txReplaced metric.Int64Counter
+	// txGasUsed counts gas used by mined transactions by contract and method
+	txGasUsed metric.Int64Counter
+	// txFee counts fees paid by mined transactions in ether by contract and method
+	txFee metric.Float64Counter
+
+	// pendingTxs tracks broadcast transactions until their receipt is seen
+	pendingTxs = make(map[common.Hash]*pendingTx)
+	// pendingMu guards pendingTxs
+	pendingMu sync.Mutex
+
+	// selectors maps the function selectors of the contract bindings to method names
+	selectors map[[4]byte]string
+	// selectorsOnce ensures the selector table is built only once
+	selectorsOnce sync.Once
+)
+
+// pendingTx is a broadcast transaction waiting for its receipt
+type pendingTx struct {
+	from     common.Address
+	nonce    uint64
+	contract string
+	method   string
+	sentAt   time.Time
+}
+
+// InitMetrics creates the Ethereum metrics and starts the receipt poller.
+// Metrics cover JSON-RPC latency and errors per method, transactions sent, mined,
+// reverted and replaced by contract and method, gas and fees spent, pending transaction
+// age, nonce gaps and signer balances. Only HTTP connections to the node are instrumented.
+//
+// Parameters:
+//   - meter: Meter creating the instruments
+//   - pollInterval: How often receipts of broadcast transactions are checked
+//   - logKratos: Logger instance for metrics logging
+//
+// Returns:
+//   - error: Error if an instrument cannot be created
+func InitMetrics(meter metric.Meter, pollInterval time.Duration, logKratos log.Logger) error {
+	var err error
+	if rpcDuration, err = meter.Float64Histogram("eth_rpc_request_duration_seconds",
+		metric.WithDescription("Ethereum JSON-RPC request latency by method"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10)); err != nil {
+		return errors.Wrap(err, "failed to create RPC duration metric")
+	}
+	if rpcErrors, err = meter.Int64Counter("eth_rpc_errors_total",
+		metric.WithDescription("Failed Ethereum JSON-RPC requests by method and kind")); err != nil {
+		return errors.Wrap(err, "failed to create RPC error metric")
+	}
+	if txSent, err = meter.Int64Counter("eth_transactions_sent_total",
+		metric.WithDescription("Transactions broadcast by contract and method")); err != nil {
+		return errors.Wrap(err, "failed to create sent transactions metric")
+	}
+	if txMined, err = meter.Int64Counter("eth_transactions_mined_total",
+		metric.WithDescription("Transactions mined successfully by contract and method")); err != nil {
+		return errors.Wrap(err, "failed to create mined transactions metric")
+	}
+	if txReverted, err = meter.Int64Counter("eth_transactions_reverted_total",
+		metric.WithDescription("Transactions mined with a reverted status by contract and method")); err != nil {
+		return errors.Wrap(err, "failed to create reverted transactions metric")
+	}
+	if txReplaced, err = meter.Int64Counter("eth_transactions_replaced_total",
+		metric.WithDescription("Transactions whose nonce was used by another transaction")); err != nil {
+		return errors.Wrap(err, "failed to create replaced transactions metric")
+	}
+	if txGasUsed, err = meter.Int64Counter("eth_transaction_gas_used_total",
+		metric.WithDescription("Gas used by mined transactions by contract and method")); err != nil {
+		return errors.Wrap(err, "failed to create gas used metric")
+	}
+	if txFee, err = meter.Float64Counter("eth_transaction_fee_ether_total",
+		metric.WithDescription("Fees paid by mined transactions in ether by contract and method")); err != nil {
+		return errors.Wrap(err, "failed to create transaction fee metric")
+	}
+	if err := registerChainGauges(meter); err != nil {
+		return err
+	}
+
+	metricsEnabled.Store(true)
+	go pollReceipts(context.Background(), pollInterval)
+
+	log.NewHelper(logKratos).Infof("Ethereum metrics initialized: receipt_poll_interval=%v", pollInterval)
+	return nil
+}
+
+// trackRawTransaction records a broadcast transaction and watches it until it is mined
+func trackRawTransaction(ctx context.Context, param json.RawMessage) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(param, &raw); err != nil {
+		return
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return
+	}
+
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	if _, ok := pendingTxs[tx.Hash()]; ok {
+		return
+	}
+	contract, method := describeTx(tx)
+	pendingTxs[tx.Hash()] = &pendingTx{
+		from:     from,
+		nonce:    tx.Nonce(),
+		contract: contract,
+		method:   method,
+		sentAt:   time.Now(),
+	}
+	txSent.Add(ctx, 1, metric.WithAttributes(txAttributes(contract, method)...))
+}
+
+// describeTx returns the contract and method labels of a transaction.
+// Contracts are named after the ethereum.contracts configuration when listed there.
+func describeTx(tx *types.Transaction) (string, string) {
+	if tx.To() == nil {
+		return "", "deploy"
+	}
+
+	contract := tx.To().Hex()
+	for name, addr := range GetConfig().GetContracts() {
+		if common.IsHexAddress(addr) && common.HexToAddress(addr) == *tx.To() {
+			contract = name
+			break
+		}
+	}
+
+	data := tx.Data()
+	if len(data) < 4 {
+		return contract, "transfer"
+	}
+	selectorsOnce.Do(loadSelectors)
+	if name, ok := selectors[[4]byte(data[:4])]; ok {
+		return contract, name
+	}
+	return contract, "0x" + hex.EncodeToString(data[:4])
+}
+
+// loadSelectors builds the selector table from the contract bindings
+func loadSelectors() {
+	selectors = make(map[[4]byte]string)
+	for _, md := range []*bind.MetaData{
+		erc20.ERC20TokenMetaData,
+		erc20.ERC20TokenOwnableMetaData,
+		erc20.ERC20PermitMetaData,
+		erc721.Erc721MetaData,
+		erc721.ERC721URIMintableMetaData,
+		erc1155.Erc1155MetaData,
+		disperse.DisperseMetaData,
+		forwarder.MinimalForwarderMetaData,
+		safe.SafeMetaData,
+	} {
+		parsed, err := md.GetAbi()
+		if err != nil {
+			continue
+		}
+		for _, m := range parsed.Methods {
+			selectors[[4]byte(m.ID)] = m.RawName
+		}
+	}
+}
+
+// txAttributes returns the metric attributes of a transaction
+func txAttributes(contract, method string) []attribute.KeyValue {
+	return []attribute.KeyValue{attribute.String("contract", contract), attribute.String("method", method)}
+}
+
+// pollReceipts checks the receipts of tracked transactions until ctx is canceled
+func pollReceipts(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			checkReceipts(ctx)
+		}
+	}
+}
+
+// checkReceipts records the outcome of tracked transactions that were mined or replaced.
+// The confirmed nonces are read before the receipts, so a transaction without a receipt
+// whose nonce is already confirmed was replaced rather than mined in between.
+func checkReceipts(ctx context.Context) {
+	client := GetClient()
+	if client == nil {
+		return
+	}
+
+	pendingMu.Lock()
+	snapshot := make(map[common.Hash]pendingTx, len(pendingTxs))
+	for hash, p := range pendingTxs {
+		snapshot[hash] = *p
+	}
+	pendingMu.Unlock()
+	if len(snapshot) == 0 {
+		return
+	}
+	ctx, span := tracing.Start(ctx, "eth.CheckReceipts",
+		trace.WithAttributes(attribute.Int("eth.pending_transactions", len(snapshot))))
+	defer span.End()
+
+	confirmed := make(map[common.Address]uint64)
+	for _, p := range snapshot {
+		if _, ok := confirmed[p.from]; ok {
+			continue
+		}
+		qctx, cancel := context.WithTimeout(ctx, metricsRPCTimeout)
+		nonce, err := client.NonceAt(qctx, p.from, nil)
+		cancel()
+		if err == nil {
+			confirmed[p.from] = nonce
+		}
+	}
+
+	for hash, p := range snapshot {
+		qctx, cancel := context.WithTimeout(ctx, metricsRPCTimeout)
+		receipt, err := client.TransactionReceipt(qctx, hash)
+		cancel()
+		attrs := metric.WithAttributes(txAttributes(p.contract, p.method)...)
+		if errors.Is(err, ethereum.NotFound) {
+			if nonce, ok := confirmed[p.from]; ok && p.nonce < nonce {
+				txReplaced.Add(ctx, 1, attrs)
+				untrackTx(hash)
+			}
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			txMined.Add(ctx, 1, attrs)
+		} else {
+			txReverted.Add(ctx, 1, attrs)
+		}
+		txGasUsed.Add(ctx, int64(receipt.GasUsed), attrs)
+		if receipt.EffectiveGasPrice != nil {
+			fee := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+			txFee.Add(ctx, weiToEther(fee), attrs)
+		}
+		untrackTx(hash)
+	}
+}
+
+// untrackTx stops watching a transaction
+func untrackTx(hash common.Hash) {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	delete(pendingTxs, hash)
+}
+
+// registerChainGauges registers the pending transaction, nonce gap and signer balance gauges
+func registerChainGauges(meter metric.Meter) error {
+	pendingCount, err := meter.Int64ObservableGauge("eth_pending_transactions",
+		metric.WithDescription("Broadcast transactions without a receipt by sender"))
+	if err != nil {
+		return errors.Wrap(err, "failed to create pending transactions metric")
+	}
+	pendingAge, err := meter.Float64ObservableGauge("eth_pending_transaction_age_seconds",
+		metric.WithDescription("Age of the oldest transaction without a receipt by sender"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return errors.Wrap(err, "failed to create pending transaction age metric")
+	}
+	nonceGap, err := meter.Int64ObservableGauge("eth_nonce_gap",
+		metric.WithDescription("Nonces reserved locally but not yet pending on the node by sender"))
+	if err != nil {
+		return errors.Wrap(err, "failed to create nonce gap metric")
+	}
+	balance, err := meter.Float64ObservableGauge("eth_signer_balance_ether",
+		metric.WithDescription("Native balance of the configured signers in ether"))
+	if err != nil {
+		return errors.Wrap(err, "failed to create signer balance metric")
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		now := time.Now()
+		counts := make(map[common.Address]int64)
+		oldest := make(map[common.Address]time.Time)
+		pendingMu.Lock()
+		for _, p := range pendingTxs {
+			counts[p.from]++
+			if t, ok := oldest[p.from]; !ok || p.sentAt.Before(t) {
+				oldest[p.from] = p.sentAt
+			}
+		}
+		pendingMu.Unlock()
+		for from, count := range counts {
+			sender := metric.WithAttributes(attribute.String("sender", from.Hex()))
+			o.ObserveInt64(pendingCount, count, sender)
+			o.ObserveFloat64(pendingAge, now.Sub(oldest[from]).Seconds(), sender)
+		}
+
+		client := GetClient()
+		if client == nil {
+			return nil
+		}
+		qctx, cancel := context.WithTimeout(ctx, metricsRPCTimeout)
+		defer cancel()
+
+		nonceMu.Lock()
+		local := make(map[common.Address]uint64, len(nonces))
+		for addr, next := range nonces {
+			local[addr] = next
+		}
+		nonceMu.Unlock()
+		for addr, next := range local {
+			pending, err := client.PendingNonceAt(qctx, addr)
+			if err != nil {
+				continue
+			}
+			o.ObserveInt64(nonceGap, int64(next)-int64(pending),
+				metric.WithAttributes(attribute.String("sender", addr.Hex())))
+		}
+
+		for name, addr := range keystore.SignerAddresses() {
+			wei, err := client.BalanceAt(qctx, addr, nil)
+			if err != nil {
+				continue
+			}
+			o.ObserveFloat64(balance, weiToEther(wei), metric.WithAttributes(
+				attribute.String("signer", name), attribute.String("address", addr.Hex())))
+		}
+		return nil
+	}, pendingCount, pendingAge, nonceGap, balance)
+	if err != nil {
+		return errors.Wrap(err, "failed to register chain metrics")
+	}
+	return nil
+}
+
+// weiToEther converts an amount in wei to ether
+func weiToEther(wei *big.Int) float64 {
+	ether, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(1e18)).Float64()
+	return ether
+}
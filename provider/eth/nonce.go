@@ -0,0 +1,103 @@
+package eth
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// errNonceTooLow is the message of the node error for a nonce that was already used
+const errNonceTooLow = "nonce too low"
+
+var (
+	// nonces tracks the next nonce to hand out per sender address
+	nonces = make(map[common.Address]uint64)
+	// nonceMu guards nonces
+	nonceMu sync.Mutex
+)
+
+// NextNonce returns the next nonce for the given address and reserves it.
+// Every call reads the pending nonce from the node and hands out the larger of it and the
+// locally tracked nonce, so that concurrent senders never collide and transactions sent by
+// the contract bindings without a reserved nonce are not reused.
+//
+// Parameters:
+//   - ctx: Context for the node query
+//   - addr: The sender address
+//
+// Returns:
+//   - uint64: The reserved nonce
+//   - error: Error if the pending nonce cannot be fetched
+func NextNonce(ctx context.Context, addr common.Address) (uint64, error) {
+	client := GetClient()
+	if client == nil {
+		return 0, errors.Wrap(LastError(), "Ethereum client not connected")
+	}
+	pending, err := client.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get pending nonce for %s", addr.Hex())
+	}
+
+	nonceMu.Lock()
+	defer nonceMu.Unlock()
+
+	next := max(nonces[addr], pending)
+	nonces[addr] = next + 1
+	return next, nil
+}
+
+// ReleaseNonce returns an unused nonce reserved by NextNonce.
+// If it was the most recently reserved nonce it is handed out again; otherwise the
+// local state is dropped and re-synchronized with the node on the next call.
+func ReleaseNonce(addr common.Address, nonce uint64) {
+	nonceMu.Lock()
+	defer nonceMu.Unlock()
+
+	if next, ok := nonces[addr]; ok && next == nonce+1 {
+		nonces[addr] = nonce
+		return
+	}
+	delete(nonces, addr)
+}
+
+// ReturnNonce gives back a nonce reserved by NextNonce after sending with it failed.
+// A nonce the node reports as too low has been used already, so the local state is reset
+// instead of handing the nonce out again.
+func ReturnNonce(addr common.Address, nonce uint64, err error) {
+	if IsNonceTooLow(err) {
+		ResetNonce(addr)
+		return
+	}
+	ReleaseNonce(addr, nonce)
+}
+
+// IsNonceTooLow reports whether err is the node rejecting a transaction whose nonce was
+// already used
+func IsNonceTooLow(err error) bool {
+	return err != nil && strings.Contains(err.Error(), errNonceTooLow)
+}
+
+// IsKnownTransaction reports whether err is the node rejecting a broadcast only because it
+// already has the transaction
+func IsKnownTransaction(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction")
+}
+
+// ResetNonce drops the locally tracked nonce for the given address so that the next
+// call to NextNonce re-reads the pending nonce from the node.
+func ResetNonce(addr common.Address) {
+	nonceMu.Lock()
+	defer nonceMu.Unlock()
+	delete(nonces, addr)
+}
+
+// resetNonces drops the locally tracked nonces of all addresses
+func resetNonces() {
+	nonceMu.Lock()
+	defer nonceMu.Unlock()
+	clear(nonces)
+}
@@ -0,0 +1,156 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"eth-contract-service/provider/tracing"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// rpcErrorTransport marks requests that did not get an HTTP response
+	rpcErrorTransport = "transport"
+	// rpcErrorHTTP marks requests answered with a non-200 HTTP status
+	rpcErrorHTTP = "http"
+	// rpcErrorRPC marks requests answered with a JSON-RPC error object
+	rpcErrorRPC = "rpc"
+)
+
+// rpcMessage is a JSON-RPC request or response
+type rpcMessage struct {
+	ID     json.RawMessage   `json:"id,omitempty"`
+	Method string            `json:"method,omitempty"`
+	Params []json.RawMessage `json:"params,omitempty"`
+	Error  json.RawMessage   `json:"error,omitempty"`
+}
+
+// rpcTransport is an HTTP transport tracing and recording the latency and errors of
+// JSON-RPC calls, and tracking the transactions passed to eth_sendRawTransaction.
+type rpcTransport struct {
+	base http.RoundTripper
+}
+
+// newHTTPClient returns the HTTP client used to reach the node
+func newHTTPClient() *http.Client {
+	return &http.Client{Transport: &rpcTransport{base: http.DefaultTransport}}
+}
+
+// RoundTrip sends the request in a span named after the JSON-RPC method and records one
+// observation per call it carries
+func (t *rpcTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	record := metricsEnabled.Load()
+	if req.Body == nil || (!record && !tracing.Enabled()) {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	calls := parseRPCMessages(body)
+
+	ctx, span := startRPCSpan(req.Context(), calls)
+	defer span.End()
+	req = req.Clone(ctx)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start).Seconds()
+
+	if record {
+		for _, call := range calls {
+			rpcDuration.Record(ctx, elapsed, metric.WithAttributes(attribute.String("method", call.Method)))
+		}
+	}
+	if err != nil {
+		failRPC(ctx, span, calls, rpcErrorTransport, err)
+		return resp, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		failRPC(ctx, span, calls, rpcErrorHTTP, errors.Errorf("unexpected HTTP status %d", resp.StatusCode))
+		return resp, nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	if err != nil {
+		failRPC(ctx, span, calls, rpcErrorTransport, err)
+		return resp, nil
+	}
+
+	failed := make(map[string]string)
+	for _, msg := range parseRPCMessages(respBody) {
+		if len(msg.Error) > 0 && string(msg.Error) != "null" {
+			failed[string(msg.ID)] = string(msg.Error)
+		}
+	}
+	for _, call := range calls {
+		if rpcErr, ok := failed[string(call.ID)]; ok {
+			failRPC(ctx, span, []rpcMessage{call}, rpcErrorRPC, errors.New(rpcErr))
+			continue
+		}
+		if record && call.Method == "eth_sendRawTransaction" && len(call.Params) > 0 {
+			trackRawTransaction(ctx, call.Params[0])
+		}
+	}
+	return resp, nil
+}
+
+// startRPCSpan starts the client span of a JSON-RPC request
+func startRPCSpan(ctx context.Context, calls []rpcMessage) (context.Context, trace.Span) {
+	name := "eth_batch"
+	attrs := []attribute.KeyValue{attribute.String("rpc.system", "jsonrpc")}
+	if len(calls) == 1 {
+		name = calls[0].Method
+		attrs = append(attrs, attribute.String("rpc.method", calls[0].Method))
+	} else {
+		attrs = append(attrs, attribute.Int("rpc.jsonrpc.batch_size", len(calls)))
+	}
+	return tracing.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// failRPC marks the span as failed and counts an error of the given kind for every call
+func failRPC(ctx context.Context, span trace.Span, calls []rpcMessage, kind string, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	if !metricsEnabled.Load() {
+		return
+	}
+	for _, call := range calls {
+		rpcErrors.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("method", call.Method), attribute.String("kind", kind)))
+	}
+}
+
+// parseRPCMessages decodes a single or batched JSON-RPC message body
+func parseRPCMessages(body []byte) []rpcMessage {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var msgs []rpcMessage
+		if err := json.Unmarshal(trimmed, &msgs); err != nil {
+			return nil
+		}
+		return msgs
+	}
+	var msg rpcMessage
+	if err := json.Unmarshal(trimmed, &msg); err != nil {
+		return nil
+	}
+	return []rpcMessage{msg}
+}
@@ -0,0 +1,72 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+
+	pkgErrors "github.com/pkg/errors"
+)
+
+var (
+	// allowUseAdmin reports whether requests may sign with the admin signer
+	allowUseAdmin bool
+	// adminTokens holds the SHA-256 digests of the admin API tokens
+	adminTokens [][sha256.Size]byte
+	// accessMu guards allowUseAdmin and adminTokens
+	accessMu sync.RWMutex
+)
+
+// InitAdminAccess applies the settings that control signing with the admin signer on
+// behalf of API requests. A nil configuration keeps use_admin disabled.
+//
+// Parameters:
+//   - cfg: Admin configuration (optional)
+//
+// Returns:
+//   - error: Error if use_admin is allowed without API tokens or a token is empty
+func InitAdminAccess(cfg *conf.Admin) error {
+	allow := cfg.GetAllowUseAdmin()
+	tokens := make([][sha256.Size]byte, 0, len(cfg.GetApiTokens()))
+	for _, t := range cfg.GetApiTokens() {
+		if t == "" {
+			return pkgErrors.New("admin.api_tokens cannot contain empty tokens")
+		}
+		tokens = append(tokens, sha256.Sum256([]byte(t)))
+	}
+	if allow && len(tokens) == 0 {
+		return pkgErrors.New("admin.api_tokens is required when admin.allow_use_admin is set")
+	}
+
+	accessMu.Lock()
+	defer accessMu.Unlock()
+	allowUseAdmin = allow
+	adminTokens = tokens
+	return nil
+}
+
+// AdminUseAllowed reports whether requests may sign with the admin signer through use_admin.
+func AdminUseAllowed() bool {
+	accessMu.RLock()
+	defer accessMu.RUnlock()
+	return allowUseAdmin
+}
+
+// AuthorizeAdmin reports whether token is one of the configured admin API tokens.
+// Tokens are compared by digest in constant time.
+func AuthorizeAdmin(token string) bool {
+	if token == "" {
+		return false
+	}
+	digest := sha256.Sum256([]byte(token))
+
+	accessMu.RLock()
+	defer accessMu.RUnlock()
+	ok := 0
+	for _, t := range adminTokens {
+		ok |= subtle.ConstantTimeCompare(digest[:], t[:])
+	}
+	return ok == 1
+}
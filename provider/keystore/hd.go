@@ -0,0 +1,292 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	pkgErrors "github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// SignerHDPrefix prefixes signer names that reference an HD wallet account, e.g. hd:42
+	SignerHDPrefix = "hd:"
+	// HDSecretMnemonic marks an encrypted HD wallet file holding a BIP-39 mnemonic
+	HDSecretMnemonic = "mnemonic"
+	// HDSecretSeed marks an encrypted HD wallet file holding a hex encoded BIP-32 seed
+	HDSecretSeed = "seed"
+
+	// hdFileVersion is the version of the encrypted HD wallet file format
+	hdFileVersion = 1
+	// defaultHDBasePath is the BIP-44 Ethereum account chain
+	defaultHDBasePath = "m/44'/60'/0'/0"
+	// hardenedOffset is the first hardened BIP-32 child index
+	hardenedOffset = 0x80000000
+	// maxHDIndex is the largest non-hardened child index
+	maxHDIndex = hardenedOffset - 1
+)
+
+var (
+	// hdBase is the extended key of the account chain
+	hdBase *extendedKey
+	// hdBasePath is the derivation path of the account chain
+	hdBasePath accounts.DerivationPath
+	// hdOnce ensures the HD wallet is loaded only once
+	hdOnce sync.Once
+	// hdErr stores any error during HD wallet initialization
+	hdErr error
+)
+
+// hdFile is the encrypted HD wallet file. The secret is encrypted with the
+// keystore v3 scrypt/AES-128-CTR scheme.
+type hdFile struct {
+	Version int                 `json:"version"`
+	Type    string              `json:"type"`
+	Crypto  keystore.CryptoJSON `json:"crypto"`
+}
+
+// extendedKey is a BIP-32 extended private key
+type extendedKey struct {
+	key       *big.Int
+	chainCode []byte
+}
+
+// EncryptHDSecret encrypts a mnemonic or seed into the HD wallet file format.
+//
+// Parameters:
+//   - secretType: HDSecretMnemonic or HDSecretSeed
+//   - secret: The mnemonic words or the hex encoded seed
+//   - password: Password protecting the file
+//   - scryptN: scrypt CPU/memory cost, e.g. keystore.StandardScryptN
+//   - scryptP: scrypt parallelization, e.g. keystore.StandardScryptP
+//
+// Returns:
+//   - []byte: JSON encoded HD wallet file
+//   - error: Error if the secret is invalid or encryption fails
+func EncryptHDSecret(secretType, secret, password string, scryptN, scryptP int) ([]byte, error) {
+	if _, err := hdSeed(secretType, secret, ""); err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := keystore.EncryptDataV3([]byte(secret), []byte(password), scryptN, scryptP)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to encrypt HD wallet secret")
+	}
+	return json.Marshal(&hdFile{Version: hdFileVersion, Type: secretType, Crypto: cryptoJSON})
+}
+
+// InitHD loads the encrypted HD wallet and derives the account chain.
+// It uses sync.Once to ensure the wallet is loaded only once.
+//
+// Parameters:
+//   - ctx: Context for the initialization operation
+//   - cfg: HD wallet configuration containing file path, password and base path
+//   - logger: Logger instance for keystore logging
+//
+// Returns:
+//   - error: Error if loading, decryption or derivation fails
+func InitHD(ctx context.Context, cfg *conf.HDWallet, logger log.Logger) error {
+	if cfg == nil || cfg.Path == "" {
+		return pkgErrors.New("hd_wallet path cannot be empty")
+	}
+
+	hdOnce.Do(func() {
+		basePath := cfg.BasePath
+		if basePath == "" {
+			basePath = defaultHDBasePath
+		}
+		path, err := accounts.ParseDerivationPath(basePath)
+		if err != nil {
+			hdErr = pkgErrors.Wrapf(err, "invalid hd_wallet base_path: %s", basePath)
+			return
+		}
+
+		// Read and decrypt wallet file
+		data, err := os.ReadFile(cfg.Path)
+		if err != nil {
+			hdErr = pkgErrors.Wrapf(err, "failed to read HD wallet file: %s", cfg.Path)
+			return
+		}
+		var file hdFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			hdErr = pkgErrors.Wrap(err, "invalid HD wallet file")
+			return
+		}
+		if file.Version != hdFileVersion {
+			hdErr = pkgErrors.Errorf("unsupported HD wallet file version: %d", file.Version)
+			return
+		}
+		secret, err := keystore.DecryptDataV3(file.Crypto, cfg.Password)
+		if err != nil {
+			hdErr = pkgErrors.Wrap(err, "failed to decrypt HD wallet")
+			return
+		}
+		seed, err := hdSeed(file.Type, string(secret), cfg.Passphrase)
+		if err != nil {
+			hdErr = err
+			return
+		}
+
+		// Derive account chain
+		key, err := masterKey(seed)
+		if err != nil {
+			hdErr = err
+			return
+		}
+		for _, i := range path {
+			if key, err = key.child(i); err != nil {
+				hdErr = pkgErrors.Wrapf(err, "failed to derive %s", basePath)
+				return
+			}
+		}
+		hdBase = key
+		hdBasePath = path
+
+		first, _ := DeriveHDAddress(0)
+		log.NewHelper(logger).Infof("HD wallet loaded: base_path=%s, first_address=%s, path=%s", path.String(), first.Hex(), cfg.Path)
+	})
+
+	return hdErr
+}
+
+// IsHDInitialized returns true if the HD wallet has been loaded.
+func IsHDInitialized() bool {
+	return hdBase != nil
+}
+
+// HDPath returns the full derivation path of an HD wallet account.
+func HDPath(index uint32) accounts.DerivationPath {
+	path := make(accounts.DerivationPath, len(hdBasePath), len(hdBasePath)+1)
+	copy(path, hdBasePath)
+	return append(path, index)
+}
+
+// DeriveHDKey returns the private key of the HD wallet account at the given index.
+//
+// Parameters:
+//   - index: Non-hardened child index of the account chain
+//
+// Returns:
+//   - *ecdsa.PrivateKey: The private key of the account
+//   - error: Error if the wallet is not loaded or the index is out of range
+func DeriveHDKey(index uint32) (*ecdsa.PrivateKey, error) {
+	if hdBase == nil {
+		return nil, pkgErrors.New("HD wallet not initialized")
+	}
+	if index > maxHDIndex {
+		return nil, pkgErrors.Errorf("HD wallet index out of range: %d", index)
+	}
+	child, err := hdBase.child(index)
+	if err != nil {
+		return nil, pkgErrors.Wrapf(err, "failed to derive HD wallet index %d", index)
+	}
+	return crypto.ToECDSA(math.PaddedBigBytes(child.key, 32))
+}
+
+// DeriveHDAddress returns the address of the HD wallet account at the given index.
+func DeriveHDAddress(index uint32) (common.Address, error) {
+	key, err := DeriveHDKey(index)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+// ParseHDSigner parses a signer name of the form hd:<index>.
+// The boolean is false when the name does not reference the HD wallet.
+func ParseHDSigner(name string) (uint32, bool, error) {
+	if !strings.HasPrefix(name, SignerHDPrefix) {
+		return 0, false, nil
+	}
+	index, err := strconv.ParseUint(strings.TrimPrefix(name, SignerHDPrefix), 10, 32)
+	if err != nil || index > maxHDIndex {
+		return 0, true, pkgErrors.Errorf("invalid HD wallet signer: %s", name)
+	}
+	return uint32(index), true, nil
+}
+
+// hdSeed returns the BIP-32 seed of a decrypted HD wallet secret
+func hdSeed(secretType, secret, passphrase string) ([]byte, error) {
+	switch secretType {
+	case HDSecretMnemonic:
+		mnemonic := strings.Join(strings.Fields(secret), " ")
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, "invalid HD wallet mnemonic")
+		}
+		return seed, nil
+	case HDSecretSeed:
+		seed, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(secret), "0x"))
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, "invalid HD wallet seed")
+		}
+		// BIP-32 seeds are 128 to 512 bits
+		if len(seed) < 16 || len(seed) > 64 {
+			return nil, pkgErrors.Errorf("HD wallet seed must be 16 to 64 bytes, got %d", len(seed))
+		}
+		return seed, nil
+	default:
+		return nil, pkgErrors.Errorf("unsupported HD wallet secret type: %s", secretType)
+	}
+}
+
+// masterKey derives the BIP-32 master key of a seed
+func masterKey(seed []byte) (*extendedKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key := new(big.Int).SetBytes(sum[:32])
+	if key.Sign() == 0 || key.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, pkgErrors.New("invalid HD wallet master key")
+	}
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
+
+// child derives the BIP-32 child private key at index i; indices from 2^31 are hardened
+func (k *extendedKey) child(i uint32) (*extendedKey, error) {
+	var data []byte
+	if i >= hardenedOffset {
+		data = append([]byte{0}, math.PaddedBigBytes(k.key, 32)...)
+	} else {
+		priv, err := crypto.ToECDSA(math.PaddedBigBytes(k.key, 32))
+		if err != nil {
+			return nil, err
+		}
+		data = crypto.CompressPubkey(&priv.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, i)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(n) >= 0 {
+		return nil, pkgErrors.Errorf("invalid child key at index %d", i)
+	}
+	key := il.Add(il, k.key)
+	key.Mod(key, n)
+	if key.Sign() == 0 {
+		return nil, pkgErrors.Errorf("invalid child key at index %d", i)
+	}
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
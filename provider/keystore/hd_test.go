@@ -0,0 +1,269 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// testMnemonic is the well-known development mnemonic of Hardhat and Anvil
+const testMnemonic = "test test test test test test test test test test test junk"
+
+// h marks a hardened BIP-32 child index
+func h(i uint32) uint32 {
+	return i + hardenedOffset
+}
+
+// base58Alphabet is the Bitcoin base58 alphabet
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// decodeXprv decodes a base58check serialized BIP-32 extended private key
+func decodeXprv(t *testing.T, s string) *extendedKey {
+	t.Helper()
+	n := new(big.Int)
+	for _, c := range s {
+		i := bytes.IndexRune([]byte(base58Alphabet), c)
+		if i < 0 {
+			t.Fatalf("invalid base58 character %q in %s", c, s)
+		}
+		n.Mul(n, big.NewInt(58)).Add(n, big.NewInt(int64(i)))
+	}
+	raw := math.PaddedBigBytes(n, 82)
+	if len(raw) != 82 {
+		t.Fatalf("extended key %s decodes to %d bytes", s, len(raw))
+	}
+	payload, checksum := raw[:78], raw[78:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], checksum) {
+		t.Fatalf("extended key %s has an invalid checksum", s)
+	}
+	if !bytes.Equal(payload[:4], []byte{0x04, 0x88, 0xad, 0xe4}) || payload[45] != 0 {
+		t.Fatalf("%s is not a mainnet extended private key", s)
+	}
+	return &extendedKey{chainCode: payload[13:45], key: new(big.Int).SetBytes(payload[46:78])}
+}
+
+// assertExtendedKey compares a derived key with a serialized extended private key
+func assertExtendedKey(t *testing.T, step string, got *extendedKey, xprv string) {
+	t.Helper()
+	want := decodeXprv(t, xprv)
+	if got.key.Cmp(want.key) != 0 {
+		t.Fatalf("%s: private key = %x, want %x", step, math.PaddedBigBytes(got.key, 32), math.PaddedBigBytes(want.key, 32))
+	}
+	if !bytes.Equal(got.chainCode, want.chainCode) {
+		t.Fatalf("%s: chain code = %x, want %x", step, got.chainCode, want.chainCode)
+	}
+}
+
+// TestBIP32Vectors checks the derivation against the test vectors of BIP-32
+func TestBIP32Vectors(t *testing.T) {
+	type step struct {
+		index uint32
+		xprv  string
+	}
+	tests := []struct {
+		name string
+		// seed is the hex encoded seed; when empty, derivation starts from master
+		seed   string
+		master string
+		chain  []step
+	}{
+		{
+			name:   "vector 1",
+			seed:   "000102030405060708090a0b0c0d0e0f",
+			master: "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+			chain: []step{
+				{h(0), "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+				{1, "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+				{h(2), "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM"},
+				{2, "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334"},
+				{1000000000, "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76"},
+			},
+		},
+		{
+			name:   "vector 2",
+			seed:   "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+			master: "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U",
+			chain: []step{
+				{0, "xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt"},
+				{h(2147483647), "xprv9wSp6B7kry3Vj9m1zSnLvN3xH8RdsPP1Mh7fAaR7aRLcQMKTR2vidYEeEg2mUCTAwCd6vnxVrcjfy2kRgVsFawNzmjuHc2YmYRmagcEPdU9"},
+				{1, "xprv9zFnWC6h2cLgpmSA46vutJzBcfJ8yaJGg8cX1e5StJh45BBciYTRXSd25UEPVuesF9yog62tGAQtHjXajPPdbRCHuWS6T8XA2ECKADdw4Ef"},
+				{h(2147483646), "xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc"},
+				{2, "xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j"},
+			},
+		},
+		{
+			// Retention of leading zeros: the master private key starts with a zero byte
+			name:   "vector 3",
+			master: "xprv9s21ZrQH143K25QhxbucbDDuQ4naNntJRi4KUfWT7xo4EKsHt2QJDu7KXp1A3u7Bi1j8ph3EGsZ9Xvz9dGuVrtHHs7pXeTzjuxBrCmmhgC6",
+			chain: []step{
+				{h(0), "xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := decodeXprv(t, tt.master)
+			if tt.seed != "" {
+				seed, err := hex.DecodeString(tt.seed)
+				if err != nil {
+					t.Fatalf("invalid seed: %v", err)
+				}
+				if key, err = masterKey(seed); err != nil {
+					t.Fatalf("masterKey: %v", err)
+				}
+				assertExtendedKey(t, "m", key, tt.master)
+			}
+
+			path := accounts.DerivationPath{}
+			for _, s := range tt.chain {
+				var err error
+				if key, err = key.child(s.index); err != nil {
+					t.Fatalf("child(%d): %v", s.index, err)
+				}
+				path = append(path, s.index)
+				assertExtendedKey(t, path.String(), key, s.xprv)
+			}
+		})
+	}
+}
+
+// TestHDMnemonicAddresses checks BIP-39 seeds and BIP-44 Ethereum accounts of known mnemonics
+func TestHDMnemonicAddresses(t *testing.T) {
+	tests := []struct {
+		name       string
+		mnemonic   string
+		passphrase string
+		seed       string
+		accounts   map[uint32]string
+		privateKey string
+	}{
+		{
+			name:       "bip39 vector with passphrase",
+			mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			passphrase: "TREZOR",
+			seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			name: "development mnemonic",
+			// Extra whitespace is collapsed before the seed is computed
+			mnemonic: "  test test test test test test\ntest test test test test junk ",
+			accounts: map[uint32]string{
+				0: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+				1: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+				2: "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+			},
+			privateKey: "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		},
+	}
+
+	basePath, err := accounts.ParseDerivationPath(defaultHDBasePath)
+	if err != nil {
+		t.Fatalf("ParseDerivationPath: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed, err := hdSeed(HDSecretMnemonic, tt.mnemonic, tt.passphrase)
+			if err != nil {
+				t.Fatalf("hdSeed: %v", err)
+			}
+			if tt.seed != "" && hex.EncodeToString(seed) != tt.seed {
+				t.Fatalf("seed = %x, want %s", seed, tt.seed)
+			}
+
+			base, err := masterKey(seed)
+			if err != nil {
+				t.Fatalf("masterKey: %v", err)
+			}
+			for _, i := range basePath {
+				if base, err = base.child(i); err != nil {
+					t.Fatalf("child(%d): %v", i, err)
+				}
+			}
+			for index, want := range tt.accounts {
+				child, err := base.child(index)
+				if err != nil {
+					t.Fatalf("child(%d): %v", index, err)
+				}
+				key, err := crypto.ToECDSA(math.PaddedBigBytes(child.key, 32))
+				if err != nil {
+					t.Fatalf("ToECDSA: %v", err)
+				}
+				if got := crypto.PubkeyToAddress(key.PublicKey); got != common.HexToAddress(want) {
+					t.Fatalf("account %d = %s, want %s", index, got.Hex(), want)
+				}
+				if index == 0 && hex.EncodeToString(crypto.FromECDSA(key)) != tt.privateKey {
+					t.Fatalf("account 0 private key = %x, want %s", crypto.FromECDSA(key), tt.privateKey)
+				}
+			}
+		})
+	}
+}
+
+func TestHDSeedValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		secretType string
+		secret     string
+		wantErr    bool
+	}{
+		{name: "mnemonic", secretType: HDSecretMnemonic, secret: testMnemonic},
+		{name: "bad checksum", secretType: HDSecretMnemonic, secret: "test test test test test test test test test test test test", wantErr: true},
+		{name: "seed", secretType: HDSecretSeed, secret: "0x000102030405060708090a0b0c0d0e0f"},
+		{name: "short seed", secretType: HDSecretSeed, secret: "0001020304050607", wantErr: true},
+		{name: "invalid hex", secretType: HDSecretSeed, secret: "zz", wantErr: true},
+		{name: "unknown type", secretType: "xprv", secret: testMnemonic, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := hdSeed(tt.secretType, tt.secret, ""); (err != nil) != tt.wantErr {
+				t.Fatalf("hdSeed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestInitHD loads an encrypted development mnemonic and resolves hd:<index> signers
+func TestInitHD(t *testing.T) {
+	data, err := EncryptHDSecret(HDSecretMnemonic, testMnemonic, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("EncryptHDSecret: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "hd.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if err := InitHD(t.Context(), &conf.HDWallet{Path: path, Password: "secret"}, log.NewStdLogger(io.Discard)); err != nil {
+		t.Fatalf("InitHD: %v", err)
+	}
+	if got := HDPath(1).String(); got != "m/44'/60'/0'/0/1" {
+		t.Fatalf("HDPath(1) = %s", got)
+	}
+	signer, err := GetSigner("hd:1")
+	if err != nil {
+		t.Fatalf("GetSigner(hd:1): %v", err)
+	}
+	if want := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"); signer.Address() != want {
+		t.Fatalf("hd:1 = %s, want %s", signer.Address().Hex(), want.Hex())
+	}
+	if _, err := GetSigner("hd:2147483648"); err == nil {
+		t.Fatalf("hardened HD signer index was accepted")
+	}
+}
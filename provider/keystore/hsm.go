@@ -0,0 +1,134 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	pkgErrors "github.com/pkg/errors"
+)
+
+// HSMKey is a secp256k1 key held by a hardware security module, e.g. behind a PKCS#11
+// module. Implementations only produce raw ECDSA signatures; recovery IDs, low-S
+// normalization and Ethereum encodings are handled by the keystore.
+type HSMKey interface {
+	// PublicKey returns the public key of the HSM key
+	PublicKey(ctx context.Context) (*ecdsa.PublicKey, error)
+	// SignDigest returns the raw ECDSA signature (r, s) of a 32-byte digest
+	SignDigest(ctx context.Context, digest []byte) (r, s *big.Int, err error)
+}
+
+// HSMFactory opens the HSM key described by a signer configuration
+type HSMFactory func(ctx context.Context, cfg *conf.Signer) (HSMKey, error)
+
+var (
+	// hsmFactories stores the registered HSM backends by signer type
+	hsmFactories = make(map[string]HSMFactory)
+	// hsmMu guards hsmFactories
+	hsmMu sync.RWMutex
+)
+
+// RegisterHSM registers an HSM backend for a signer type such as pkcs11.
+// Backends that depend on cgo or vendor libraries live outside this package and call
+// RegisterHSM from an init function; signers of that type can then be configured.
+//
+// Parameters:
+//   - signerType: Signer type handled by the backend
+//   - factory: Function that opens the key of a signer configuration
+func RegisterHSM(signerType string, factory HSMFactory) {
+	hsmMu.Lock()
+	defer hsmMu.Unlock()
+	hsmFactories[signerType] = factory
+}
+
+// HSMSigner signs with a key held by a hardware security module
+type HSMSigner struct {
+	key     HSMKey
+	address common.Address
+}
+
+// newHSMSigner opens the key of a signer through the backend registered for its type
+func newHSMSigner(ctx context.Context, cfg *conf.Signer) (*HSMSigner, error) {
+	hsmMu.RLock()
+	factory, ok := hsmFactories[cfg.Type]
+	hsmMu.RUnlock()
+	if !ok {
+		return nil, pkgErrors.Errorf("unsupported signer type %q: no HSM backend registered", cfg.Type)
+	}
+
+	key, err := factory(ctx, cfg)
+	if err != nil {
+		return nil, pkgErrors.Wrapf(err, "failed to open %s key", cfg.Type)
+	}
+	pub, err := key.PublicKey(ctx)
+	if err != nil {
+		return nil, pkgErrors.Wrapf(err, "failed to read %s public key", cfg.Type)
+	}
+	return &HSMSigner{key: key, address: crypto.PubkeyToAddress(*pub)}, nil
+}
+
+// Address returns the account address of the signer
+func (s *HSMSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs a transaction for the given chain
+func (s *HSMSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	sig, err := s.signDigest(ctx, signer.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+	sig[64] -= 27
+	return tx.WithSignature(signer, sig)
+}
+
+// SignTypedData signs an EIP-712 typed data document
+func (s *HSMSigner) SignTypedData(ctx context.Context, td apitypes.TypedData) ([]byte, error) {
+	digest, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to hash typed data")
+	}
+	return s.signDigest(ctx, digest)
+}
+
+// SignText signs an EIP-191 personal message
+func (s *HSMSigner) SignText(ctx context.Context, message []byte) ([]byte, error) {
+	return s.signDigest(ctx, accounts.TextHash(message))
+}
+
+// signDigest signs a digest in the HSM and returns the signature with v in {27, 28}.
+// HSMs do not report a recovery ID, so both candidates are tried against the address.
+func (s *HSMSigner) signDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	r, sv, err := s.key.SignDigest(ctx, digest)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "HSM failed to sign digest")
+	}
+
+	// Ethereum only accepts signatures with s in the lower half of the curve order
+	n := crypto.S256().Params().N
+	if sv.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		sv = new(big.Int).Sub(n, sv)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], math.PaddedBigBytes(r, 32))
+	copy(sig[32:64], math.PaddedBigBytes(sv, 32))
+	for v := byte(0); v < 2; v++ {
+		sig[64] = v
+		if pub, err := crypto.SigToPub(digest, sig); err == nil && crypto.PubkeyToAddress(*pub) == s.address {
+			sig[64] += 27
+			return sig, nil
+		}
+	}
+	return nil, pkgErrors.New("HSM signature does not match the signer address")
+}
@@ -102,3 +102,6 @@ func IsInitialized() bool {
 	return adminKey != nil
 }
 
+
+// SignerAdmin is the name of the admin keystore signer
+const SignerAdmin = "admin"
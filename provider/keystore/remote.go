@@ -0,0 +1,205 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"net/http"
+	"time"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	pkgErrors "github.com/pkg/errors"
+)
+
+const (
+	// RemoteAPIWeb3Signer selects the eth_* methods served by Web3Signer
+	RemoteAPIWeb3Signer = "web3signer"
+	// RemoteAPIClef selects the account_* methods served by Clef
+	RemoteAPIClef = "clef"
+
+	// defaultRemoteTimeout is the request timeout used when none is configured
+	defaultRemoteTimeout = 10 * time.Second
+)
+
+// RemoteSigner delegates signing to an external signing service over JSON-RPC.
+// Every signature returned by the service is checked against the local transaction
+// or digest, so a misbehaving service cannot substitute another payload.
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+	api     string
+	timeout time.Duration
+}
+
+// NewRemoteSigner creates a signer backed by a Web3Signer or Clef endpoint.
+//
+// Parameters:
+//   - ctx: Context for dialing the endpoint
+//   - cfg: Signer configuration with url, address, api and timeout
+//
+// Returns:
+//   - *RemoteSigner: The remote signer
+//   - error: Error if the configuration is invalid
+func NewRemoteSigner(ctx context.Context, cfg *conf.Signer) (*RemoteSigner, error) {
+	if cfg.Url == "" {
+		return nil, pkgErrors.New("remote signer url cannot be empty")
+	}
+	if !common.IsHexAddress(cfg.Address) {
+		return nil, pkgErrors.Errorf("remote signer requires a valid address, got %q", cfg.Address)
+	}
+	api := cfg.Api
+	if api == "" {
+		api = RemoteAPIWeb3Signer
+	}
+	if api != RemoteAPIWeb3Signer && api != RemoteAPIClef {
+		return nil, pkgErrors.Errorf("unsupported remote signer api: %s", api)
+	}
+	timeout := defaultRemoteTimeout
+	if cfg.Timeout != nil {
+		timeout = cfg.Timeout.AsDuration()
+	}
+
+	client, err := rpc.DialOptions(ctx, cfg.Url, rpc.WithHTTPClient(&http.Client{Timeout: timeout}))
+	if err != nil {
+		return nil, pkgErrors.Wrapf(err, "failed to connect to remote signer: %s", cfg.Url)
+	}
+	return &RemoteSigner{
+		client:  client,
+		address: common.HexToAddress(cfg.Address),
+		api:     api,
+		timeout: timeout,
+	}, nil
+}
+
+// Address returns the account address of the signer
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs a transaction with eth_signTransaction or account_signTransaction
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := sendTxArgs(s.address, tx, chainID)
+
+	var raw hexutil.Bytes
+	switch s.api {
+	case RemoteAPIClef:
+		var result struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := s.call(ctx, &result, "account_signTransaction", args); err != nil {
+			return nil, err
+		}
+		raw = result.Raw
+	default:
+		if err := s.call(ctx, &raw, "eth_signTransaction", args); err != nil {
+			return nil, err
+		}
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, pkgErrors.Wrap(err, "remote signer returned an invalid transaction")
+	}
+	if err := checkSignedTx(tx, signed, s.address, chainID); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// SignTypedData signs an EIP-712 typed data document with eth_signTypedData or account_signTypedData
+func (s *RemoteSigner) SignTypedData(ctx context.Context, td apitypes.TypedData) ([]byte, error) {
+	digest, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to hash typed data")
+	}
+
+	method := "eth_signTypedData"
+	if s.api == RemoteAPIClef {
+		method = "account_signTypedData"
+	}
+	var sig hexutil.Bytes
+	if err := s.call(ctx, &sig, method, common.NewMixedcaseAddress(s.address), td); err != nil {
+		return nil, err
+	}
+	return checkSignature(digest, sig, s.address)
+}
+
+// SignText signs an EIP-191 personal message with eth_sign or account_signData
+func (s *RemoteSigner) SignText(ctx context.Context, message []byte) ([]byte, error) {
+	var sig hexutil.Bytes
+	var err error
+	switch s.api {
+	case RemoteAPIClef:
+		err = s.call(ctx, &sig, "account_signData", accounts.MimetypeTextPlain, common.NewMixedcaseAddress(s.address), hexutil.Bytes(message))
+	default:
+		err = s.call(ctx, &sig, "eth_sign", s.address, hexutil.Bytes(message))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return checkSignature(accounts.TextHash(message), sig, s.address)
+}
+
+// call performs a JSON-RPC request with the configured timeout
+func (s *RemoteSigner) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	if err := s.client.CallContext(ctx, result, method, args...); err != nil {
+		return pkgErrors.Wrapf(err, "remote signer %s failed", method)
+	}
+	return nil
+}
+
+// sendTxArgs converts an unsigned transaction to the transaction object of the signing APIs
+func sendTxArgs(from common.Address, tx *types.Transaction, chainID *big.Int) apitypes.SendTxArgs {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(from),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if to := tx.To(); to != nil {
+		mixed := common.NewMixedcaseAddress(*to)
+		args.To = &mixed
+	}
+	if tx.Type() == types.LegacyTxType {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	}
+	if accessList := tx.AccessList(); len(accessList) > 0 {
+		args.AccessList = &accessList
+	}
+	return args
+}
+
+// checkSignedTx checks that a transaction signed elsewhere matches the requested
+// transaction and was signed by the expected address
+func checkSignedTx(want, got *types.Transaction, from common.Address, chainID *big.Int) error {
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), got)
+	if err != nil {
+		return pkgErrors.Wrap(err, "failed to recover remote transaction sender")
+	}
+	if sender != from {
+		return pkgErrors.Errorf("remote transaction signed by %s, expected %s", sender.Hex(), from.Hex())
+	}
+
+	sameTo := (want.To() == nil && got.To() == nil) || (want.To() != nil && got.To() != nil && *want.To() == *got.To())
+	if !sameTo || got.Nonce() != want.Nonce() || got.Gas() != want.Gas() ||
+		got.Value().Cmp(want.Value()) != 0 || !bytes.Equal(got.Data(), want.Data()) ||
+		got.GasFeeCap().Cmp(want.GasFeeCap()) != 0 || got.GasTipCap().Cmp(want.GasTipCap()) != 0 {
+		return pkgErrors.New("remote signer returned a transaction that differs from the request")
+	}
+	return nil
+}
@@ -0,0 +1,336 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-kratos/kratos/v2/log"
+	pkgErrors "github.com/pkg/errors"
+)
+
+const (
+	// SignerTypeKeystore loads a keystore v3 file into process memory
+	SignerTypeKeystore = "keystore"
+	// SignerTypeRemote delegates signing to a Web3Signer or Clef JSON-RPC endpoint
+	SignerTypeRemote = "remote"
+	// SignerTypeMock derives a deterministic key from the signer name, for local development only
+	SignerTypeMock = "mock"
+)
+
+// Signer signs transactions and messages for a single account.
+// Implementations may keep the key outside of this process; callers never see it.
+// Message signatures are 65 bytes with v in {27, 28}.
+type Signer interface {
+	// Address returns the account address of the signer
+	Address() common.Address
+	// SignTx signs a transaction for the given chain
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignTypedData signs an EIP-712 typed data document
+	SignTypedData(ctx context.Context, td apitypes.TypedData) ([]byte, error)
+	// SignText signs an EIP-191 personal message
+	SignText(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// Resolver returns the signer for a signer name carrying the prefix it was registered with
+type Resolver func(name string) (Signer, error)
+
+var (
+	// signers stores the configured named signers
+	signers = make(map[string]Signer)
+	// signersMu guards signers, which can be replaced by a configuration reload
+	signersMu sync.RWMutex
+	// resolvers stores the signer resolvers by name prefix
+	resolvers = make(map[string]Resolver)
+	// resolversMu guards resolvers
+	resolversMu sync.RWMutex
+	// signersOnce ensures the signers are loaded only once
+	signersOnce sync.Once
+	// signersErr stores any error during signer initialization
+	signersErr error
+)
+
+// InitSigners loads the configured named signers.
+// It uses sync.Once to ensure the signers are loaded only once.
+//
+// Parameters:
+//   - ctx: Context for the initialization operation
+//   - cfgs: Signer configurations
+//   - logger: Logger instance for keystore logging
+//
+// Returns:
+//   - error: Error if a signer is misconfigured or cannot be loaded
+func InitSigners(ctx context.Context, cfgs []*conf.Signer, logger log.Logger) error {
+	signersOnce.Do(func() {
+		loaded, err := loadSigners(ctx, cfgs, logger)
+		if err != nil {
+			signersErr = err
+			return
+		}
+		signersMu.Lock()
+		signers = loaded
+		signersMu.Unlock()
+	})
+	return signersErr
+}
+
+// ReloadSigners loads a changed signer list and replaces the named signers.
+// The current signers stay in use when any signer of the new list cannot be loaded.
+//
+// Parameters:
+//   - ctx: Context for the load operation
+//   - cfgs: Signer configurations
+//   - logger: Logger instance for keystore logging
+//
+// Returns:
+//   - error: Error if a signer is misconfigured or cannot be loaded
+func ReloadSigners(ctx context.Context, cfgs []*conf.Signer, logger log.Logger) error {
+	loaded, err := loadSigners(ctx, cfgs, logger)
+	if err != nil {
+		return err
+	}
+	signersMu.Lock()
+	signers = loaded
+	signersMu.Unlock()
+	return nil
+}
+
+// loadSigners creates the named signers of a signer list
+func loadSigners(ctx context.Context, cfgs []*conf.Signer, logger log.Logger) (map[string]Signer, error) {
+	loaded := make(map[string]Signer, len(cfgs))
+	for _, cfg := range cfgs {
+		if cfg.GetName() == "" {
+			return nil, pkgErrors.New("signer name cannot be empty")
+		}
+		if strings.Contains(cfg.Name, ":") {
+			return nil, pkgErrors.Errorf("signer name %s cannot contain ':', prefixed names are reserved", cfg.Name)
+		}
+		if _, ok := loaded[cfg.Name]; ok {
+			return nil, pkgErrors.Errorf("duplicate signer: %s", cfg.Name)
+		}
+
+		signer, err := newSigner(ctx, cfg)
+		if err != nil {
+			return nil, pkgErrors.Wrapf(err, "failed to load signer %s", cfg.Name)
+		}
+		if cfg.Address != "" && signer.Address() != common.HexToAddress(cfg.Address) {
+			return nil, pkgErrors.Errorf("signer %s address mismatch: expected %s, got %s", cfg.Name, cfg.Address, signer.Address().Hex())
+		}
+		loaded[cfg.Name] = signer
+
+		log.NewHelper(logger).Infof("signer loaded: name=%s, type=%s, address=%s", cfg.Name, cfg.Type, signer.Address().Hex())
+	}
+	return loaded, nil
+}
+
+// RegisterResolver makes signer names starting with prefix resolvable through fn,
+// e.g. for keys managed outside of this package.
+//
+// Parameters:
+//   - prefix: Signer name prefix such as role:
+//   - fn: Function that resolves a full signer name
+func RegisterResolver(prefix string, fn Resolver) {
+	resolversMu.Lock()
+	defer resolversMu.Unlock()
+	resolvers[prefix] = fn
+}
+
+// GetSigner returns a named signer.
+// An empty name or admin resolves to the admin signer: a configured signer named admin,
+// otherwise the admin keystore. hd:<index> resolves to an HD wallet account, and names
+// with a registered prefix are passed to its resolver.
+//
+// Parameters:
+//   - name: Signer name
+//
+// Returns:
+//   - Signer: The signer
+//   - error: Error if the signer is unknown or not loaded
+func GetSigner(name string) (Signer, error) {
+	if name == "" {
+		name = SignerAdmin
+	}
+	signersMu.RLock()
+	signer, ok := signers[name]
+	signersMu.RUnlock()
+	if ok {
+		return signer, nil
+	}
+	if name == SignerAdmin {
+		if adminKey == nil {
+			return nil, pkgErrors.New("admin keystore not initialized")
+		}
+		return NewKeySigner(adminKey), nil
+	}
+	if index, ok, err := ParseHDSigner(name); ok {
+		if err != nil {
+			return nil, err
+		}
+		key, err := DeriveHDKey(index)
+		if err != nil {
+			return nil, err
+		}
+		return NewKeySigner(key), nil
+	}
+
+	resolversMu.RLock()
+	defer resolversMu.RUnlock()
+	for prefix, resolve := range resolvers {
+		if strings.HasPrefix(name, prefix) {
+			return resolve(name)
+		}
+	}
+	return nil, pkgErrors.Errorf("unknown signer: %s", name)
+}
+
+// SignerAddresses returns the addresses of the configured named signers and of the admin
+// keystore by signer name.
+func SignerAddresses() map[string]common.Address {
+	signersMu.RLock()
+	defer signersMu.RUnlock()
+
+	addrs := make(map[string]common.Address, len(signers)+1)
+	if adminKey != nil {
+		addrs[SignerAdmin] = adminAddress
+	}
+	for name, signer := range signers {
+		addrs[name] = signer.Address()
+	}
+	return addrs
+}
+
+// newSigner creates a signer from its configuration
+func newSigner(ctx context.Context, cfg *conf.Signer) (Signer, error) {
+	switch cfg.Type {
+	case SignerTypeKeystore:
+		keyJSON, err := os.ReadFile(cfg.KeystorePath)
+		if err != nil {
+			return nil, pkgErrors.Wrapf(err, "failed to read keystore file: %s", cfg.KeystorePath)
+		}
+		key, err := keystore.DecryptKey(keyJSON, cfg.Password)
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, "failed to decrypt keystore")
+		}
+		return NewKeySigner(key.PrivateKey), nil
+	case SignerTypeRemote:
+		return NewRemoteSigner(ctx, cfg)
+	case SignerTypeMock:
+		return NewMockSigner(cfg.Name), nil
+	default:
+		return newHSMSigner(ctx, cfg)
+	}
+}
+
+// KeySigner signs with a private key held in process memory
+type KeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKeySigner creates a signer for an in-memory private key
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// NewMockSigner creates a signer whose key is derived from a seed string.
+// The key is public knowledge; the signer exists for local development and tests.
+func NewMockSigner(seed string) *KeySigner {
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("mock signer:" + seed)))
+	if err != nil {
+		// A Keccak-256 digest is a valid secp256k1 key with overwhelming probability
+		panic(err)
+	}
+	return NewKeySigner(key)
+}
+
+// Address returns the account address of the signer
+func (s *KeySigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs a transaction for the given chain
+func (s *KeySigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+// SignTypedData signs an EIP-712 typed data document
+func (s *KeySigner) SignTypedData(ctx context.Context, td apitypes.TypedData) ([]byte, error) {
+	digest, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to hash typed data")
+	}
+	return s.signDigest(digest)
+}
+
+// SignText signs an EIP-191 personal message
+func (s *KeySigner) SignText(ctx context.Context, message []byte) ([]byte, error) {
+	return s.signDigest(accounts.TextHash(message))
+}
+
+// signDigest signs a digest and returns the signature with v in {27, 28}
+func (s *KeySigner) signDigest(digest []byte) ([]byte, error) {
+	sig, err := crypto.Sign(digest, s.key)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "failed to sign digest")
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+// ParseSignature decodes a hex encoded 65-byte signature
+func ParseSignature(s string) ([]byte, error) {
+	sig, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "invalid signature encoding")
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, pkgErrors.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(sig))
+	}
+	return sig, nil
+}
+
+// RecoverSigner returns the address that produced a signature over a digest.
+// Both v in {0, 1} and v in {27, 28} are accepted.
+func RecoverSigner(digest common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, pkgErrors.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(sig))
+	}
+	normalized := append([]byte{}, sig...)
+	if normalized[64] >= 27 {
+		normalized[64] -= 27
+	}
+	pub, err := crypto.SigToPub(digest.Bytes(), normalized)
+	if err != nil {
+		return common.Address{}, pkgErrors.Wrap(err, "failed to recover signer")
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// checkSignature normalizes v to {27, 28} and checks that the signature over a digest
+// was produced by the expected address
+func checkSignature(digest []byte, sig []byte, expected common.Address) ([]byte, error) {
+	got, err := RecoverSigner(common.BytesToHash(digest), sig)
+	if err != nil {
+		return nil, err
+	}
+	if got != expected {
+		return nil, pkgErrors.Errorf("signature produced by %s, expected %s", got.Hex(), expected.Hex())
+	}
+	normalized := append([]byte{}, sig...)
+	if normalized[64] < 27 {
+		normalized[64] += 27
+	}
+	return normalized, nil
+}
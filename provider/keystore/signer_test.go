@@ -0,0 +1,459 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"io"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"eth-contract-service/internal/conf"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var testChainID = big.NewInt(1337)
+
+// mockSigner creates a signer of type mock through the signer configuration path
+func mockSigner(t *testing.T, name string) *KeySigner {
+	t.Helper()
+	s, err := newSigner(context.Background(), &conf.Signer{Name: name, Type: SignerTypeMock})
+	if err != nil {
+		t.Fatalf("newSigner(mock): %v", err)
+	}
+	return s.(*KeySigner)
+}
+
+// testTransactions returns unsigned transactions of every supported fee type
+func testTransactions() map[string]*types.Transaction {
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	return map[string]*types.Transaction{
+		"legacy": types.NewTx(&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+		"dynamic fee": types.NewTx(&types.DynamicFeeTx{ChainID: testChainID, Nonce: 8, GasTipCap: big.NewInt(1e9),
+			GasFeeCap: big.NewInt(3e9), Gas: 50000, To: &to, Data: []byte{0xde, 0xad}}),
+		"contract creation": types.NewTx(&types.DynamicFeeTx{ChainID: testChainID, Nonce: 9, GasTipCap: big.NewInt(1e9),
+			GasFeeCap: big.NewInt(3e9), Gas: 100000, Data: []byte{0x60, 0x00}}),
+	}
+}
+
+// testTypedData returns a small EIP-712 document
+func testTypedData() apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "to", Type: "address"}, {Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "Test", ChainId: math.NewHexOrDecimal256(testChainID.Int64())},
+		Message: apitypes.TypedDataMessage{
+			"to":       "0x00000000000000000000000000000000000000bb",
+			"contents": "hello",
+		},
+	}
+}
+
+// recoverSigner returns the address that produced a 65-byte signature with v in {27, 28}
+func recoverSigner(t *testing.T, digest, sig []byte) common.Address {
+	t.Helper()
+	if len(sig) != crypto.SignatureLength {
+		t.Fatalf("signature length = %d, want %d", len(sig), crypto.SignatureLength)
+	}
+	if sig[64] != 27 && sig[64] != 28 {
+		t.Fatalf("signature v = %d, want 27 or 28", sig[64])
+	}
+	raw := append([]byte{}, sig...)
+	raw[64] -= 27
+	pub, err := crypto.SigToPub(digest, raw)
+	if err != nil {
+		t.Fatalf("SigToPub: %v", err)
+	}
+	return crypto.PubkeyToAddress(*pub)
+}
+
+// checkSigner signs transactions, typed data and a message with s and checks every
+// signature recovers to the signer address
+func checkSigner(t *testing.T, s Signer) {
+	t.Helper()
+	ctx := context.Background()
+
+	for name, tx := range testTransactions() {
+		t.Run("tx/"+name, func(t *testing.T) {
+			signed, err := s.SignTx(ctx, tx, testChainID)
+			if err != nil {
+				t.Fatalf("SignTx: %v", err)
+			}
+			sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+			if err != nil {
+				t.Fatalf("Sender: %v", err)
+			}
+			if sender != s.Address() {
+				t.Fatalf("sender = %s, want %s", sender.Hex(), s.Address().Hex())
+			}
+			if signed.Nonce() != tx.Nonce() || signed.Gas() != tx.Gas() {
+				t.Fatalf("signed transaction differs from the request")
+			}
+		})
+	}
+
+	t.Run("typed data", func(t *testing.T) {
+		td := testTypedData()
+		sig, err := s.SignTypedData(ctx, td)
+		if err != nil {
+			t.Fatalf("SignTypedData: %v", err)
+		}
+		digest, _, err := apitypes.TypedDataAndHash(td)
+		if err != nil {
+			t.Fatalf("TypedDataAndHash: %v", err)
+		}
+		if got := recoverSigner(t, digest, sig); got != s.Address() {
+			t.Fatalf("typed data signer = %s, want %s", got.Hex(), s.Address().Hex())
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		msg := []byte("hello world")
+		sig, err := s.SignText(ctx, msg)
+		if err != nil {
+			t.Fatalf("SignText: %v", err)
+		}
+		if got := recoverSigner(t, accounts.TextHash(msg), sig); got != s.Address() {
+			t.Fatalf("text signer = %s, want %s", got.Hex(), s.Address().Hex())
+		}
+	})
+}
+
+func TestKeySigner(t *testing.T) {
+	a, b := mockSigner(t, "alice"), mockSigner(t, "alice")
+	if a.Address() != b.Address() {
+		t.Fatalf("mock signers with the same name have different addresses")
+	}
+	if other := mockSigner(t, "bob"); other.Address() == a.Address() {
+		t.Fatalf("mock signers with different names share an address")
+	}
+	checkSigner(t, a)
+}
+
+func TestLoadSigners(t *testing.T) {
+	alice := mockSigner(t, "alice")
+	tests := []struct {
+		name    string
+		cfgs    []*conf.Signer
+		wantErr bool
+	}{
+		{name: "mock", cfgs: []*conf.Signer{{Name: "alice", Type: SignerTypeMock}}},
+		{name: "matching address", cfgs: []*conf.Signer{{Name: "alice", Type: SignerTypeMock, Address: alice.Address().Hex()}}},
+		{name: "address mismatch", cfgs: []*conf.Signer{{Name: "alice", Type: SignerTypeMock, Address: "0x00000000000000000000000000000000000000aa"}}, wantErr: true},
+		{name: "empty name", cfgs: []*conf.Signer{{Type: SignerTypeMock}}, wantErr: true},
+		{name: "prefixed name", cfgs: []*conf.Signer{{Name: "hd:0", Type: SignerTypeMock}}, wantErr: true},
+		{name: "duplicate", cfgs: []*conf.Signer{{Name: "alice", Type: SignerTypeMock}, {Name: "alice", Type: SignerTypeMock}}, wantErr: true},
+		{name: "unknown type", cfgs: []*conf.Signer{{Name: "alice", Type: "unknown"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loaded, err := loadSigners(context.Background(), tt.cfgs, log.NewStdLogger(io.Discard))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadSigners() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && loaded["alice"].Address() != alice.Address() {
+				t.Fatalf("loaded signer address = %s, want %s", loaded["alice"].Address().Hex(), alice.Address().Hex())
+			}
+		})
+	}
+}
+
+// web3SignerStub serves the eth_* signing methods of Web3Signer with a local key.
+// A non-nil tamper function modifies signed transactions before they are returned.
+type web3SignerStub struct {
+	key    *KeySigner
+	wrong  *KeySigner
+	tamper func(*types.Transaction) *types.Transaction
+}
+
+func (s *web3SignerStub) signer() *KeySigner {
+	if s.wrong != nil {
+		return s.wrong
+	}
+	return s.key
+}
+
+func (s *web3SignerStub) SignTransaction(args apitypes.SendTxArgs) (hexutil.Bytes, error) {
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	if s.tamper != nil {
+		tx = s.tamper(tx)
+	}
+	signed, err := s.signer().SignTx(context.Background(), tx, (*big.Int)(args.ChainID))
+	if err != nil {
+		return nil, err
+	}
+	return signed.MarshalBinary()
+}
+
+func (s *web3SignerStub) SignTypedData(addr common.MixedcaseAddress, td apitypes.TypedData) (hexutil.Bytes, error) {
+	return s.signer().SignTypedData(context.Background(), td)
+}
+
+func (s *web3SignerStub) Sign(addr common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.signer().SignText(context.Background(), data)
+}
+
+// clefStub serves the account_* signing methods of Clef with a local key.
+// Clef returns signatures with v in {0, 1}, which the remote signer normalizes.
+type clefStub struct {
+	key *KeySigner
+}
+
+func (s *clefStub) SignTransaction(args apitypes.SendTxArgs) (map[string]hexutil.Bytes, error) {
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	signed, err := s.key.SignTx(context.Background(), tx, (*big.Int)(args.ChainID))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]hexutil.Bytes{"raw": raw}, nil
+}
+
+func (s *clefStub) SignTypedData(addr common.MixedcaseAddress, td apitypes.TypedData) (hexutil.Bytes, error) {
+	sig, err := s.key.SignTypedData(context.Background(), td)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] -= 27
+	return sig, nil
+}
+
+func (s *clefStub) SignData(contentType string, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	sig, err := s.key.SignText(context.Background(), data)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] -= 27
+	return sig, nil
+}
+
+// remoteSigner starts a JSON-RPC stub serving namespace and returns a remote signer for it
+func remoteSigner(t *testing.T, api, namespace string, stub interface{}, address common.Address) *RemoteSigner {
+	t.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName(namespace, stub); err != nil {
+		t.Fatalf("RegisterName: %v", err)
+	}
+	ts := httptest.NewServer(server)
+	t.Cleanup(func() {
+		ts.Close()
+		server.Stop()
+	})
+
+	s, err := newSigner(context.Background(), &conf.Signer{
+		Name:    "remote",
+		Type:    SignerTypeRemote,
+		Url:     ts.URL,
+		Address: address.Hex(),
+		Api:     api,
+	})
+	if err != nil {
+		t.Fatalf("newSigner(remote): %v", err)
+	}
+	return s.(*RemoteSigner)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key := mockSigner(t, "remote")
+
+	t.Run("web3signer", func(t *testing.T) {
+		checkSigner(t, remoteSigner(t, RemoteAPIWeb3Signer, "eth", &web3SignerStub{key: key}, key.Address()))
+	})
+	t.Run("clef", func(t *testing.T) {
+		checkSigner(t, remoteSigner(t, RemoteAPIClef, "account", &clefStub{key: key}, key.Address()))
+	})
+}
+
+func TestRemoteSignerRejectsForeignSignatures(t *testing.T) {
+	ctx := context.Background()
+	key := mockSigner(t, "remote")
+	tx := testTransactions()["dynamic fee"]
+
+	tests := []struct {
+		name string
+		stub *web3SignerStub
+	}{
+		{name: "other key", stub: &web3SignerStub{key: key, wrong: mockSigner(t, "other")}},
+		{name: "changed nonce", stub: &web3SignerStub{key: key, tamper: func(tx *types.Transaction) *types.Transaction {
+			return types.NewTx(&types.DynamicFeeTx{ChainID: testChainID, Nonce: tx.Nonce() + 1, GasTipCap: tx.GasTipCap(),
+				GasFeeCap: tx.GasFeeCap(), Gas: tx.Gas(), To: tx.To(), Value: tx.Value(), Data: tx.Data()})
+		}}},
+		{name: "changed recipient", stub: &web3SignerStub{key: key, tamper: func(tx *types.Transaction) *types.Transaction {
+			to := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+			return types.NewTx(&types.DynamicFeeTx{ChainID: testChainID, Nonce: tx.Nonce(), GasTipCap: tx.GasTipCap(),
+				GasFeeCap: tx.GasFeeCap(), Gas: tx.Gas(), To: &to, Value: tx.Value(), Data: tx.Data()})
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := remoteSigner(t, RemoteAPIWeb3Signer, "eth", tt.stub, key.Address())
+			if _, err := s.SignTx(ctx, tx, testChainID); err == nil {
+				t.Fatalf("SignTx accepted a foreign transaction")
+			}
+			if tt.stub.wrong == nil {
+				return
+			}
+			if _, err := s.SignText(ctx, []byte("hello")); err == nil {
+				t.Fatalf("SignText accepted a foreign signature")
+			}
+			if _, err := s.SignTypedData(ctx, testTypedData()); err == nil {
+				t.Fatalf("SignTypedData accepted a foreign signature")
+			}
+		})
+	}
+}
+
+// mockHSMKey is an HSM key backed by an in-memory key.
+// With highS set it returns the high-S form of every signature, as some HSMs do.
+type mockHSMKey struct {
+	key   *ecdsa.PrivateKey
+	highS bool
+}
+
+func (k *mockHSMKey) PublicKey(ctx context.Context) (*ecdsa.PublicKey, error) {
+	return &k.key.PublicKey, nil
+}
+
+func (k *mockHSMKey) SignDigest(ctx context.Context, digest []byte) (*big.Int, *big.Int, error) {
+	sig, err := crypto.Sign(digest, k.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64])
+	if k.highS {
+		s.Sub(crypto.S256().Params().N, s)
+	}
+	return r, s, nil
+}
+
+func TestHSMSigner(t *testing.T) {
+	key := mockSigner(t, "hsm").key
+	for _, highS := range []bool{false, true} {
+		signerType := "mock-hsm"
+		name := "low-s"
+		if highS {
+			signerType, name = "mock-hsm-high-s", "high-s"
+		}
+		highS := highS
+		RegisterHSM(signerType, func(ctx context.Context, cfg *conf.Signer) (HSMKey, error) {
+			return &mockHSMKey{key: key, highS: highS}, nil
+		})
+
+		t.Run(name, func(t *testing.T) {
+			s, err := newSigner(context.Background(), &conf.Signer{Name: "hsm", Type: signerType})
+			if err != nil {
+				t.Fatalf("newSigner(%s): %v", signerType, err)
+			}
+			if s.Address() != crypto.PubkeyToAddress(key.PublicKey) {
+				t.Fatalf("HSM signer address = %s, want %s", s.Address().Hex(), crypto.PubkeyToAddress(key.PublicKey).Hex())
+			}
+			checkSigner(t, s)
+
+			// Signatures must be in the lower half of the curve order whatever the HSM returns
+			sig, err := s.SignText(context.Background(), []byte("hello"))
+			if err != nil {
+				t.Fatalf("SignText: %v", err)
+			}
+			halfN := new(big.Int).Rsh(crypto.S256().Params().N, 1)
+			if new(big.Int).SetBytes(sig[32:64]).Cmp(halfN) > 0 {
+				t.Fatalf("HSM signature has a high S value")
+			}
+		})
+	}
+}
+
+func TestCheckSignature(t *testing.T) {
+	key := mockSigner(t, "check")
+	other := mockSigner(t, "other")
+	digest := accounts.TextHash([]byte("hello"))
+
+	sig, err := key.SignText(context.Background(), []byte("hello"))
+	if err != nil {
+		t.Fatalf("SignText: %v", err)
+	}
+	withV := func(v byte) []byte {
+		out := append([]byte{}, sig...)
+		out[64] = v
+		return out
+	}
+	recoveryID := sig[64] - 27
+
+	tests := []struct {
+		name     string
+		sig      []byte
+		expected common.Address
+		wantErr  bool
+	}{
+		{name: "v 27/28", sig: withV(recoveryID + 27), expected: key.Address()},
+		{name: "v 0/1", sig: withV(recoveryID), expected: key.Address()},
+		{name: "wrong recovery id", sig: withV(1 - recoveryID), expected: key.Address(), wantErr: true},
+		{name: "other signer", sig: withV(recoveryID + 27), expected: other.Address(), wantErr: true},
+		{name: "short", sig: sig[:64], expected: key.Address(), wantErr: true},
+		{name: "long", sig: append(withV(recoveryID+27), 0), expected: key.Address(), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkSignature(digest, tt.sig, tt.expected)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got[64] != recoveryID+27 {
+				t.Fatalf("normalized v = %d, want %d", got[64], recoveryID+27)
+			}
+			if got := recoverSigner(t, digest, got); got != key.Address() {
+				t.Fatalf("normalized signature recovers to %s", got.Hex())
+			}
+		})
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	sig := make([]byte, crypto.SignatureLength)
+	sig[64] = 27
+
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{name: "valid", in: hexutil.Encode(sig)},
+		{name: "missing prefix", in: hexutil.Encode(sig)[2:], wantErr: true},
+		{name: "short", in: hexutil.Encode(sig[:64]), wantErr: true},
+		{name: "not hex", in: "0xzz", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSignature(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(got) != crypto.SignatureLength {
+				t.Fatalf("ParseSignature() returned %d bytes", len(got))
+			}
+		})
+	}
+}
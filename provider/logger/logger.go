@@ -15,6 +15,9 @@ import (
 
 var _ log.Logger = (*ZapLogger)(nil)
 
+// level is the minimum level shared by all loggers; SetLevel changes it at runtime
+var level = zap.NewAtomicLevelAt(zapcore.ErrorLevel)
+
 // ZapLogger implements the kratos Logger interface using zap.
 // It provides structured logging capabilities with configurable output formats and levels.
 type ZapLogger struct {
@@ -123,11 +126,11 @@ func DefaultLogger(logConf *conf.Log) *zap.Logger {
 	}
 
 	// Define log level enablers
-	highPriority := zap.LevelEnablerFunc(func(level zapcore.Level) bool {
-		return level >= zapcore.ErrorLevel
+	highPriority := zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+		return l >= zapcore.ErrorLevel && level.Enabled(l)
 	})
-	lowPriority := zap.LevelEnablerFunc(func(level zapcore.Level) bool {
-		return level < zapcore.ErrorLevel && level >= zapcore.DebugLevel
+	lowPriority := zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+		return l < zapcore.ErrorLevel && l >= zapcore.DebugLevel && level.Enabled(l)
 	})
 
 	// Create cores for different priority levels
@@ -151,21 +154,18 @@ func DefaultLogger(logConf *conf.Log) *zap.Logger {
 		zap.AddCallerSkip(2),
 	)
 
-	return setLogLevel(logger, logConf.GetLevel())
+	SetLevel(logConf.GetLevel())
+	return logger
 }
 
-// setLogLevel configures the minimum log level for the logger.
+// SetLevel changes the minimum log level of all loggers at runtime.
 //
 // Parameters:
-//   - logger: The zap logger to configure
-//   - level: The minimum log level (-1=Debug, 0=Info, 1=Warn, 3=DPanic, 4=Panic, 5=Fatal, default=Error)
-//
-// Returns:
-//   - *zap.Logger: The logger with the configured level
-func setLogLevel(logger *zap.Logger, level int32) *zap.Logger {
+//   - l: The minimum log level (-1=Debug, 0=Info, 1=Warn, 3=DPanic, 4=Panic, 5=Fatal, default=Error)
+func SetLevel(l int32) {
 	var zapLevel zapcore.Level
 
-	switch level {
+	switch l {
 	case -1:
 		zapLevel = zapcore.DebugLevel
 	case 0:
@@ -182,6 +182,6 @@ func setLogLevel(logger *zap.Logger, level int32) *zap.Logger {
 		zapLevel = zapcore.ErrorLevel
 	}
 
-	return logger.WithOptions(zap.IncreaseLevel(zapLevel))
+	level.SetLevel(zapLevel)
 }
 
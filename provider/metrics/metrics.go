@@ -0,0 +1,202 @@
+// Package metrics provides the Prometheus metrics endpoint.
+// Instruments are created through the OpenTelemetry metrics API and exported in the
+// Prometheus format, so packages only depend on otel.Meter and stay no-ops while
+// metrics are disabled.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"eth-contract-service/internal/conf"
+	"eth-contract-service/provider/cache"
+	"eth-contract-service/provider/db"
+
+	kmetrics "github.com/go-kratos/kratos/v2/middleware/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	// MeterName is the instrumentation scope of the service metrics
+	MeterName = "eth-contract-service"
+
+	// serverRequestsCounterName counts handled requests by operation and status code
+	serverRequestsCounterName = "server_requests_code_total"
+	// serverSecondsHistogramName records request latency by operation
+	serverSecondsHistogramName = "server_requests_seconds"
+
+	// defaultPath is the metrics endpoint path used when none is configured
+	defaultPath = "/metrics"
+	// defaultReceiptPollInterval is the receipt poll interval used when none is configured
+	defaultReceiptPollInterval = 15 * time.Second
+)
+
+// Settings holds the effective metrics settings
+type Settings struct {
+	// Enabled serves metrics on the HTTP server
+	Enabled bool
+	// Path is the metrics endpoint path
+	Path string
+	// ReceiptPollInterval is how often receipts of sent transactions are checked
+	ReceiptPollInterval time.Duration
+}
+
+var (
+	// settings stores the effective metrics settings
+	settings = Settings{
+		Path:                defaultPath,
+		ReceiptPollInterval: defaultReceiptPollInterval,
+	}
+	// registry collects the exported metrics, nil while metrics are disabled
+	registry *prometheus.Registry
+	// initOnce ensures the meter provider is installed only once
+	initOnce sync.Once
+)
+
+// Init installs the Prometheus exporter as the global meter provider and registers the
+// database and Redis connection pool metrics. Metrics stay disabled unless enabled.
+//
+// Parameters:
+//   - cfg: Metrics configuration (optional)
+//   - logger: Logger instance for metrics logging
+//
+// Returns:
+//   - error: Error if the exporter cannot be created
+func Init(cfg *conf.Metrics, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if !cfg.GetEnabled() {
+			log.NewHelper(logger).Infof("metrics disabled")
+			return
+		}
+		settings.Enabled = true
+		if cfg.Path != "" {
+			settings.Path = cfg.Path
+		}
+		if cfg.ReceiptPollInterval != nil && cfg.ReceiptPollInterval.AsDuration() > 0 {
+			settings.ReceiptPollInterval = cfg.ReceiptPollInterval.AsDuration()
+		}
+
+		reg := prometheus.NewRegistry()
+		reg.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+		exporter, err := otelprom.New(otelprom.WithRegisterer(reg), otelprom.WithoutScopeInfo())
+		if err != nil {
+			initErr = errors.Wrap(err, "failed to create Prometheus exporter")
+			return
+		}
+		otel.SetMeterProvider(sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(exporter),
+			sdkmetric.WithView(kmetrics.DefaultSecondsHistogramView(serverSecondsHistogramName)),
+		))
+		registry = reg
+
+		if err := registerPoolMetrics(); err != nil {
+			initErr = err
+			return
+		}
+
+		log.NewHelper(logger).Infof("metrics initialized: path=%s, receipt_poll_interval=%v",
+			settings.Path, settings.ReceiptPollInterval)
+	})
+	return initErr
+}
+
+// GetSettings returns the effective metrics settings
+func GetSettings() Settings {
+	return settings
+}
+
+// Enabled reports whether metrics are exported
+func Enabled() bool {
+	return registry != nil
+}
+
+// Meter returns the meter of the service metrics.
+// Instruments created before Init are forwarded to the exporter once it is installed.
+func Meter() metric.Meter {
+	return otel.Meter(MeterName)
+}
+
+// Handler returns the HTTP handler serving the metrics in the Prometheus text format
+func Handler() http.Handler {
+	if registry == nil {
+		return http.NotFoundHandler()
+	}
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// Server returns the server middleware counting requests per operation and status code
+// and recording their latency. It does nothing while metrics are disabled.
+func Server() middleware.Middleware {
+	if !Enabled() {
+		return func(handler middleware.Handler) middleware.Handler {
+			return handler
+		}
+	}
+	meter := Meter()
+	requests, err := kmetrics.DefaultRequestsCounter(meter, serverRequestsCounterName)
+	if err != nil {
+		otel.Handle(err)
+	}
+	seconds, err := kmetrics.DefaultSecondsHistogram(meter, serverSecondsHistogramName)
+	if err != nil {
+		otel.Handle(err)
+	}
+	return kmetrics.Server(kmetrics.WithRequests(requests), kmetrics.WithSeconds(seconds))
+}
+
+// registerPoolMetrics registers gauges reporting the database and Redis connection pools
+func registerPoolMetrics() error {
+	meter := Meter()
+
+	dbOpen, _ := meter.Int64ObservableGauge("db_pool_open_connections", metric.WithDescription("Open database connections"))
+	dbInUse, _ := meter.Int64ObservableGauge("db_pool_in_use_connections", metric.WithDescription("Database connections in use"))
+	dbIdle, _ := meter.Int64ObservableGauge("db_pool_idle_connections", metric.WithDescription("Idle database connections"))
+	dbWait, _ := meter.Int64ObservableCounter("db_pool_wait_count_total", metric.WithDescription("Connections waited for"))
+	dbWaitSeconds, _ := meter.Float64ObservableCounter("db_pool_wait_seconds_total", metric.WithDescription("Time blocked waiting for a connection"), metric.WithUnit("s"))
+	redisTotal, _ := meter.Int64ObservableGauge("redis_pool_total_connections", metric.WithDescription("Redis connections in the pool"))
+	redisIdle, _ := meter.Int64ObservableGauge("redis_pool_idle_connections", metric.WithDescription("Idle Redis connections"))
+	redisHits, _ := meter.Int64ObservableCounter("redis_pool_hits_total", metric.WithDescription("Free connections found in the pool"))
+	redisMisses, _ := meter.Int64ObservableCounter("redis_pool_misses_total", metric.WithDescription("Free connections not found in the pool"))
+	redisTimeouts, _ := meter.Int64ObservableCounter("redis_pool_timeouts_total", metric.WithDescription("Waits for a connection that timed out"))
+
+	_, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		// The database is initialized right after metrics and before any scrape is served
+		if sqlDB, err := db.Get().DB(); err == nil {
+			stats := sqlDB.Stats()
+			o.ObserveInt64(dbOpen, int64(stats.OpenConnections))
+			o.ObserveInt64(dbInUse, int64(stats.InUse))
+			o.ObserveInt64(dbIdle, int64(stats.Idle))
+			o.ObserveInt64(dbWait, stats.WaitCount)
+			o.ObserveFloat64(dbWaitSeconds, stats.WaitDuration.Seconds())
+		}
+		if client := cache.GetRedisClient(); client != nil {
+			stats := client.PoolStats()
+			o.ObserveInt64(redisTotal, int64(stats.TotalConns))
+			o.ObserveInt64(redisIdle, int64(stats.IdleConns))
+			o.ObserveInt64(redisHits, int64(stats.Hits))
+			o.ObserveInt64(redisMisses, int64(stats.Misses))
+			o.ObserveInt64(redisTimeouts, int64(stats.Timeouts))
+		}
+		return nil
+	}, dbOpen, dbInUse, dbIdle, dbWait, dbWaitSeconds, redisTotal, redisIdle, redisHits, redisMisses, redisTimeouts)
+	if err != nil {
+		return errors.Wrap(err, "failed to register connection pool metrics")
+	}
+	return nil
+}
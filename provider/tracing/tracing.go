@@ -0,0 +1,205 @@
+// Package tracing provides OpenTelemetry tracing with export to an OTLP collector.
+// Spans are created through the global tracer provider, so instrumented code stays a
+// no-op while tracing is disabled.
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"eth-contract-service/internal/conf"
+
+	ktracing "github.com/go-kratos/kratos/v2/middleware/tracing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the instrumentation scope of the service spans
+	TracerName = "eth-contract-service"
+
+	// ProtocolGRPC exports spans with OTLP over gRPC
+	ProtocolGRPC = "grpc"
+	// ProtocolHTTP exports spans with OTLP over HTTP
+	ProtocolHTTP = "http"
+)
+
+// Settings holds the effective tracing settings
+type Settings struct {
+	// Enabled exports spans to the collector
+	Enabled bool
+	// Endpoint is the collector address, empty for the exporter default
+	Endpoint string
+	// Protocol is the OTLP transport, grpc or http
+	Protocol string
+	// Insecure connects to the collector without TLS
+	Insecure bool
+	// SampleRatio is the fraction of new traces that are recorded
+	SampleRatio float64
+}
+
+var (
+	// settings stores the effective tracing settings
+	settings = Settings{
+		Protocol:    ProtocolGRPC,
+		SampleRatio: 1,
+	}
+	// provider exports the recorded spans, nil while tracing is disabled
+	provider *sdktrace.TracerProvider
+	// initOnce ensures the tracer provider is installed only once
+	initOnce sync.Once
+)
+
+// Init installs an OTLP exporting tracer provider as the global tracer provider.
+// Tracing stays disabled unless enabled.
+//
+// Parameters:
+//   - cfg: Tracing configuration (optional)
+//   - logger: Logger instance for tracing logging
+//
+// Returns:
+//   - error: Error if the configuration is invalid or the exporter cannot be created
+func Init(cfg *conf.Tracing, logger log.Logger) error {
+	var initErr error
+	initOnce.Do(func() {
+		if !cfg.GetEnabled() {
+			log.NewHelper(logger).Infof("tracing disabled")
+			return
+		}
+		settings.Enabled = true
+		settings.Endpoint = cfg.Endpoint
+		settings.Insecure = cfg.Insecure
+		if cfg.Protocol != "" {
+			settings.Protocol = cfg.Protocol
+		}
+		if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+			initErr = errors.Errorf("tracing sample_ratio must be between 0 and 1, got %v", cfg.SampleRatio)
+			return
+		}
+		if cfg.SampleRatio > 0 {
+			settings.SampleRatio = cfg.SampleRatio
+		}
+
+		ctx := context.Background()
+		exporter, err := newExporter(ctx)
+		if err != nil {
+			initErr = err
+			return
+		}
+		// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the defaults
+		res, err := resource.New(ctx,
+			resource.WithAttributes(semconv.ServiceName(TracerName)),
+			resource.WithFromEnv(),
+			resource.WithHost(),
+			resource.WithTelemetrySDK(),
+		)
+		if err != nil {
+			initErr = errors.Wrap(err, "failed to create tracing resource")
+			return
+		}
+
+		provider = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(settings.SampleRatio))),
+		)
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{}, propagation.Baggage{}))
+
+		log.NewHelper(logger).Infof("tracing initialized: protocol=%s, endpoint=%s, sample_ratio=%v",
+			settings.Protocol, settings.Endpoint, settings.SampleRatio)
+	})
+	return initErr
+}
+
+// newExporter creates the OTLP span exporter for the configured protocol
+func newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch settings.Protocol {
+	case ProtocolGRPC:
+		var opts []otlptracegrpc.Option
+		if settings.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(settings.Endpoint))
+		}
+		if settings.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		return exporter, errors.Wrap(err, "failed to create OTLP gRPC exporter")
+	case ProtocolHTTP:
+		var opts []otlptracehttp.Option
+		if settings.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(settings.Endpoint))
+		}
+		if settings.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		return exporter, errors.Wrap(err, "failed to create OTLP HTTP exporter")
+	default:
+		return nil, errors.Errorf("unsupported tracing protocol: %s", settings.Protocol)
+	}
+}
+
+// GetSettings returns the effective tracing settings
+func GetSettings() Settings {
+	return settings
+}
+
+// Enabled reports whether spans are exported
+func Enabled() bool {
+	return provider != nil
+}
+
+// Shutdown flushes the buffered spans and stops the exporter
+func Shutdown(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+	return provider.Shutdown(ctx)
+}
+
+// Start creates a span as a child of the span in ctx, if any.
+//
+// Parameters:
+//   - ctx: Parent context
+//   - name: Span name, e.g. eth.Dial
+//   - opts: Span options such as trace.WithAttributes
+//
+// Returns:
+//   - context.Context: Context carrying the new span
+//   - trace.Span: The span, which the caller must end
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, opts...)
+}
+
+// Server returns the server middleware starting a span for every request, continuing
+// the trace of the caller. It does nothing while tracing is disabled.
+func Server() middleware.Middleware {
+	if !Enabled() {
+		return func(handler middleware.Handler) middleware.Handler {
+			return handler
+		}
+	}
+	return ktracing.Server(ktracing.WithTracerName(TracerName))
+}
+
+// TraceID returns a log valuer resolving the trace id of the logging context
+func TraceID() log.Valuer {
+	return ktracing.TraceID()
+}
+
+// SpanID returns a log valuer resolving the span id of the logging context
+func SpanID() log.Valuer {
+	return ktracing.SpanID()
+}